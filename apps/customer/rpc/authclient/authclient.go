@@ -0,0 +1,205 @@
+package authclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	authservice "gz-dango/apps/customer/rpc/client/auth"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// DefaultCacheTTL 令牌检查和鉴权结果的默认本地缓存时间
+	// 令牌撤销和策略修改最多延迟该时间后在调用方生效
+	DefaultCacheTTL = 5 * time.Second
+	// DefaultCacheLimit 本地缓存的最大条目数
+	DefaultCacheLimit = 10000
+	// GrpcAct gRPC接口鉴权时使用的请求方法，obj 为完整方法名，如 /customer.User/GetUser
+	GrpcAct = auth.MethodGrpc
+)
+
+// Client 通过 customer 服务的 Auth 接口校验令牌和鉴权，调用方无需持有JWT密钥和casbin策略
+// Introspect 和 Check 的结果在本地短暂缓存，CheckMany 不缓存
+type Client struct {
+	auth  authservice.Auth
+	cache *collection.Cache
+}
+
+// NewClient 创建鉴权客户端，ttl 小于等于0时使用 DefaultCacheTTL
+func NewClient(cli zrpc.Client, ttl time.Duration) (*Client, error) {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	cache, err := collection.NewCache(ttl, collection.WithName("auth"), collection.WithLimit(DefaultCacheLimit))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		auth:  authservice.NewAuth(cli),
+		cache: cache,
+	}, nil
+}
+
+// MustNewClient 创建鉴权客户端，失败时panic
+func MustNewClient(cli zrpc.Client, ttl time.Duration) *Client {
+	c, err := NewClient(cli, ttl)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Introspect 校验令牌并返回用户信息，令牌无效时返回对应的认证错误
+func (c *Client) Introspect(ctx context.Context, token string) (*auth.UserClaims, *errors.Error) {
+	v, err := c.cache.Take("introspect\x00"+token, func() (any, error) {
+		return c.auth.Introspect(ctx, &authservice.IntrospectRequest{Token: token})
+	})
+	if err != nil {
+		return nil, errors.FromError(err)
+	}
+	out := v.(*authservice.IntrospectOut)
+	if !out.Active {
+		return nil, auth.InactiveError(out.Reason)
+	}
+	return tokenClaimsToUser(out.Claims), nil
+}
+
+// Check 校验令牌并检查用户是否可以访问 obj，ip 为客户端IP，可以为空
+// 令牌无效时返回对应的认证错误，没有权限时返回 auth.ErrForbidden
+func (c *Client) Check(ctx context.Context, token, obj, act, ip string) (*auth.UserClaims, *errors.Error) {
+	key := strings.Join([]string{"check", token, obj, act, ip}, "\x00")
+	v, err := c.cache.Take(key, func() (any, error) {
+		return c.auth.Check(ctx, &authservice.CheckRequest{
+			Token: token,
+			Obj:   obj,
+			Act:   act,
+			Ip:    ip,
+		})
+	})
+	if err != nil {
+		return nil, errors.FromError(err)
+	}
+	out := v.(*authservice.CheckOut)
+	if !out.Active {
+		return nil, auth.InactiveError(out.Reason)
+	}
+	if !out.Allowed {
+		return nil, auth.ErrForbidden
+	}
+	return tokenClaimsToUser(out.Claims), nil
+}
+
+// CheckMany 校验令牌并批量检查用户是否可以访问 items，返回的结果与 items 一一对应
+func (c *Client) CheckMany(
+	ctx context.Context,
+	token string,
+	items []*authservice.CheckItem,
+	ip string,
+) (*auth.UserClaims, []bool, *errors.Error) {
+	out, err := c.auth.CheckMany(ctx, &authservice.CheckManyRequest{
+		Token: token,
+		Items: items,
+		Ip:    ip,
+	})
+	if err != nil {
+		return nil, nil, errors.FromError(err)
+	}
+	if !out.Active {
+		return nil, nil, auth.InactiveError(out.Reason)
+	}
+	return tokenClaimsToUser(out.Claims), out.Allowed, nil
+}
+
+// UnaryServerInterceptor 供其他zrpc服务使用的鉴权拦截器
+// 从gRPC元数据中读取令牌，以完整方法名和 GrpcAct 鉴权，通过后将用户信息存储到 context 中
+func (c *Client) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		var token string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(auth.MetadataTokenKey); len(values) > 0 {
+				token = values[0]
+			}
+		}
+		if token == "" {
+			return nil, auth.ErrNoAuthor
+		}
+		claims, err := c.Check(ctx, token, info.FullMethod, GrpcAct, peerIP(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return handler(auth.SetUserClaims(ctx, claims), req)
+	}
+}
+
+// Middleware 供其他HTTP服务使用的鉴权中间件，以请求路径和请求方法鉴权
+func (c *Client) Middleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := auth.ExtractToken(r)
+		if token == "" {
+			httpx.WriteJson(w, auth.ErrNoAuthor.Code, auth.ErrNoAuthor.Reply())
+			return
+		}
+		var ip string
+		if addr := auth.ClientIP(r); addr.IsValid() {
+			ip = addr.String()
+		}
+		claims, err := c.Check(r.Context(), token, r.URL.Path, r.Method, ip)
+		if err != nil {
+			httpx.WriteJson(w, err.Code, err.Reply())
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.SetUserClaims(r.Context(), claims)))
+	}
+}
+
+// peerIP 获取gRPC调用方的IP
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// tokenClaimsToUser 将 Auth 接口返回的用户信息转换为 auth.UserClaims
+func tokenClaimsToUser(c *authservice.TokenClaims) *auth.UserClaims {
+	if c == nil {
+		return nil
+	}
+	claims := &auth.UserClaims{
+		IsStaff:  c.IsStaff,
+		UserId:   c.UserId,
+		TenantId: c.TenantId,
+		DeptId:   c.DeptId,
+		Roles:    c.Roles,
+	}
+	claims.ID = c.TokenId
+	if c.IssuedAt > 0 {
+		claims.IssuedAt = jwt.NewNumericDate(time.Unix(c.IssuedAt, 0))
+	}
+	if c.ExpiresAt > 0 {
+		claims.ExpiresAt = jwt.NewNumericDate(time.Unix(c.ExpiresAt, 0))
+	}
+	return claims
+}
@@ -0,0 +1,255 @@
+package authclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	authservice "gz-dango/apps/customer/rpc/client/auth"
+	"gz-dango/pkg/auth"
+
+	"github.com/zeromicro/go-zero/core/collection"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeAuth 记录调用次数并返回固定结果的 Auth 服务
+type fakeAuth struct {
+	introspect *authservice.IntrospectOut
+	check      func(in *authservice.CheckRequest) *authservice.CheckOut
+	checkMany  *authservice.CheckManyOut
+
+	introspectCalls, checkCalls, checkManyCalls atomic.Int32
+	lastCheck                                   atomic.Pointer[authservice.CheckRequest]
+}
+
+func (f *fakeAuth) Introspect(ctx context.Context, in *authservice.IntrospectRequest, opts ...grpc.CallOption) (*authservice.IntrospectOut, error) {
+	f.introspectCalls.Add(1)
+	return f.introspect, nil
+}
+
+func (f *fakeAuth) Check(ctx context.Context, in *authservice.CheckRequest, opts ...grpc.CallOption) (*authservice.CheckOut, error) {
+	f.checkCalls.Add(1)
+	f.lastCheck.Store(in)
+	return f.check(in), nil
+}
+
+func (f *fakeAuth) CheckMany(ctx context.Context, in *authservice.CheckManyRequest, opts ...grpc.CallOption) (*authservice.CheckManyOut, error) {
+	f.checkManyCalls.Add(1)
+	return f.checkMany, nil
+}
+
+var testClaims = &authservice.TokenClaims{UserId: 7, TenantId: 2, Roles: []string{"role_1"}, TokenId: "jti"}
+
+// allowObj 只允许访问 obj 的 Check 结果
+func allowObj(obj string) func(in *authservice.CheckRequest) *authservice.CheckOut {
+	return func(in *authservice.CheckRequest) *authservice.CheckOut {
+		return &authservice.CheckOut{Active: true, Claims: testClaims, Allowed: in.Obj == obj}
+	}
+}
+
+func newTestClient(t *testing.T, f *fakeAuth) *Client {
+	t.Helper()
+	cache, err := collection.NewCache(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Client{auth: f, cache: cache}
+}
+
+func TestClientInactiveToken(t *testing.T) {
+	cases := []struct {
+		reason string
+		want   error
+	}{
+		{auth.InactiveRevoked, auth.ErrTokenRevoked},
+		{auth.InactiveExpired, auth.ErrTokenExpired},
+		{auth.InactiveInvalid, auth.ErrInvalidToken},
+		{"", auth.ErrInvalidToken},
+	}
+	for _, c := range cases {
+		t.Run(c.reason, func(t *testing.T) {
+			f := &fakeAuth{
+				introspect: &authservice.IntrospectOut{Reason: c.reason},
+				check: func(in *authservice.CheckRequest) *authservice.CheckOut {
+					return &authservice.CheckOut{Reason: c.reason}
+				},
+				checkMany: &authservice.CheckManyOut{Reason: c.reason},
+			}
+			client := newTestClient(t, f)
+			ctx := context.Background()
+			if _, err := client.Introspect(ctx, "token"); !err.Is(c.want) {
+				t.Errorf("Introspect err = %v, want %v", err, c.want)
+			}
+			if _, err := client.Check(ctx, "token", "/a", "GET", ""); !err.Is(c.want) {
+				t.Errorf("Check err = %v, want %v", err, c.want)
+			}
+			if _, _, err := client.CheckMany(ctx, "token", nil, ""); !err.Is(c.want) {
+				t.Errorf("CheckMany err = %v, want %v", err, c.want)
+			}
+		})
+	}
+}
+
+func TestClientIntrospect(t *testing.T) {
+	f := &fakeAuth{introspect: &authservice.IntrospectOut{Active: true, Claims: testClaims}}
+	client := newTestClient(t, f)
+	for range 3 {
+		claims, err := client.Introspect(context.Background(), "token")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if claims.UserId != 7 || claims.TenantId != 2 || claims.ID != "jti" || !slices.Equal(claims.Roles, []string{"role_1"}) {
+			t.Errorf("claims = %+v", claims)
+		}
+	}
+	if n := f.introspectCalls.Load(); n != 1 {
+		t.Errorf("Introspect called %d times, want 1", n)
+	}
+}
+
+func TestClientCheckCached(t *testing.T) {
+	f := &fakeAuth{check: allowObj("/a")}
+	client := newTestClient(t, f)
+	ctx := context.Background()
+	for range 3 {
+		if _, err := client.Check(ctx, "token", "/a", "GET", "10.0.0.1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := f.checkCalls.Load(); n != 1 {
+		t.Errorf("Check called %d times within ttl, want 1", n)
+	}
+	// 拒绝的结果同样缓存
+	for range 2 {
+		if _, err := client.Check(ctx, "token", "/b", "GET", "10.0.0.1"); !err.Is(auth.ErrForbidden) {
+			t.Errorf("err = %v, want ErrForbidden", err)
+		}
+	}
+	if n := f.checkCalls.Load(); n != 2 {
+		t.Errorf("Check called %d times, want 2", n)
+	}
+	// 令牌、请求方法或IP不同时不共用缓存
+	for _, args := range [][3]string{{"other", "GET", "10.0.0.1"}, {"token", "POST", "10.0.0.1"}, {"token", "GET", "10.0.0.2"}} {
+		if _, err := client.Check(ctx, args[0], "/a", args[1], args[2]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := f.checkCalls.Load(); n != 5 {
+		t.Errorf("Check called %d times, want 5", n)
+	}
+}
+
+func TestClientCheckManyNotCached(t *testing.T) {
+	f := &fakeAuth{checkMany: &authservice.CheckManyOut{Active: true, Claims: testClaims, Allowed: []bool{true, false}}}
+	client := newTestClient(t, f)
+	items := []*authservice.CheckItem{{Obj: "/a", Act: "GET"}, {Obj: "/b", Act: "GET"}}
+	for range 2 {
+		claims, allowed, err := client.CheckMany(context.Background(), "token", items, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if claims.UserId != 7 || !slices.Equal(allowed, []bool{true, false}) {
+			t.Errorf("CheckMany = %+v, %v", claims, allowed)
+		}
+	}
+	if n := f.checkManyCalls.Load(); n != 2 {
+		t.Errorf("CheckMany called %d times, want 2", n)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	const method = "/order.Order/GetOrder"
+	cases := []struct {
+		name    string
+		md      metadata.MD
+		allowed bool
+		want    error
+	}{
+		{"no metadata", nil, false, auth.ErrNoAuthor},
+		{"empty token", metadata.Pairs(auth.MetadataTokenKey, ""), false, auth.ErrNoAuthor},
+		{"forbidden", metadata.Pairs(auth.MetadataTokenKey, "token"), false, auth.ErrForbidden},
+		{"allowed", metadata.Pairs(auth.MetadataTokenKey, "token"), true, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			obj := ""
+			if c.allowed {
+				obj = method
+			}
+			f := &fakeAuth{check: allowObj(obj)}
+			interceptor := newTestClient(t, f).UnaryServerInterceptor()
+			ctx := context.Background()
+			if c.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, c.md)
+			}
+			called := false
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+				called = true
+				uc, err := auth.GetUserClaims(ctx)
+				if err != nil || uc.UserId != 7 {
+					t.Errorf("handler claims = %+v, %v", uc, err)
+				}
+				return nil, nil
+			})
+			if called != (c.want == nil) {
+				t.Fatalf("handler called = %v, err = %v", called, err)
+			}
+			if c.want != nil && !errors.Is(err, c.want) {
+				t.Errorf("err = %v, want %v", err, c.want)
+			}
+			if c.want == auth.ErrNoAuthor && f.checkCalls.Load() != 0 {
+				t.Error("Check called without token")
+			}
+			if in := f.lastCheck.Load(); in != nil && (in.Obj != method || in.Act != GrpcAct) {
+				t.Errorf("Check request = %+v, want obj %q act %q", in, method, GrpcAct)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	cases := []struct {
+		name   string
+		token  string
+		path   string
+		status int
+	}{
+		{"no token", "", "/api/v1/order", http.StatusUnauthorized},
+		{"forbidden", "token", "/api/v1/admin", http.StatusForbidden},
+		{"allowed", "token", "/api/v1/order", http.StatusOK},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := &fakeAuth{check: allowObj("/api/v1/order")}
+			handler := newTestClient(t, f).Middleware(func(w http.ResponseWriter, r *http.Request) {
+				if uc, err := auth.GetUserClaims(r.Context()); err != nil || uc.UserId != 7 {
+					t.Errorf("handler claims = %+v, %v", uc, err)
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+			r := httptest.NewRequest(http.MethodGet, c.path, nil)
+			r.RemoteAddr = "10.0.0.1:1234"
+			if c.token != "" {
+				r.Header.Set("Authorization", c.token)
+			}
+			w := httptest.NewRecorder()
+			handler(w, r)
+			if w.Code != c.status {
+				t.Errorf("status = %d, want %d", w.Code, c.status)
+			}
+			if c.token == "" {
+				return
+			}
+			in := f.lastCheck.Load()
+			if in == nil || in.Obj != c.path || in.Act != http.MethodGet || in.Ip != "10.0.0.1" {
+				t.Errorf("Check request = %+v", in)
+			}
+		})
+	}
+}
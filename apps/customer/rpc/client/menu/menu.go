@@ -14,59 +14,132 @@ import (
 )
 
 type (
-	BoolValue               = pb.BoolValue
-	ButtonOut               = pb.ButtonOut
-	ButtonOutBase           = pb.ButtonOutBase
-	ChangePasswordRequest   = pb.ChangePasswordRequest
-	CreateButtonRequest     = pb.CreateButtonRequest
-	CreateMenuRequest       = pb.CreateMenuRequest
-	CreatePermissionRequest = pb.CreatePermissionRequest
-	CreateRoleRequest       = pb.CreateRoleRequest
-	CreateUserRequest       = pb.CreateUserRequest
-	DeleteButtonRequest     = pb.DeleteButtonRequest
-	DeleteMenuRequest       = pb.DeleteMenuRequest
-	DeletePermissionRequest = pb.DeletePermissionRequest
-	DeleteRoleRequest       = pb.DeleteRoleRequest
-	DeleteUserRequest       = pb.DeleteUserRequest
-	GetButtonRequest        = pb.GetButtonRequest
-	GetMenuRequest          = pb.GetMenuRequest
-	GetPermissionRequest    = pb.GetPermissionRequest
-	GetRoleRequest          = pb.GetRoleRequest
-	GetUserRequest          = pb.GetUserRequest
-	ListButtonRequest       = pb.ListButtonRequest
-	ListMenuRequest         = pb.ListMenuRequest
-	ListPermissionRequest   = pb.ListPermissionRequest
-	ListRoleRequest         = pb.ListRoleRequest
-	ListUserRequest         = pb.ListUserRequest
-	LoginOut                = pb.LoginOut
-	LoginRequest            = pb.LoginRequest
-	MenuOut                 = pb.MenuOut
-	MenuOutBase             = pb.MenuOutBase
-	MetaSchemas             = pb.MetaSchemas
-	NilOut                  = pb.NilOut
-	PagButtonOutBase        = pb.PagButtonOutBase
-	PagMenuOutBase          = pb.PagMenuOutBase
-	PagPermissionOutBase    = pb.PagPermissionOutBase
-	PagRoleOutBase          = pb.PagRoleOutBase
-	PagUserOut              = pb.PagUserOut
-	PermissionOutBase       = pb.PermissionOutBase
-	ResetPasswordRequest    = pb.ResetPasswordRequest
-	RoleOut                 = pb.RoleOut
-	RoleOutBase             = pb.RoleOutBase
-	UInt32Value             = pb.UInt32Value
-	UpdateButtonRequest     = pb.UpdateButtonRequest
-	UpdateMenuRequest       = pb.UpdateMenuRequest
-	UpdatePermissionRequest = pb.UpdatePermissionRequest
-	UpdateRoleRequest       = pb.UpdateRoleRequest
-	UpdateUserRequest       = pb.UpdateUserRequest
-	UserOut                 = pb.UserOut
+	AllowedButtonCodesOut            = pb.AllowedButtonCodesOut
+	BoolValue                        = pb.BoolValue
+	ButtonOut                        = pb.ButtonOut
+	ButtonOutBase                    = pb.ButtonOutBase
+	CaptchaOut                       = pb.CaptchaOut
+	ChangePasswordRequest            = pb.ChangePasswordRequest
+	CheckItem                        = pb.CheckItem
+	CheckManyOut                     = pb.CheckManyOut
+	CheckManyRequest                 = pb.CheckManyRequest
+	CheckOut                         = pb.CheckOut
+	CheckRequest                     = pb.CheckRequest
+	CloneRoleRequest                 = pb.CloneRoleRequest
+	CreateButtonRequest              = pb.CreateButtonRequest
+	CreateDeptRequest                = pb.CreateDeptRequest
+	CreateMenuRequest                = pb.CreateMenuRequest
+	CreatePermissionRequest          = pb.CreatePermissionRequest
+	CreateRolePermissionGrantRequest = pb.CreateRolePermissionGrantRequest
+	CreateRoleRequest                = pb.CreateRoleRequest
+	CreateTenantRequest              = pb.CreateTenantRequest
+	CreateUserRequest                = pb.CreateUserRequest
+	CreateUserRoleGrantRequest       = pb.CreateUserRoleGrantRequest
+	DeleteButtonRequest              = pb.DeleteButtonRequest
+	DeleteDependent                  = pb.DeleteDependent
+	DeleteDeptRequest                = pb.DeleteDeptRequest
+	DeleteGrantRequest               = pb.DeleteGrantRequest
+	DeleteMenuRequest                = pb.DeleteMenuRequest
+	DeleteOut                        = pb.DeleteOut
+	DeletePermissionRequest          = pb.DeletePermissionRequest
+	DeleteRoleRequest                = pb.DeleteRoleRequest
+	DeleteTenantRequest              = pb.DeleteTenantRequest
+	DeleteUserRequest                = pb.DeleteUserRequest
+	DeptOut                          = pb.DeptOut
+	DeptOutBase                      = pb.DeptOutBase
+	DiffRolesRequest                 = pb.DiffRolesRequest
+	ExplainAccessOut                 = pb.ExplainAccessOut
+	ExplainAccessRequest             = pb.ExplainAccessRequest
+	ExplainRule                      = pb.ExplainRule
+	ExplainSubject                   = pb.ExplainSubject
+	ExportMenuRoutesRequest          = pb.ExportMenuRoutesRequest
+	ExportRbacRequest                = pb.ExportRbacRequest
+	GenerateCaptchaRequest           = pb.GenerateCaptchaRequest
+	GetButtonRequest                 = pb.GetButtonRequest
+	GetDeptRequest                   = pb.GetDeptRequest
+	GetMenuRequest                   = pb.GetMenuRequest
+	GetMenuTreeRequest               = pb.GetMenuTreeRequest
+	GetPermissionRequest             = pb.GetPermissionRequest
+	GetRoleRequest                   = pb.GetRoleRequest
+	GetTenantRequest                 = pb.GetTenantRequest
+	GetUserRequest                   = pb.GetUserRequest
+	ImportRbacOut                    = pb.ImportRbacOut
+	ImportRbacRequest                = pb.ImportRbacRequest
+	IntrospectOut                    = pb.IntrospectOut
+	IntrospectRequest                = pb.IntrospectRequest
+	ListAllowedButtonCodesRequest    = pb.ListAllowedButtonCodesRequest
+	ListButtonRequest                = pb.ListButtonRequest
+	ListDeptRequest                  = pb.ListDeptRequest
+	ListMenuRequest                  = pb.ListMenuRequest
+	ListPermissionRequest            = pb.ListPermissionRequest
+	ListRolePermissionGrantRequest   = pb.ListRolePermissionGrantRequest
+	ListRoleRequest                  = pb.ListRoleRequest
+	ListTenantRequest                = pb.ListTenantRequest
+	ListUserRequest                  = pb.ListUserRequest
+	ListUserRoleGrantRequest         = pb.ListUserRoleGrantRequest
+	LoginOut                         = pb.LoginOut
+	LoginRequest                     = pb.LoginRequest
+	MenuButtonCodes                  = pb.MenuButtonCodes
+	MenuOrder                        = pb.MenuOrder
+	MenuOut                          = pb.MenuOut
+	MenuOutBase                      = pb.MenuOutBase
+	MenuRoutesOut                    = pb.MenuRoutesOut
+	MenuTreeButton                   = pb.MenuTreeButton
+	MenuTreeNode                     = pb.MenuTreeNode
+	MenuTreeOut                      = pb.MenuTreeOut
+	MetaSchemas                      = pb.MetaSchemas
+	MoveMenuRequest                  = pb.MoveMenuRequest
+	NilOut                           = pb.NilOut
+	PagButtonOutBase                 = pb.PagButtonOutBase
+	PagDeptOutBase                   = pb.PagDeptOutBase
+	PagMenuOutBase                   = pb.PagMenuOutBase
+	PagPermissionOutBase             = pb.PagPermissionOutBase
+	PagRoleOutBase                   = pb.PagRoleOutBase
+	PagRolePermissionGrantOut        = pb.PagRolePermissionGrantOut
+	PagTenantOut                     = pb.PagTenantOut
+	PagUserOut                       = pb.PagUserOut
+	PagUserRoleGrantOut              = pb.PagUserRoleGrantOut
+	PermissionOutBase                = pb.PermissionOutBase
+	PolicyConditions                 = pb.PolicyConditions
+	RbacChange                       = pb.RbacChange
+	RbacDocumentOut                  = pb.RbacDocumentOut
+	ReorderMenusRequest              = pb.ReorderMenusRequest
+	ResetPasswordRequest             = pb.ResetPasswordRequest
+	RoleDiffCategory                 = pb.RoleDiffCategory
+	RoleDiffEntry                    = pb.RoleDiffEntry
+	RoleDiffOut                      = pb.RoleDiffOut
+	RoleFieldChange                  = pb.RoleFieldChange
+	RoleImpactGrant                  = pb.RoleImpactGrant
+	RoleImpactOut                    = pb.RoleImpactOut
+	RoleImpactUser                   = pb.RoleImpactUser
+	RoleOut                          = pb.RoleOut
+	RoleOutBase                      = pb.RoleOutBase
+	RolePermissionGrantOut           = pb.RolePermissionGrantOut
+	SyncPermissionsOut               = pb.SyncPermissionsOut
+	SyncPermissionsRequest           = pb.SyncPermissionsRequest
+	TenantOut                        = pb.TenantOut
+	TokenClaims                      = pb.TokenClaims
+	UInt32Value                      = pb.UInt32Value
+	UpdateButtonRequest              = pb.UpdateButtonRequest
+	UpdateDeptRequest                = pb.UpdateDeptRequest
+	UpdateMenuRequest                = pb.UpdateMenuRequest
+	UpdatePermissionRequest          = pb.UpdatePermissionRequest
+	UpdateRoleRequest                = pb.UpdateRoleRequest
+	UpdateTenantRequest              = pb.UpdateTenantRequest
+	UpdateUserRequest                = pb.UpdateUserRequest
+	UserOut                          = pb.UserOut
+	UserRoleGrantOut                 = pb.UserRoleGrantOut
 
 	Menu interface {
 		CreateMenu(ctx context.Context, in *CreateMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
 		UpdateMenu(ctx context.Context, in *UpdateMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
-		DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*NilOut, error)
+		DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*DeleteOut, error)
 		GetMenu(ctx context.Context, in *GetMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
 		ListMenu(ctx context.Context, in *ListMenuRequest, opts ...grpc.CallOption) (*PagMenuOutBase, error)
+		GetMenuTree(ctx context.Context, in *GetMenuTreeRequest, opts ...grpc.CallOption) (*MenuTreeOut, error)
+		MoveMenu(ctx context.Context, in *MoveMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
+		ReorderMenus(ctx context.Context, in *ReorderMenusRequest, opts ...grpc.CallOption) (*NilOut, error)
+		ExportMenuRoutes(ctx context.Context, in *ExportMenuRoutesRequest, opts ...grpc.CallOption) (*MenuRoutesOut, error)
 	}
 
 	defaultMenu struct {
@@ -90,7 +163,7 @@ func (m *defaultMenu) UpdateMenu(ctx context.Context, in *UpdateMenuRequest, opt
 	return client.UpdateMenu(ctx, in, opts...)
 }
 
-func (m *defaultMenu) DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*NilOut, error) {
+func (m *defaultMenu) DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
 	client := pb.NewMenuClient(m.cli.Conn())
 	return client.DeleteMenu(ctx, in, opts...)
 }
@@ -104,3 +177,23 @@ func (m *defaultMenu) ListMenu(ctx context.Context, in *ListMenuRequest, opts ..
 	client := pb.NewMenuClient(m.cli.Conn())
 	return client.ListMenu(ctx, in, opts...)
 }
+
+func (m *defaultMenu) GetMenuTree(ctx context.Context, in *GetMenuTreeRequest, opts ...grpc.CallOption) (*MenuTreeOut, error) {
+	client := pb.NewMenuClient(m.cli.Conn())
+	return client.GetMenuTree(ctx, in, opts...)
+}
+
+func (m *defaultMenu) MoveMenu(ctx context.Context, in *MoveMenuRequest, opts ...grpc.CallOption) (*MenuOut, error) {
+	client := pb.NewMenuClient(m.cli.Conn())
+	return client.MoveMenu(ctx, in, opts...)
+}
+
+func (m *defaultMenu) ReorderMenus(ctx context.Context, in *ReorderMenusRequest, opts ...grpc.CallOption) (*NilOut, error) {
+	client := pb.NewMenuClient(m.cli.Conn())
+	return client.ReorderMenus(ctx, in, opts...)
+}
+
+func (m *defaultMenu) ExportMenuRoutes(ctx context.Context, in *ExportMenuRoutesRequest, opts ...grpc.CallOption) (*MenuRoutesOut, error) {
+	client := pb.NewMenuClient(m.cli.Conn())
+	return client.ExportMenuRoutes(ctx, in, opts...)
+}
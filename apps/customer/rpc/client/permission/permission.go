@@ -14,59 +14,130 @@ import (
 )
 
 type (
-	BoolValue               = pb.BoolValue
-	ButtonOut               = pb.ButtonOut
-	ButtonOutBase           = pb.ButtonOutBase
-	ChangePasswordRequest   = pb.ChangePasswordRequest
-	CreateButtonRequest     = pb.CreateButtonRequest
-	CreateMenuRequest       = pb.CreateMenuRequest
-	CreatePermissionRequest = pb.CreatePermissionRequest
-	CreateRoleRequest       = pb.CreateRoleRequest
-	CreateUserRequest       = pb.CreateUserRequest
-	DeleteButtonRequest     = pb.DeleteButtonRequest
-	DeleteMenuRequest       = pb.DeleteMenuRequest
-	DeletePermissionRequest = pb.DeletePermissionRequest
-	DeleteRoleRequest       = pb.DeleteRoleRequest
-	DeleteUserRequest       = pb.DeleteUserRequest
-	GetButtonRequest        = pb.GetButtonRequest
-	GetMenuRequest          = pb.GetMenuRequest
-	GetPermissionRequest    = pb.GetPermissionRequest
-	GetRoleRequest          = pb.GetRoleRequest
-	GetUserRequest          = pb.GetUserRequest
-	ListButtonRequest       = pb.ListButtonRequest
-	ListMenuRequest         = pb.ListMenuRequest
-	ListPermissionRequest   = pb.ListPermissionRequest
-	ListRoleRequest         = pb.ListRoleRequest
-	ListUserRequest         = pb.ListUserRequest
-	LoginOut                = pb.LoginOut
-	LoginRequest            = pb.LoginRequest
-	MenuOut                 = pb.MenuOut
-	MenuOutBase             = pb.MenuOutBase
-	MetaSchemas             = pb.MetaSchemas
-	NilOut                  = pb.NilOut
-	PagButtonOutBase        = pb.PagButtonOutBase
-	PagMenuOutBase          = pb.PagMenuOutBase
-	PagPermissionOutBase    = pb.PagPermissionOutBase
-	PagRoleOutBase          = pb.PagRoleOutBase
-	PagUserOut              = pb.PagUserOut
-	PermissionOutBase       = pb.PermissionOutBase
-	ResetPasswordRequest    = pb.ResetPasswordRequest
-	RoleOut                 = pb.RoleOut
-	RoleOutBase             = pb.RoleOutBase
-	UInt32Value             = pb.UInt32Value
-	UpdateButtonRequest     = pb.UpdateButtonRequest
-	UpdateMenuRequest       = pb.UpdateMenuRequest
-	UpdatePermissionRequest = pb.UpdatePermissionRequest
-	UpdateRoleRequest       = pb.UpdateRoleRequest
-	UpdateUserRequest       = pb.UpdateUserRequest
-	UserOut                 = pb.UserOut
+	AllowedButtonCodesOut            = pb.AllowedButtonCodesOut
+	BoolValue                        = pb.BoolValue
+	ButtonOut                        = pb.ButtonOut
+	ButtonOutBase                    = pb.ButtonOutBase
+	CaptchaOut                       = pb.CaptchaOut
+	ChangePasswordRequest            = pb.ChangePasswordRequest
+	CheckItem                        = pb.CheckItem
+	CheckManyOut                     = pb.CheckManyOut
+	CheckManyRequest                 = pb.CheckManyRequest
+	CheckOut                         = pb.CheckOut
+	CheckRequest                     = pb.CheckRequest
+	CloneRoleRequest                 = pb.CloneRoleRequest
+	CreateButtonRequest              = pb.CreateButtonRequest
+	CreateDeptRequest                = pb.CreateDeptRequest
+	CreateMenuRequest                = pb.CreateMenuRequest
+	CreatePermissionRequest          = pb.CreatePermissionRequest
+	CreateRolePermissionGrantRequest = pb.CreateRolePermissionGrantRequest
+	CreateRoleRequest                = pb.CreateRoleRequest
+	CreateTenantRequest              = pb.CreateTenantRequest
+	CreateUserRequest                = pb.CreateUserRequest
+	CreateUserRoleGrantRequest       = pb.CreateUserRoleGrantRequest
+	DeleteButtonRequest              = pb.DeleteButtonRequest
+	DeleteDependent                  = pb.DeleteDependent
+	DeleteDeptRequest                = pb.DeleteDeptRequest
+	DeleteGrantRequest               = pb.DeleteGrantRequest
+	DeleteMenuRequest                = pb.DeleteMenuRequest
+	DeleteOut                        = pb.DeleteOut
+	DeletePermissionRequest          = pb.DeletePermissionRequest
+	DeleteRoleRequest                = pb.DeleteRoleRequest
+	DeleteTenantRequest              = pb.DeleteTenantRequest
+	DeleteUserRequest                = pb.DeleteUserRequest
+	DeptOut                          = pb.DeptOut
+	DeptOutBase                      = pb.DeptOutBase
+	DiffRolesRequest                 = pb.DiffRolesRequest
+	ExplainAccessOut                 = pb.ExplainAccessOut
+	ExplainAccessRequest             = pb.ExplainAccessRequest
+	ExplainRule                      = pb.ExplainRule
+	ExplainSubject                   = pb.ExplainSubject
+	ExportMenuRoutesRequest          = pb.ExportMenuRoutesRequest
+	ExportRbacRequest                = pb.ExportRbacRequest
+	GenerateCaptchaRequest           = pb.GenerateCaptchaRequest
+	GetButtonRequest                 = pb.GetButtonRequest
+	GetDeptRequest                   = pb.GetDeptRequest
+	GetMenuRequest                   = pb.GetMenuRequest
+	GetMenuTreeRequest               = pb.GetMenuTreeRequest
+	GetPermissionRequest             = pb.GetPermissionRequest
+	GetRoleRequest                   = pb.GetRoleRequest
+	GetTenantRequest                 = pb.GetTenantRequest
+	GetUserRequest                   = pb.GetUserRequest
+	ImportRbacOut                    = pb.ImportRbacOut
+	ImportRbacRequest                = pb.ImportRbacRequest
+	IntrospectOut                    = pb.IntrospectOut
+	IntrospectRequest                = pb.IntrospectRequest
+	ListAllowedButtonCodesRequest    = pb.ListAllowedButtonCodesRequest
+	ListButtonRequest                = pb.ListButtonRequest
+	ListDeptRequest                  = pb.ListDeptRequest
+	ListMenuRequest                  = pb.ListMenuRequest
+	ListPermissionRequest            = pb.ListPermissionRequest
+	ListRolePermissionGrantRequest   = pb.ListRolePermissionGrantRequest
+	ListRoleRequest                  = pb.ListRoleRequest
+	ListTenantRequest                = pb.ListTenantRequest
+	ListUserRequest                  = pb.ListUserRequest
+	ListUserRoleGrantRequest         = pb.ListUserRoleGrantRequest
+	LoginOut                         = pb.LoginOut
+	LoginRequest                     = pb.LoginRequest
+	MenuButtonCodes                  = pb.MenuButtonCodes
+	MenuOrder                        = pb.MenuOrder
+	MenuOut                          = pb.MenuOut
+	MenuOutBase                      = pb.MenuOutBase
+	MenuRoutesOut                    = pb.MenuRoutesOut
+	MenuTreeButton                   = pb.MenuTreeButton
+	MenuTreeNode                     = pb.MenuTreeNode
+	MenuTreeOut                      = pb.MenuTreeOut
+	MetaSchemas                      = pb.MetaSchemas
+	MoveMenuRequest                  = pb.MoveMenuRequest
+	NilOut                           = pb.NilOut
+	PagButtonOutBase                 = pb.PagButtonOutBase
+	PagDeptOutBase                   = pb.PagDeptOutBase
+	PagMenuOutBase                   = pb.PagMenuOutBase
+	PagPermissionOutBase             = pb.PagPermissionOutBase
+	PagRoleOutBase                   = pb.PagRoleOutBase
+	PagRolePermissionGrantOut        = pb.PagRolePermissionGrantOut
+	PagTenantOut                     = pb.PagTenantOut
+	PagUserOut                       = pb.PagUserOut
+	PagUserRoleGrantOut              = pb.PagUserRoleGrantOut
+	PermissionOutBase                = pb.PermissionOutBase
+	PolicyConditions                 = pb.PolicyConditions
+	RbacChange                       = pb.RbacChange
+	RbacDocumentOut                  = pb.RbacDocumentOut
+	ReorderMenusRequest              = pb.ReorderMenusRequest
+	ResetPasswordRequest             = pb.ResetPasswordRequest
+	RoleDiffCategory                 = pb.RoleDiffCategory
+	RoleDiffEntry                    = pb.RoleDiffEntry
+	RoleDiffOut                      = pb.RoleDiffOut
+	RoleFieldChange                  = pb.RoleFieldChange
+	RoleImpactGrant                  = pb.RoleImpactGrant
+	RoleImpactOut                    = pb.RoleImpactOut
+	RoleImpactUser                   = pb.RoleImpactUser
+	RoleOut                          = pb.RoleOut
+	RoleOutBase                      = pb.RoleOutBase
+	RolePermissionGrantOut           = pb.RolePermissionGrantOut
+	SyncPermissionsOut               = pb.SyncPermissionsOut
+	SyncPermissionsRequest           = pb.SyncPermissionsRequest
+	TenantOut                        = pb.TenantOut
+	TokenClaims                      = pb.TokenClaims
+	UInt32Value                      = pb.UInt32Value
+	UpdateButtonRequest              = pb.UpdateButtonRequest
+	UpdateDeptRequest                = pb.UpdateDeptRequest
+	UpdateMenuRequest                = pb.UpdateMenuRequest
+	UpdatePermissionRequest          = pb.UpdatePermissionRequest
+	UpdateRoleRequest                = pb.UpdateRoleRequest
+	UpdateTenantRequest              = pb.UpdateTenantRequest
+	UpdateUserRequest                = pb.UpdateUserRequest
+	UserOut                          = pb.UserOut
+	UserRoleGrantOut                 = pb.UserRoleGrantOut
 
 	Permission interface {
 		CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*PermissionOutBase, error)
 		UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...grpc.CallOption) (*PermissionOutBase, error)
-		DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*NilOut, error)
+		DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeleteOut, error)
 		GetPermission(ctx context.Context, in *GetPermissionRequest, opts ...grpc.CallOption) (*PermissionOutBase, error)
 		ListPermission(ctx context.Context, in *ListPermissionRequest, opts ...grpc.CallOption) (*PagPermissionOutBase, error)
+		ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessOut, error)
+		SyncPermissions(ctx context.Context, in *SyncPermissionsRequest, opts ...grpc.CallOption) (*SyncPermissionsOut, error)
 	}
 
 	defaultPermission struct {
@@ -90,7 +161,7 @@ func (m *defaultPermission) UpdatePermission(ctx context.Context, in *UpdatePerm
 	return client.UpdatePermission(ctx, in, opts...)
 }
 
-func (m *defaultPermission) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*NilOut, error) {
+func (m *defaultPermission) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
 	client := pb.NewPermissionClient(m.cli.Conn())
 	return client.DeletePermission(ctx, in, opts...)
 }
@@ -104,3 +175,13 @@ func (m *defaultPermission) ListPermission(ctx context.Context, in *ListPermissi
 	client := pb.NewPermissionClient(m.cli.Conn())
 	return client.ListPermission(ctx, in, opts...)
 }
+
+func (m *defaultPermission) ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessOut, error) {
+	client := pb.NewPermissionClient(m.cli.Conn())
+	return client.ExplainAccess(ctx, in, opts...)
+}
+
+func (m *defaultPermission) SyncPermissions(ctx context.Context, in *SyncPermissionsRequest, opts ...grpc.CallOption) (*SyncPermissionsOut, error) {
+	client := pb.NewPermissionClient(m.cli.Conn())
+	return client.SyncPermissions(ctx, in, opts...)
+}
@@ -0,0 +1,163 @@
+// rbac 通过customer服务导出和导入声明式权限文档，用于初始化新环境
+//
+//	rbac -target 127.0.0.1:8080 -internal-token $INTERNAL_TOKEN export -o rbac.yaml
+//	rbac -target 127.0.0.1:8080 -internal-token $INTERNAL_TOKEN import -dry-run rbac.yaml
+//	rbac -target 127.0.0.1:8080 -token $TOKEN import -prune rbac.yaml
+//
+// 使用内部服务令牌时作为内部调用处理平台级数据，指定租户用户的令牌时处理该租户的数据
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	rbacClient "gz-dango/apps/customer/rpc/client/rbac"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	target        = flag.String("target", "127.0.0.1:8080", "customer服务地址")
+	token         = flag.String("token", "", "授权令牌")
+	internalToken = flag.String("internal-token", "", "内部服务令牌，未指定授权令牌时作为内部调用")
+	timeout       = flag.Duration("timeout", time.Minute, "请求超时时间")
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	client, err := zrpc.NewClientWithTarget(*target)
+	if err != nil {
+		fatal(err)
+	}
+	rbac := rbacClient.NewRbac(client)
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, auth.MetadataTokenKey, *token)
+	} else if *internalToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, auth.MetadataInternalTokenKey, *internalToken)
+	}
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "export":
+		err = export(ctx, rbac, args)
+	case "import":
+		err = importDocument(ctx, rbac, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fatal(err)
+	}
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintln(out, "usage: rbac [flags] export [-format yaml|json] [-o file]")
+	fmt.Fprintln(out, "       rbac [flags] import [-format yaml|json] [-dry-run] [-prune] file")
+	flag.PrintDefaults()
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+// formatOf 未指定格式时按文件扩展名判断，默认为YAML
+func formatOf(format, file string) string {
+	if format != "" {
+		return format
+	}
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		return "json"
+	}
+	return "yaml"
+}
+
+func export(ctx context.Context, rbac rbacClient.Rbac, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "", "文档格式 yaml/json，为空时按输出文件扩展名判断")
+	output := fs.String("o", "", "输出文件，为空时输出到标准输出")
+	_ = fs.Parse(args)
+
+	out, err := rbac.ExportRbac(ctx, &pb.ExportRbacRequest{Format: formatOf(*format, *output)})
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = io.WriteString(os.Stdout, out.Content)
+		return err
+	}
+	return os.WriteFile(*output, []byte(out.Content), 0o644)
+}
+
+func importDocument(ctx context.Context, rbac rbacClient.Rbac, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "文档格式 yaml/json，为空时按文件扩展名判断")
+	dryRun := fs.Bool("dry-run", false, "只输出将产生的变更，不写入数据")
+	prune := fs.Bool("prune", false, "删除文档中未描述的数据")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("import requires exactly one document file, use - for stdin")
+	}
+
+	file := fs.Arg(0)
+	var content []byte
+	var err error
+	if file == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+	out, err := rbac.ImportRbac(ctx, &pb.ImportRbacRequest{
+		Format:  formatOf(*format, file),
+		Content: string(content),
+		DryRun:  *dryRun,
+		Prune:   *prune,
+	})
+	if err != nil {
+		return err
+	}
+	printChanges(out)
+	return nil
+}
+
+// printChanges 以差异的形式输出变更：+ 新增，~ 更新，- 删除，! 跳过
+func printChanges(out *pb.ImportRbacOut) {
+	marks := map[string]string{"create": "+", "update": "~", "delete": "-", "skip": "!"}
+	for _, c := range out.Changes {
+		line := fmt.Sprintf("%s %s %s", marks[c.Action], c.Kind, c.Key)
+		if len(c.Fields) > 0 {
+			line += " (" + strings.Join(c.Fields, ", ") + ")"
+		}
+		if c.Reason != "" {
+			line += ": " + c.Reason
+		}
+		fmt.Println(line)
+	}
+	switch {
+	case len(out.Changes) == 0:
+		fmt.Println("no changes")
+	case out.Applied:
+		fmt.Printf("%d changes applied\n", len(out.Changes))
+	default:
+		fmt.Printf("%d changes (dry run, nothing applied)\n", len(out.Changes))
+	}
+}
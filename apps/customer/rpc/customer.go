@@ -1,17 +1,29 @@
 package main
 
 import (
+	"context"
+	_ "embed"
 	"flag"
 	"fmt"
+	"os"
+	"slices"
 
 	"gz-dango/apps/customer/rpc/internal/config"
+	userLogic "gz-dango/apps/customer/rpc/internal/logic/user"
+	authServer "gz-dango/apps/customer/rpc/internal/server/auth"
 	buttonServer "gz-dango/apps/customer/rpc/internal/server/button"
+	captchaServer "gz-dango/apps/customer/rpc/internal/server/captcha"
+	deptServer "gz-dango/apps/customer/rpc/internal/server/dept"
+	grantServer "gz-dango/apps/customer/rpc/internal/server/grant"
 	menuServer "gz-dango/apps/customer/rpc/internal/server/menu"
 	permissionServer "gz-dango/apps/customer/rpc/internal/server/permission"
+	rbacServer "gz-dango/apps/customer/rpc/internal/server/rbac"
 	roleServer "gz-dango/apps/customer/rpc/internal/server/role"
+	tenantServer "gz-dango/apps/customer/rpc/internal/server/tenant"
 	userServer "gz-dango/apps/customer/rpc/internal/server/user"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -19,9 +31,33 @@ import (
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-var configFile = flag.String("f", "etc/customer.yaml", "the config file")
+// protoSrc 用于读取rpc方法的注释，作为同步权限时的描述
+//
+//go:embed customer.proto
+var protoSrc string
+
+// publicMethods 无需令牌即可调用的接口，鉴权接口校验请求体中的令牌
+var publicMethods = []string{
+	pb.User_Login_FullMethodName,
+	pb.Captcha_GenerateCaptcha_FullMethodName,
+	pb.Auth_Introspect_FullMethodName,
+	pb.Auth_Check_FullMethodName,
+	pb.Auth_CheckMany_FullMethodName,
+}
+
+var (
+	configFile = flag.String("f", "etc/customer.yaml", "the config file")
+	bootstrap  = flag.Bool("bootstrap", false, "create the superuser role and the initial admin user on an empty database, then exit")
+)
+
+// 初始化管理员时读取的环境变量，未设置密码时随机生成并输出一次
+const (
+	adminUsernameEnv = "CUSTOMER_ADMIN_USERNAME"
+	adminPasswordEnv = "CUSTOMER_ADMIN_PASSWORD"
+)
 
 func main() {
 	flag.Parse()
@@ -30,18 +66,38 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	logx.MustSetup(c.ServiceLog)
 	ctx := svc.NewServiceContext(c)
+	if *bootstrap {
+		runBootstrap(ctx)
+		return
+	}
 
+	// 接口列表取自proto文件描述，服务启动前即可用于同步权限
+	ctx.SetEndpoints(auth.GrpcEndpoints(
+		[]protoreflect.FileDescriptor{pb.File_apps_customer_rpc_customer_proto},
+		auth.ParseProtoComments(protoSrc),
+	))
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterPermissionServer(grpcServer, permissionServer.NewPermissionServer(ctx))
 		pb.RegisterMenuServer(grpcServer, menuServer.NewMenuServer(ctx))
 		pb.RegisterButtonServer(grpcServer, buttonServer.NewButtonServer(ctx))
 		pb.RegisterRoleServer(grpcServer, roleServer.NewRoleServer(ctx))
 		pb.RegisterUserServer(grpcServer, userServer.NewUserServer(ctx))
+		pb.RegisterCaptchaServer(grpcServer, captchaServer.NewCaptchaServer(ctx))
+		pb.RegisterTenantServer(grpcServer, tenantServer.NewTenantServer(ctx))
+		pb.RegisterDeptServer(grpcServer, deptServer.NewDeptServer(ctx))
+		pb.RegisterGrantServer(grpcServer, grantServer.NewGrantServer(ctx))
+		pb.RegisterAuthServer(grpcServer, authServer.NewAuthServer(ctx))
+		pb.RegisterRbacServer(grpcServer, rbacServer.NewRbacServer(ctx))
 
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
 		}
 	})
+	// 解析调用方身份，用于租户隔离
+	s.AddUnaryInterceptors(auth.UnaryClaimsInterceptor(ctx.Enforce(), auth.ClaimsInterceptorOptions{
+		InternalToken: c.Security.InternalToken,
+		PublicMethods: slices.Concat(publicMethods, c.Security.PublicMethods),
+	}))
 	defer func() {
 		ctx.Close()
 		s.Stop()
@@ -51,8 +107,32 @@ func main() {
 		panic(err)
 	}
 
-	go ctx.WatchCasbinPolicies()
+	ctx.SyncPermissionsOnStart()
+	ctx.WatchCasbinPolicies()
+	ctx.WatchGrantExpiry()
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
+
+// runBootstrap 初始化超级管理员角色和管理员用户后退出，已存在用户时失败
+func runBootstrap(ctx *svc.ServiceContext) {
+	defer ctx.Close()
+	if err := ctx.RefreshPolicies(); err != nil {
+		panic(err)
+	}
+	res, err := userLogic.Bootstrap(context.Background(), ctx, userLogic.BootstrapOptions{
+		Username: os.Getenv(adminUsernameEnv),
+		Password: os.Getenv(adminPasswordEnv),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bootstrap failed: %v\n", err)
+		ctx.Close()
+		os.Exit(1)
+	}
+	fmt.Printf("created role %q (id=%d) and user %q (id=%d)\n", res.Role.Name, res.Role.Id, res.User.Username, res.User.Id)
+	if res.Password != "" {
+		fmt.Printf("generated password: %s\n", res.Password)
+		fmt.Println("the password is shown only once, store it now")
+	}
+}
@@ -19,13 +19,34 @@ type Config struct {
 }
 
 type SecurityConfig struct {
-	JwtSecret          string
-	PolicyLoadTimeout  time.Duration
-	PolicyChangeKey    string
-	JwtBlacklistPrefix string
-	CheckTimestamp     bool
-	TimestampRange     int
-	TokenExpireMinutes int
-	LoginFailMaxTimes  int
-	PasswordStrength   int
+	JwtSecret            string
+	PolicyLoadTimeout    time.Duration
+	PolicyChangeKey      string
+	PolicyResyncInterval time.Duration `json:",optional"`                                // 定期全量重新加载策略的间隔，用于兜底丢失的变更消息
+	PolicyWatcher        string        `json:",default=redis,options=redis|etcd|memory"` // 策略变更消息的传输方式
+	ModelPath            string        `json:",optional"`                                // 自定义casbin模型文件，为空时使用内置模型
+	GrantSweepInterval   time.Duration `json:",optional"`                                // 检查临时授权生效和失效的间隔
+	PermissionSync       string        `json:",default=off,options=off|report|apply"`    // 启动时按已注册的接口同步权限
+	InternalToken        string        `json:",optional"`                                // 内部服务调用携带的共享令牌，为空时拒绝未携带授权令牌的非公开调用
+	PublicMethods        []string      `json:",optional"`                                // 除登录、验证码和鉴权接口外，其他无需令牌即可调用的完整方法名
+	JwtBlacklistPrefix   string
+	CheckTimestamp       bool
+	TimestampRange       int
+	TokenExpireMinutes   int
+	LoginFailMaxTimes    int
+	PasswordStrength     int
+	Captcha              CaptchaConfig
+}
+
+type CaptchaConfig struct {
+	Mode          string        // 登录验证码模式: off / always / on_failure
+	Type          string        // 默认验证码类型: digit / math
+	Length        int           // 数字验证码长度
+	Width         int           // 图片宽度
+	Height        int           // 图片高度
+	Expire        time.Duration // 验证码有效期
+	Prefix        string        // 验证码在Redis中的键前缀
+	FailThreshold int           // on_failure模式下触发验证码的登录失败次数
+	FailWindow    time.Duration // 统计登录失败次数的时间窗口
+	FailPrefix    string        // 登录失败计数在Redis中的键前缀
 }
@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+)
+
+func UserClaimsToOut(
+	c *auth.UserClaims,
+) *pb.TokenClaims {
+	if c == nil {
+		return nil
+	}
+	mo := &pb.TokenClaims{
+		UserId:   c.UserId,
+		TenantId: c.TenantId,
+		DeptId:   c.DeptId,
+		IsStaff:  c.IsStaff,
+		Roles:    c.Roles,
+		TokenId:  c.ID,
+	}
+	if c.IssuedAt != nil {
+		mo.IssuedAt = c.IssuedAt.Unix()
+	}
+	if c.ExpiresAt != nil {
+		mo.ExpiresAt = c.ExpiresAt.Unix()
+	}
+	return mo
+}
@@ -1,6 +1,9 @@
 package converter
 
 import (
+	"cmp"
+	"slices"
+
 	"gz-dango/apps/customer/rpc/internal/models"
 	"gz-dango/apps/customer/rpc/pb"
 )
@@ -10,9 +13,11 @@ func ButtonModelToOutBase(
 ) *pb.ButtonOutBase {
 	return &pb.ButtonOutBase{
 		Id:           m.Id,
+		TenantId:     m.TenantId,
 		CreatedAt:    m.CreatedAt.String(),
 		UpdatedAt:    m.UpdatedAt.String(),
 		Name:         m.Name,
+		Code:         m.Code,
 		ArrangeOrder: m.ArrangeOrder,
 		IsActive:     m.IsActive,
 		Descr:        m.Descr,
@@ -37,9 +42,11 @@ func ButtonModelToOut(
 ) *pb.ButtonOut {
 	return &pb.ButtonOut{
 		Id:           m.Id,
+		TenantId:     m.TenantId,
 		CreatedAt:    m.CreatedAt.String(),
 		UpdatedAt:    m.UpdatedAt.String(),
 		Name:         m.Name,
+		Code:         m.Code,
 		ArrangeOrder: m.ArrangeOrder,
 		IsActive:     m.IsActive,
 		Descr:        m.Descr,
@@ -47,3 +54,33 @@ func ButtonModelToOut(
 		Permissions:  ListPermModelToOut(m.Permissions),
 	}
 }
+
+// ButtonCodesByMenu 按所属菜单分组按钮的权限标识，菜单按排序值和ID升序，组内保持按钮的顺序
+func ButtonCodesByMenu(
+	ms []models.ButtonModel,
+) []*pb.MenuButtonCodes {
+	groups := make(map[uint32]*pb.MenuButtonCodes)
+	menus := make([]models.MenuModel, 0)
+	for _, m := range ms {
+		g, ok := groups[m.MenuId]
+		if !ok {
+			g = &pb.MenuButtonCodes{
+				MenuId:   m.MenuId,
+				MenuName: m.Menu.Name,
+				MenuPath: m.Menu.Path,
+				Codes:    make([]string, 0),
+			}
+			groups[m.MenuId] = g
+			menus = append(menus, m.Menu)
+		}
+		g.Codes = append(g.Codes, m.Code)
+	}
+	slices.SortFunc(menus, func(a, b models.MenuModel) int {
+		return cmp.Or(cmp.Compare(a.ArrangeOrder, b.ArrangeOrder), cmp.Compare(a.Id, b.Id))
+	})
+	items := make([]*pb.MenuButtonCodes, 0, len(menus))
+	for _, m := range menus {
+		items = append(items, groups[m.Id])
+	}
+	return items
+}
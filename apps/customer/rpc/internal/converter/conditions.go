@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+)
+
+// PolicyConditionsToOut 将业务表中保存的条件转换为输出，未设置条件时返回nil
+func PolicyConditionsToOut(s string) *pb.PolicyConditions {
+	c, err := auth.DecodeConditions(s)
+	if err != nil || c.IsZero() {
+		return nil
+	}
+	weekdays := make([]int32, 0, len(c.Weekdays))
+	for _, d := range c.Weekdays {
+		weekdays = append(weekdays, int32(d))
+	}
+	return &pb.PolicyConditions{
+		Cidrs:        c.CIDRs,
+		Weekdays:     weekdays,
+		StartTime:    c.StartTime,
+		EndTime:      c.EndTime,
+		Timezone:     c.Timezone,
+		RequireStaff: c.RequireStaff,
+	}
+}
+
+// PolicyConditionsFromIn 将请求中的条件转换为 auth.Conditions，in 为nil时返回空条件
+func PolicyConditionsFromIn(in *pb.PolicyConditions) auth.Conditions {
+	if in == nil {
+		return auth.Conditions{}
+	}
+	weekdays := make([]int, 0, len(in.Weekdays))
+	for _, d := range in.Weekdays {
+		weekdays = append(weekdays, int(d))
+	}
+	return auth.Conditions{
+		CIDRs:        in.Cidrs,
+		Weekdays:     weekdays,
+		StartTime:    in.StartTime,
+		EndTime:      in.EndTime,
+		Timezone:     in.Timezone,
+		RequireStaff: in.RequireStaff,
+	}
+}
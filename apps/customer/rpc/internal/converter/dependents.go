@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+)
+
+// DependentsToDeleteOut 将删除操作的依赖项转换为输出，deleted 为是否已删除
+func DependentsToDeleteOut(ds svc.Dependents, dryRun bool, deleted bool) *pb.DeleteOut {
+	out := &pb.DeleteOut{
+		DryRun:     dryRun,
+		Deleted:    deleted,
+		Dependents: make([]*pb.DeleteDependent, 0, len(ds)),
+	}
+	for _, d := range ds {
+		out.Dependents = append(out.Dependents, &pb.DeleteDependent{
+			Kind:  d.Kind,
+			Count: uint32(len(d.Ids)),
+			Ids:   d.Ids,
+		})
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/pb"
+)
+
+func DeptModelToOutBase(
+	m models.DeptModel,
+) *pb.DeptOutBase {
+	return &pb.DeptOutBase{
+		Id:           m.Id,
+		TenantId:     m.TenantId,
+		CreatedAt:    m.CreatedAt.String(),
+		UpdatedAt:    m.UpdatedAt.String(),
+		Name:         m.Name,
+		ArrangeOrder: m.ArrangeOrder,
+		IsActive:     m.IsActive,
+		Descr:        m.Descr,
+	}
+}
+
+func ListDeptModelToOutBase(
+	ms []models.DeptModel,
+) []*pb.DeptOutBase {
+	mso := make([]*pb.DeptOutBase, 0, len(ms))
+	if len(ms) > 0 {
+		for _, m := range ms {
+			mo := DeptModelToOutBase(m)
+			mso = append(mso, mo)
+		}
+	}
+	return mso
+}
+
+func DeptModelToOut(
+	m models.DeptModel,
+) *pb.DeptOut {
+	var parent *pb.DeptOutBase
+	if m.Parent != nil {
+		parent = DeptModelToOutBase(*m.Parent)
+	}
+	return &pb.DeptOut{
+		Id:           m.Id,
+		TenantId:     m.TenantId,
+		CreatedAt:    m.CreatedAt.String(),
+		UpdatedAt:    m.UpdatedAt.String(),
+		Name:         m.Name,
+		ArrangeOrder: m.ArrangeOrder,
+		IsActive:     m.IsActive,
+		Descr:        m.Descr,
+		Parent:       parent,
+	}
+}
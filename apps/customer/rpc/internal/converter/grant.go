@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/pb"
+)
+
+func UserRoleGrantModelToOut(
+	m models.UserRoleGrantModel,
+) *pb.UserRoleGrantOut {
+	mo := &pb.UserRoleGrantOut{
+		Id:        m.Id,
+		TenantId:  m.TenantId,
+		CreatedAt: m.CreatedAt.String(),
+		UpdatedAt: m.UpdatedAt.String(),
+		UserId:    m.UserId,
+		StartAt:   m.StartAt.Format(time.RFC3339),
+		EndAt:     m.EndAt.Format(time.RFC3339),
+		Descr:     m.Descr,
+		Active:    m.Active(time.Now()),
+	}
+	if m.Role != nil {
+		mo.Role = RoleModelToOutBase(*m.Role)
+	}
+	return mo
+}
+
+func ListUserRoleGrantModelToOut(
+	ms []models.UserRoleGrantModel,
+) []*pb.UserRoleGrantOut {
+	mso := make([]*pb.UserRoleGrantOut, 0, len(ms))
+	for _, m := range ms {
+		mso = append(mso, UserRoleGrantModelToOut(m))
+	}
+	return mso
+}
+
+func RolePermissionGrantModelToOut(
+	m models.RolePermissionGrantModel,
+) *pb.RolePermissionGrantOut {
+	mo := &pb.RolePermissionGrantOut{
+		Id:        m.Id,
+		TenantId:  m.TenantId,
+		CreatedAt: m.CreatedAt.String(),
+		UpdatedAt: m.UpdatedAt.String(),
+		RoleId:    m.RoleId,
+		StartAt:   m.StartAt.Format(time.RFC3339),
+		EndAt:     m.EndAt.Format(time.RFC3339),
+		Descr:     m.Descr,
+		Active:    m.Active(time.Now()),
+	}
+	if m.Permission != nil {
+		mo.Permission = PermModelToOutBase(*m.Permission)
+	}
+	return mo
+}
+
+func ListRolePermissionGrantModelToOut(
+	ms []models.RolePermissionGrantModel,
+) []*pb.RolePermissionGrantOut {
+	mso := make([]*pb.RolePermissionGrantOut, 0, len(ms))
+	for _, m := range ms {
+		mso = append(mso, RolePermissionGrantModelToOut(m))
+	}
+	return mso
+}
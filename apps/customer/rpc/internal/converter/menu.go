@@ -2,6 +2,7 @@ package converter
 
 import (
 	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 )
 
@@ -9,17 +10,15 @@ func MenuModelToOutBase(
 	m models.MenuModel,
 ) *pb.MenuOutBase {
 	return &pb.MenuOutBase{
-		Id:        m.Id,
-		CreatedAt: m.CreatedAt.String(),
-		UpdatedAt: m.UpdatedAt.String(),
-		Path:      m.Path,
-		Component: m.Component,
-		Name:      m.Name,
-		Label:     m.Label,
-		Meta: &pb.MetaSchemas{
-			Title: m.Meta.Title,
-			Icon:  m.Meta.Icon,
-		},
+		Id:           m.Id,
+		TenantId:     m.TenantId,
+		CreatedAt:    m.CreatedAt.String(),
+		UpdatedAt:    m.UpdatedAt.String(),
+		Path:         m.Path,
+		Component:    m.Component,
+		Name:         m.Name,
+		Label:        m.Label,
+		Meta:         MetaToOut(m.Meta),
 		ArrangeOrder: m.ArrangeOrder,
 		IsActive:     m.IsActive,
 		Descr:        m.Descr,
@@ -47,17 +46,15 @@ func MenuModelToOut(
 		parent = MenuModelToOutBase(*m.Parent)
 	}
 	return &pb.MenuOut{
-		Id:        m.Id,
-		CreatedAt: m.CreatedAt.String(),
-		UpdatedAt: m.UpdatedAt.String(),
-		Path:      m.Path,
-		Component: m.Component,
-		Name:      m.Name,
-		Label:     m.Label,
-		Meta: &pb.MetaSchemas{
-			Title: m.Meta.Title,
-			Icon:  m.Meta.Icon,
-		},
+		Id:           m.Id,
+		TenantId:     m.TenantId,
+		CreatedAt:    m.CreatedAt.String(),
+		UpdatedAt:    m.UpdatedAt.String(),
+		Path:         m.Path,
+		Component:    m.Component,
+		Name:         m.Name,
+		Label:        m.Label,
+		Meta:         MetaToOut(m.Meta),
 		ArrangeOrder: m.ArrangeOrder,
 		IsActive:     m.IsActive,
 		Descr:        m.Descr,
@@ -65,3 +62,67 @@ func MenuModelToOut(
 		Permissions:  ListPermModelToOut(m.Permissions),
 	}
 }
+
+func MenuTreeToOut(
+	ns []*svc.MenuTreeNode,
+) []*pb.MenuTreeNode {
+	nso := make([]*pb.MenuTreeNode, 0, len(ns))
+	for _, n := range ns {
+		var parentId uint32
+		if n.Menu.ParentId != nil {
+			parentId = *n.Menu.ParentId
+		}
+		buttons := make([]*pb.MenuTreeButton, 0, len(n.Buttons))
+		for _, b := range n.Buttons {
+			buttons = append(buttons, &pb.MenuTreeButton{
+				Button:      ButtonModelToOutBase(b),
+				Permissions: ListPermModelToOut(b.Permissions),
+			})
+		}
+		nso = append(nso, &pb.MenuTreeNode{
+			Menu:        MenuModelToOutBase(n.Menu),
+			ParentId:    parentId,
+			Permissions: ListPermModelToOut(n.Menu.Permissions),
+			Buttons:     buttons,
+			Children:    MenuTreeToOut(n.Children),
+		})
+	}
+	return nso
+}
+
+func MetaToOut(
+	m models.Meta,
+) *pb.MetaSchemas {
+	return &pb.MetaSchemas{
+		Title:        m.Title,
+		Icon:         m.Icon,
+		Hidden:       m.Hidden,
+		KeepAlive:    m.KeepAlive,
+		Redirect:     m.Redirect,
+		Affix:        m.Affix,
+		ExternalLink: m.ExternalLink,
+		Badge:        m.Badge,
+		ActiveMenu:   m.ActiveMenu,
+		Titles:       m.Titles,
+	}
+}
+
+func MetaFromIn(
+	in *pb.MetaSchemas,
+) models.Meta {
+	if in == nil {
+		return models.Meta{}
+	}
+	return models.Meta{
+		Title:        in.Title,
+		Icon:         in.Icon,
+		Hidden:       in.Hidden,
+		KeepAlive:    in.KeepAlive,
+		Redirect:     in.Redirect,
+		Affix:        in.Affix,
+		ExternalLink: in.ExternalLink,
+		Badge:        in.Badge,
+		ActiveMenu:   in.ActiveMenu,
+		Titles:       in.Titles,
+	}
+}
@@ -2,6 +2,7 @@ package converter
 
 import (
 	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 )
 
@@ -9,12 +10,17 @@ func PermModelToOutBase(
 	m models.PermissionModel,
 ) *pb.PermissionOutBase {
 	return &pb.PermissionOutBase{
-		Id:        m.Id,
-		CreatedAt: m.CreatedAt.String(),
-		UpdatedAt: m.UpdatedAt.String(),
-		Url:       m.Url,
-		Method:    m.Method,
-		Descr:     m.Descr,
+		Id:         m.Id,
+		TenantId:   m.TenantId,
+		CreatedAt:  m.CreatedAt.String(),
+		UpdatedAt:  m.UpdatedAt.String(),
+		Url:        m.Url,
+		Method:     m.Method,
+		Matcher:    m.Matcher,
+		Effect:     m.Effect,
+		Label:      m.Label,
+		Descr:      m.Descr,
+		Conditions: PolicyConditionsToOut(m.Conditions),
 	}
 }
 
@@ -30,3 +36,15 @@ func ListPermModelToOut(
 	}
 	return mso
 }
+
+func PermissionSyncResultToOut(
+	res *svc.PermissionSyncResult,
+	applied bool,
+) *pb.SyncPermissionsOut {
+	return &pb.SyncPermissionsOut{
+		Applied: applied,
+		Created: ListPermModelToOut(res.Created),
+		Updated: ListPermModelToOut(res.Updated),
+		Stale:   ListPermModelToOut(res.Stale),
+	}
+}
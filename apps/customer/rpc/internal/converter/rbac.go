@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+)
+
+func RbacImportResultToOut(
+	res *svc.RbacImportResult,
+	applied bool,
+) *pb.ImportRbacOut {
+	changes := make([]*pb.RbacChange, 0, len(res.Changes))
+	for _, c := range res.Changes {
+		changes = append(changes, &pb.RbacChange{
+			Kind:   c.Kind,
+			Key:    c.Key,
+			Action: c.Action,
+			Fields: c.Fields,
+			Reason: c.Reason,
+		})
+	}
+	return &pb.ImportRbacOut{
+		Applied: applied,
+		Changes: changes,
+	}
+}
@@ -1,7 +1,10 @@
 package converter
 
 import (
+	"time"
+
 	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 )
 
@@ -9,11 +12,14 @@ func RoleModelToOutBase(
 	m models.RoleModel,
 ) *pb.RoleOutBase {
 	return &pb.RoleOutBase{
-		Id:        m.Id,
-		CreatedAt: m.CreatedAt.String(),
-		UpdatedAt: m.UpdatedAt.String(),
-		Name:      m.Name,
-		Descr:     m.Descr,
+		Id:         m.Id,
+		TenantId:   m.TenantId,
+		CreatedAt:  m.CreatedAt.String(),
+		UpdatedAt:  m.UpdatedAt.String(),
+		Name:       m.Name,
+		Descr:      m.Descr,
+		DataScope:  m.DataScope,
+		Conditions: PolicyConditionsToOut(m.Conditions),
 	}
 }
 
@@ -34,13 +40,80 @@ func RoleModelToOut(
 	m models.RoleModel,
 ) *pb.RoleOut {
 	return &pb.RoleOut{
-		Id:          m.Id,
-		CreatedAt:   m.CreatedAt.String(),
-		UpdatedAt:   m.UpdatedAt.String(),
-		Name:        m.Name,
-		Descr:       m.Descr,
-		Permissions: ListPermModelToOut(m.Permissions),
-		Menus:       ListMenuModelToOutBase(m.Menus),
-		Buttons:     ListButtonModelToOutBase(m.Buttons),
+		Id:              m.Id,
+		TenantId:        m.TenantId,
+		CreatedAt:       m.CreatedAt.String(),
+		UpdatedAt:       m.UpdatedAt.String(),
+		Name:            m.Name,
+		Descr:           m.Descr,
+		Permissions:     ListPermModelToOut(m.Permissions),
+		Menus:           ListMenuModelToOutBase(m.Menus),
+		Buttons:         ListButtonModelToOutBase(m.Buttons),
+		Parents:         ListRoleModelToOutBase(m.Parents),
+		DataScope:       m.DataScope,
+		Depts:           ListDeptModelToOutBase(m.Depts),
+		DenyPermissions: ListPermModelToOut(m.DenyPermissions),
+		Conditions:      PolicyConditionsToOut(m.Conditions),
+	}
+}
+
+func RoleImpactToOut(
+	impact *svc.RoleImpact,
+) *pb.RoleImpactOut {
+	users := make([]*pb.RoleImpactUser, 0, len(impact.Users))
+	for _, m := range impact.Users {
+		users = append(users, &pb.RoleImpactUser{
+			Id:       m.Id,
+			Username: m.Username,
+			IsActive: m.IsActive,
+			TenantId: m.TenantId,
+		})
+	}
+	now := time.Now()
+	grants := make([]*pb.RoleImpactGrant, 0, len(impact.UserGrants))
+	for _, m := range impact.UserGrants {
+		grants = append(grants, &pb.RoleImpactGrant{
+			Id:      m.Id,
+			UserId:  m.UserId,
+			StartAt: m.StartAt.Format(time.RFC3339),
+			EndAt:   m.EndAt.Format(time.RFC3339),
+			Active:  m.Active(now),
+		})
+	}
+	return &pb.RoleImpactOut{
+		Role:            RoleModelToOutBase(impact.Role),
+		Users:           users,
+		Grants:          grants,
+		Children:        ListRoleModelToOutBase(impact.Children),
+		Menus:           ListMenuModelToOutBase(impact.Role.Menus),
+		Buttons:         ListButtonModelToOutBase(impact.Role.Buttons),
+		Permissions:     ListPermModelToOut(impact.Role.Permissions),
+		DenyPermissions: ListPermModelToOut(impact.Role.DenyPermissions),
+	}
+}
+
+func RoleDiffToOut(base models.RoleModel, d svc.RoleDiff) *pb.RoleDiffOut {
+	out := &pb.RoleDiffOut{
+		Base:       RoleModelToOutBase(base),
+		Fields:     make([]*pb.RoleFieldChange, 0, len(d.Fields)),
+		Categories: make([]*pb.RoleDiffCategory, 0, len(d.Categories)),
+	}
+	for _, f := range d.Fields {
+		out.Fields = append(out.Fields, &pb.RoleFieldChange{Field: f.Field, From: f.From, To: f.To})
+	}
+	entriesToOut := func(es []svc.RoleDiffEntry) []*pb.RoleDiffEntry {
+		eso := make([]*pb.RoleDiffEntry, 0, len(es))
+		for _, e := range es {
+			eso = append(eso, &pb.RoleDiffEntry{Id: e.Id, Name: e.Name})
+		}
+		return eso
+	}
+	for _, c := range d.Categories {
+		out.Categories = append(out.Categories, &pb.RoleDiffCategory{
+			Category: c.Category,
+			Added:    entriesToOut(c.Added),
+			Removed:  entriesToOut(c.Removed),
+		})
 	}
+	return out
 }
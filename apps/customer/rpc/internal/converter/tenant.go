@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/pb"
+)
+
+func TenantModelToOut(
+	m models.TenantModel,
+) *pb.TenantOut {
+	return &pb.TenantOut{
+		Id:        m.Id,
+		CreatedAt: m.CreatedAt.String(),
+		UpdatedAt: m.UpdatedAt.String(),
+		Name:      m.Name,
+		IsActive:  m.IsActive,
+		Descr:     m.Descr,
+	}
+}
+
+func ListTenantModelToOut(
+	ms []models.TenantModel,
+) []*pb.TenantOut {
+	mso := make([]*pb.TenantOut, 0, len(ms))
+	if len(ms) > 0 {
+		for _, m := range ms {
+			mo := TenantModelToOut(m)
+			mso = append(mso, mo)
+		}
+	}
+	return mso
+}
@@ -8,14 +8,20 @@ import (
 func UserModelToOut(
 	m models.UserModel,
 ) *pb.UserOut {
+	var dept *pb.DeptOutBase
+	if m.Dept != nil {
+		dept = DeptModelToOutBase(*m.Dept)
+	}
 	return &pb.UserOut{
 		Id:        m.Id,
+		TenantId:  m.TenantId,
 		CreatedAt: m.CreatedAt.String(),
 		UpdatedAt: m.UpdatedAt.String(),
 		Username:  m.Username,
 		IsActive:  m.IsActive,
 		IsStaff:   m.IsStaff,
-		Role:      RoleModelToOutBase(m.Role),
+		Roles:     ListRoleModelToOutBase(m.Roles),
+		Dept:      dept,
 	}
 }
 
@@ -0,0 +1,45 @@
+package authlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckLogic {
+	return &CheckLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CheckLogic) Check(in *pb.CheckRequest) (*pb.CheckOut, error) {
+	// todo: add your logic here and delete this line
+	claims, reason, err := introspect(l.ctx, l.svcCtx.Enforce(), in.Token)
+	if err != nil {
+		return nil, err
+	}
+	if claims == nil {
+		return &pb.CheckOut{Reason: reason}, nil
+	}
+	allowed, err := authorize(l.svcCtx.Enforce(), claims, in.Ip, []*pb.CheckItem{{Obj: in.Obj, Act: in.Act}})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckOut{
+		Active:  true,
+		Claims:  converter.UserClaimsToOut(claims),
+		Allowed: allowed[0],
+	}, nil
+}
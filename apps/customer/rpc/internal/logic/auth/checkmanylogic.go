@@ -0,0 +1,45 @@
+package authlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckManyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckManyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckManyLogic {
+	return &CheckManyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CheckManyLogic) CheckMany(in *pb.CheckManyRequest) (*pb.CheckManyOut, error) {
+	// todo: add your logic here and delete this line
+	claims, reason, err := introspect(l.ctx, l.svcCtx.Enforce(), in.Token)
+	if err != nil {
+		return nil, err
+	}
+	if claims == nil {
+		return &pb.CheckManyOut{Reason: reason}, nil
+	}
+	allowed, err := authorize(l.svcCtx.Enforce(), claims, in.Ip, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CheckManyOut{
+		Active:  true,
+		Claims:  converter.UserClaimsToOut(claims),
+		Allowed: allowed,
+	}, nil
+}
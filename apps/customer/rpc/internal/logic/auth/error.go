@@ -0,0 +1,22 @@
+package authlogic
+
+import (
+	"net/http"
+
+	"gz-dango/pkg/errors"
+)
+
+var (
+	ErrInvalidClientIP = errors.New(
+		http.StatusBadRequest,
+		"invalid_client_ip",
+		"客户端IP格式无效",
+		nil,
+	)
+	ErrCheckFailed = errors.New(
+		http.StatusInternalServerError,
+		"check_failed",
+		"鉴权失败",
+		nil,
+	)
+)
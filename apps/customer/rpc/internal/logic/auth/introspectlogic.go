@@ -0,0 +1,38 @@
+package authlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type IntrospectLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewIntrospectLogic(ctx context.Context, svcCtx *svc.ServiceContext) *IntrospectLogic {
+	return &IntrospectLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *IntrospectLogic) Introspect(in *pb.IntrospectRequest) (*pb.IntrospectOut, error) {
+	// todo: add your logic here and delete this line
+	claims, reason, err := introspect(l.ctx, l.svcCtx.Enforce(), in.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IntrospectOut{
+		Active: claims != nil,
+		Reason: reason,
+		Claims: converter.UserClaimsToOut(claims),
+	}, nil
+}
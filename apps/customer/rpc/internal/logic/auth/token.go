@@ -0,0 +1,56 @@
+package authlogic
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+)
+
+// introspect 校验令牌，令牌本身无效时返回无效的原因，其他错误（如黑名单查询失败）直接返回
+func introspect(ctx context.Context, e *auth.AuthEnforcer, token string) (*auth.UserClaims, string, error) {
+	claims, err := e.Authentication(ctx, token)
+	if err != nil {
+		if reason, ok := auth.InactiveReason(err); ok {
+			return nil, reason, nil
+		}
+		return nil, "", err
+	}
+	return claims, "", nil
+}
+
+// requestEnv 构建鉴权所需的请求上下文属性，ip 为空时不满足网段条件
+func requestEnv(claims *auth.UserClaims, ip string) (*auth.RequestEnv, error) {
+	env := &auth.RequestEnv{
+		Time:    time.Now(),
+		IsStaff: claims.IsStaff,
+	}
+	if ip != "" {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			return nil, ErrInvalidClientIP.WithCause(err)
+		}
+		env.IP = addr
+	}
+	return env, nil
+}
+
+// authorize 检查用户能否访问 items 中的每一项，返回的结果与 items 一一对应
+func authorize(e *auth.AuthEnforcer, claims *auth.UserClaims, ip string, items []*pb.CheckItem) ([]bool, error) {
+	env, err := requestEnv(claims, ip)
+	if err != nil {
+		return nil, err
+	}
+	sub, dom := auth.UserSubject(claims.UserId), auth.TenantDomain(claims.TenantId)
+	allowed := make([]bool, 0, len(items))
+	for _, item := range items {
+		ok, aerr := e.Authorization(sub, dom, item.Obj, item.Act, env)
+		if aerr != nil {
+			return nil, ErrCheckFailed.WithCause(aerr)
+		}
+		allowed = append(allowed, ok)
+	}
+	return allowed, nil
+}
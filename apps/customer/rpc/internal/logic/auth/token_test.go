@@ -0,0 +1,172 @@
+package authlogic
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testKey = "test"
+
+type staticLoader struct {
+	policies, groupings [][]string
+}
+
+func (l staticLoader) LoadRules(ctx context.Context) ([][]string, [][]string, error) {
+	return l.policies, l.groupings, nil
+}
+
+// failingBlacklist 黑名单查询总是失败
+type failingBlacklist struct {
+	auth.BlacklistManager
+}
+
+func (failingBlacklist) Contains(ctx context.Context, token string) (bool, error) {
+	return false, errors.New("redis down")
+}
+
+// newTestEnforcer 用户1在租户1中拥有 role_1，role_1 可以访问 ListCustomer，并且仅在内网可以访问 GetCustomer
+func newTestEnforcer(t *testing.T) *auth.AuthEnforcer {
+	t.Helper()
+	m, err := auth.NewModel("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := casbin.NewEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := auth.NewAuthEnforcer(e, testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cond, err := auth.EncodeConditions(auth.Conditions{CIDRs: []string{"10.0.0.0/8"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	loader := staticLoader{
+		policies: [][]string{
+			auth.PolicyRule("1", auth.PlatformDomain, "/customer.User/ListCustomer", auth.MethodGrpc, auth.MatchExact, auth.EffectAllow, ""),
+			auth.PolicyRule("2", auth.PlatformDomain, "/customer.User/GetCustomer", auth.MethodGrpc, auth.MatchExact, auth.EffectAllow, auth.JoinConditions(cond)),
+		},
+		groupings: [][]string{
+			{auth.UserSubject(1), "role_1", auth.TenantDomain(1)},
+			{"role_1", "1", auth.PlatformDomain},
+			{"role_1", "2", auth.PlatformDomain},
+		},
+	}
+	if _, err := enforcer.Reload(context.Background(), loader); err != nil {
+		t.Fatal(err)
+	}
+	return enforcer
+}
+
+func newTestToken(t *testing.T, key string, expiresAt time.Time) string {
+	t.Helper()
+	token, err := auth.NewJWT([]byte(key), auth.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(expiresAt)},
+		UserId:           1,
+		TenantId:         1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestIntrospect(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnforcer(t)
+	blacklist := auth.NewMemoryBlacklist("")
+	e.SetBlacklist(blacklist)
+	valid := newTestToken(t, testKey, time.Now().Add(time.Hour))
+	revoked := newTestToken(t, testKey, time.Now().Add(2*time.Hour))
+	if err := blacklist.Add(ctx, revoked, 60); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name   string
+		token  string
+		reason string
+	}{
+		{"valid", valid, ""},
+		{"expired", newTestToken(t, testKey, time.Now().Add(-time.Hour)), auth.InactiveExpired},
+		{"wrong key", newTestToken(t, "other", time.Now().Add(time.Hour)), auth.InactiveInvalid},
+		{"malformed", "not-a-jwt", auth.InactiveInvalid},
+		{"revoked", revoked, auth.InactiveRevoked},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			claims, reason, err := introspect(ctx, e, c.token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reason != c.reason {
+				t.Errorf("reason = %q, want %q", reason, c.reason)
+			}
+			if (claims != nil) != (c.reason == "") {
+				t.Errorf("claims = %+v with reason %q", claims, reason)
+			}
+			if claims != nil && (claims.UserId != 1 || claims.TenantId != 1) {
+				t.Errorf("claims = %+v", claims)
+			}
+		})
+	}
+
+	// 黑名单查询失败不是令牌无效，直接返回错误
+	e.SetBlacklist(failingBlacklist{})
+	if _, _, err := introspect(ctx, e, valid); err == nil {
+		t.Error("expected error when blacklist lookup fails")
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	e := newTestEnforcer(t)
+	claims := &auth.UserClaims{UserId: 1, TenantId: 1}
+	items := []*pb.CheckItem{
+		{Obj: "/customer.User/ListCustomer", Act: auth.MethodGrpc},
+		{Obj: "/customer.User/GetCustomer", Act: auth.MethodGrpc},
+		{Obj: "/customer.User/DeleteUser", Act: auth.MethodGrpc},
+	}
+	cases := []struct {
+		name string
+		ip   string
+		want []bool
+	}{
+		{"internal ip", "10.1.2.3", []bool{true, true, false}},
+		{"external ip", "192.168.0.1", []bool{true, false, false}},
+		{"unknown ip", "", []bool{true, false, false}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := authorize(e, claims, c.ip, items)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, c.want) {
+				t.Errorf("authorize = %v, want %v", got, c.want)
+			}
+		})
+	}
+
+	// 其他租户的用户不继承租户1的角色
+	got, err := authorize(e, &auth.UserClaims{UserId: 1, TenantId: 2}, "10.1.2.3", items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []bool{false, false, false}; !slices.Equal(got, want) {
+		t.Errorf("authorize in tenant 2 = %v, want %v", got, want)
+	}
+
+	if _, err := authorize(e, claims, "not-an-ip", items); !errors.Is(err, ErrInvalidClientIP) {
+		t.Errorf("err = %v, want ErrInvalidClientIP", err)
+	}
+}
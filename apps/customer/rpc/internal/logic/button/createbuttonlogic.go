@@ -29,6 +29,9 @@ func NewCreateButtonLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Crea
 
 func (l *CreateButtonLogic) CreateButton(in *pb.CreateButtonRequest) (*pb.ButtonOut, error) {
 	// todo: add your logic here and delete this line
+	if !models.ValidButtonCode(in.Code) {
+		return nil, ErrInvalidButtonCode
+	}
 	mm, err := l.svcCtx.Menu.FindModel(l.ctx, nil, in.MenuId)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
@@ -38,11 +41,13 @@ func (l *CreateButtonLogic) CreateButton(in *pb.CreateButtonRequest) (*pb.Button
 			BaseModel: database.BaseModel{Id: in.Id},
 		},
 		Name:         in.Name,
+		Code:         in.Code,
 		ArrangeOrder: in.ArrangeOrder,
 		IsActive:     in.IsActive,
 		Descr:        in.Descr,
 		MenuId:       in.MenuId,
 		Menu:         *mm,
+		TenantId:     in.TenantId,
 	}
 	pms, err := l.svcCtx.Perm.ListModelByIds(l.ctx, in.PermissionIds)
 	if err != nil {
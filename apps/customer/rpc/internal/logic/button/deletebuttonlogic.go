@@ -3,6 +3,7 @@ package buttonlogic
 import (
 	"context"
 
+	"gz-dango/apps/customer/rpc/internal/converter"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 	"gz-dango/pkg/auth"
@@ -25,12 +26,25 @@ func NewDeleteButtonLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Dele
 	}
 }
 
-func (l *DeleteButtonLogic) DeleteButton(in *pb.DeleteButtonRequest) (*pb.NilOut, error) {
+func (l *DeleteButtonLogic) DeleteButton(in *pb.DeleteButtonRequest) (*pb.DeleteOut, error) {
 	// todo: add your logic here and delete this line
 	m, err := l.svcCtx.Button.FindModel(l.ctx, nil, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
+	if !auth.CanWriteTenant(l.ctx, m.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	deps, err := l.svcCtx.Button.Dependents(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(deps, true, false), nil
+	}
+	if len(deps) > 0 && !in.Force {
+		return nil, ErrButtonHasDependents.WithData(deps.Counts())
+	}
 	if err := l.svcCtx.Button.DeleteModel(l.ctx, in.Pk); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
@@ -40,5 +54,5 @@ func (l *DeleteButtonLogic) DeleteButton(in *pb.DeleteButtonRequest) (*pb.NilOut
 	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
 		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
 	}
-	return &pb.NilOut{}, nil
+	return converter.DependentsToDeleteOut(deps, false, true), nil
 }
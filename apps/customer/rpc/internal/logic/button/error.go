@@ -19,4 +19,16 @@ var (
 		"删除按钮策略失败",
 		nil,
 	)
+	ErrInvalidButtonCode = errors.New(
+		http.StatusBadRequest,
+		"invalid_button_code",
+		"按钮的权限标识由冒号分隔的字母、数字、下划线或连字符组成，如 user:create",
+		nil,
+	)
+	ErrButtonHasDependents = errors.New(
+		http.StatusConflict,
+		"button_has_dependents",
+		"按钮仍被授予角色，需强制删除",
+		nil,
+	)
 )
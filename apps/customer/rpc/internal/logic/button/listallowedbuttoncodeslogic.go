@@ -0,0 +1,44 @@
+package buttonlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAllowedButtonCodesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListAllowedButtonCodesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAllowedButtonCodesLogic {
+	return &ListAllowedButtonCodesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListAllowedButtonCodesLogic) ListAllowedButtonCodes(in *pb.ListAllowedButtonCodesRequest) (*pb.AllowedButtonCodesOut, error) {
+	// todo: add your logic here and delete this line
+	uc, err := auth.GetUserClaims(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	ms, err := l.svcCtx.Button.ListAllowedModels(
+		l.ctx,
+		auth.UserSubject(uc.UserId),
+		auth.TenantDomain(uc.TenantId),
+	)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return &pb.AllowedButtonCodesOut{Items: converter.ButtonCodesByMenu(ms)}, nil
+}
@@ -81,9 +81,15 @@ func (l *ListButtonLogic) ListButton(in *pb.ListButtonRequest) (*pb.PagButtonOut
 	if in.Descr != "" {
 		query["descr like ?"] = "%" + in.Descr + "%"
 	}
+	if in.Code != "" {
+		query["code = ?"] = in.Code
+	}
 	if in.MenuId != 0 {
 		query["menu_id = ?"] = in.MenuId
 	}
+	if in.TenantId != nil {
+		query["tenant_id = ?"] = in.TenantId.GetValue()
+	}
 	qp := database.QueryParams{
 		Preloads: []string{},
 		Query:    query,
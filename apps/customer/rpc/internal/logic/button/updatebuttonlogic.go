@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/models"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 	"gz-dango/pkg/auth"
@@ -29,20 +30,31 @@ func NewUpdateButtonLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Upda
 
 func (l *UpdateButtonLogic) UpdateButton(in *pb.UpdateButtonRequest) (*pb.ButtonOut, error) {
 	// todo: add your logic here and delete this line
+	if !models.ValidButtonCode(in.Code) {
+		return nil, ErrInvalidButtonCode
+	}
+	om, err := l.svcCtx.Button.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if !auth.CanWriteTenant(l.ctx, om.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
 	data := map[string]any{
-		"update_at":     time.Now(),
+		"updated_at":    time.Now(),
 		"name":          in.Name,
 		"arrange_order": in.ArrangeOrder,
 		"is_active":     in.IsActive,
 		"descr":         in.Descr,
 		"menu_id":       in.MenuId,
+		"code":          buttonCodeColumn(in.Code),
 	}
 
 	pms, err := l.svcCtx.Perm.ListModelByIds(l.ctx, in.PermissionIds)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	if err := l.svcCtx.Button.UpdateModel(l.ctx, data, map[string]any{"permissions": pms}); err != nil {
+	if err := l.svcCtx.Button.UpdateModel(l.ctx, data, map[string]any{"Permissions": pms}, "id = ?", in.Pk); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
 	m, err := l.svcCtx.Button.FindModel(l.ctx, []string{"Menu", "Permissions"}, in.Pk)
@@ -60,3 +72,11 @@ func (l *UpdateButtonLogic) UpdateButton(in *pb.UpdateButtonRequest) (*pb.Button
 	}
 	return converter.ButtonModelToOut(*m), nil
 }
+
+// buttonCodeColumn 返回权限标识的列值，未设置时保存为NULL，不参与同一菜单下的唯一约束
+func buttonCodeColumn(code string) any {
+	if code == "" {
+		return nil
+	}
+	return code
+}
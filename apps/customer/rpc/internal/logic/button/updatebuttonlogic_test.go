@@ -0,0 +1,12 @@
+package buttonlogic
+
+import "testing"
+
+func TestButtonCodeColumn(t *testing.T) {
+	if v := buttonCodeColumn(""); v != nil {
+		t.Errorf("buttonCodeColumn(\"\") = %#v, want nil", v)
+	}
+	if v := buttonCodeColumn("user:create"); v != "user:create" {
+		t.Errorf("buttonCodeColumn(\"user:create\") = %#v", v)
+	}
+}
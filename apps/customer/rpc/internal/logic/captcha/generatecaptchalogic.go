@@ -0,0 +1,38 @@
+package captchalogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GenerateCaptchaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGenerateCaptchaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateCaptchaLogic {
+	return &GenerateCaptchaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GenerateCaptchaLogic) GenerateCaptcha(in *pb.GenerateCaptchaRequest) (*pb.CaptchaOut, error) {
+	item, err := l.svcCtx.Captcha.Generate(l.ctx, in.Type)
+	if err != nil {
+		return nil, errors.FromError(err)
+	}
+	return &pb.CaptchaOut{
+		CaptchaId: item.Id,
+		Type:      item.Type,
+		Image:     item.Image,
+		Expire:    int64(item.Expire),
+	}, nil
+}
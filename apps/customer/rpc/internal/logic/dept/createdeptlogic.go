@@ -0,0 +1,54 @@
+package deptlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateDeptLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateDeptLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateDeptLogic {
+	return &CreateDeptLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateDeptLogic) CreateDept(in *pb.CreateDeptRequest) (*pb.DeptOut, error) {
+	// todo: add your logic here and delete this line
+	m := models.DeptModel{
+		Name:         in.Name,
+		ArrangeOrder: in.ArrangeOrder,
+		IsActive:     in.IsActive,
+		Descr:        in.Descr,
+		TenantId:     in.TenantId,
+	}
+	if in.ParentId != 0 {
+		// 只能在数据范围内的部门下创建子部门
+		if err := l.svcCtx.Scope.CheckDept(l.ctx, in.ParentId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		parent, err := l.svcCtx.Dept.FindModel(l.ctx, nil, in.ParentId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		m.ParentId = &in.ParentId
+		m.Parent = parent
+	}
+	if err := l.svcCtx.Dept.CreateModel(l.ctx, &m); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.DeptModelToOut(m), nil
+}
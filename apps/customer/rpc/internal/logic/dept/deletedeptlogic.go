@@ -0,0 +1,51 @@
+package deptlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteDeptLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteDeptLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteDeptLogic {
+	return &DeleteDeptLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeleteDeptLogic) DeleteDept(in *pb.DeleteDeptRequest) (*pb.DeleteOut, error) {
+	// todo: add your logic here and delete this line
+	if err := l.svcCtx.Scope.CheckDept(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	m, err := l.svcCtx.Dept.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	deps, err := l.svcCtx.Dept.Dependents(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(deps, true, false), nil
+	}
+	if len(deps) > 0 && !in.Force {
+		return nil, ErrDeptHasDependents.WithData(deps.Counts())
+	}
+	if err := l.svcCtx.Dept.DeleteModel(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.DependentsToDeleteOut(deps, false, true), nil
+}
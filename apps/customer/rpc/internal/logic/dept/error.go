@@ -0,0 +1,22 @@
+package deptlogic
+
+import (
+	"net/http"
+
+	"gz-dango/pkg/errors"
+)
+
+var (
+	ErrDeptCycle = errors.New(
+		http.StatusBadRequest,
+		"dept_cycle",
+		"部门的上级部门不能是其自身或下级部门",
+		nil,
+	)
+	ErrDeptHasDependents = errors.New(
+		http.StatusConflict,
+		"dept_has_dependents",
+		"部门仍有下级部门、用户或被角色的数据权限引用，需强制删除",
+		nil,
+	)
+)
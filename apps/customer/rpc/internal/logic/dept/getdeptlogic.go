@@ -0,0 +1,38 @@
+package deptlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDeptLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDeptLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDeptLogic {
+	return &GetDeptLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetDeptLogic) GetDept(in *pb.GetDeptRequest) (*pb.DeptOut, error) {
+	// todo: add your logic here and delete this line
+	if err := l.svcCtx.Scope.CheckDept(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	m, err := l.svcCtx.Dept.FindModel(l.ctx, []string{"Parent"}, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.DeptModelToOut(*m), nil
+}
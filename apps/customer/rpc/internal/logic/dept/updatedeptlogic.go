@@ -0,0 +1,66 @@
+package deptlogic
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateDeptLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateDeptLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateDeptLogic {
+	return &UpdateDeptLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateDeptLogic) UpdateDept(in *pb.UpdateDeptRequest) (*pb.DeptOut, error) {
+	// todo: add your logic here and delete this line
+	if err := l.svcCtx.Scope.CheckDept(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	data := map[string]any{
+		"updated_at":    time.Now(),
+		"name":          in.Name,
+		"arrange_order": in.ArrangeOrder,
+		"is_active":     in.IsActive,
+		"descr":         in.Descr,
+		"parent_id":     nil,
+	}
+	if in.ParentId != 0 {
+		if err := l.svcCtx.Scope.CheckDept(l.ctx, in.ParentId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		if _, err := l.svcCtx.Dept.FindModel(l.ctx, nil, in.ParentId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		hasCycle, err := l.svcCtx.Dept.HasCycle(l.ctx, in.Pk, in.ParentId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		if hasCycle {
+			return nil, ErrDeptCycle
+		}
+		data["parent_id"] = in.ParentId
+	}
+	if err := l.svcCtx.Dept.UpdateModel(l.ctx, data, "id = ?", in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	m, err := l.svcCtx.Dept.FindModel(l.ctx, []string{"Parent"}, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.DeptModelToOut(*m), nil
+}
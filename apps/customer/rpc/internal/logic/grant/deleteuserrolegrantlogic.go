@@ -0,0 +1,52 @@
+package grantlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteUserRoleGrantLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteUserRoleGrantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteUserRoleGrantLogic {
+	return &DeleteUserRoleGrantLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeleteUserRoleGrantLogic) DeleteUserRoleGrant(in *pb.DeleteGrantRequest) (*pb.DeleteOut, error) {
+	// todo: add your logic here and delete this line
+	m, err := l.svcCtx.UserGrant.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if !auth.CanWriteTenant(l.ctx, m.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	// 临时授权没有依赖项，试运行只确认授权存在且可删除
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(nil, true, false), nil
+	}
+	if err := l.svcCtx.UserGrant.DeleteModel(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if err := l.svcCtx.User.ResetGroupPolicy(l.ctx, []uint32{m.UserId}); err != nil {
+		return nil, ErrResetGrantPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
+	return converter.DependentsToDeleteOut(nil, false, true), nil
+}
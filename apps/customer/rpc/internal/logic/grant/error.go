@@ -0,0 +1,28 @@
+package grantlogic
+
+import (
+	"net/http"
+
+	"gz-dango/pkg/errors"
+)
+
+var (
+	ErrInvalidGrantWindow = errors.New(
+		http.StatusBadRequest,
+		"invalid_grant_window",
+		"临时授权的有效期无效，失效时间需晚于生效时间和当前时间",
+		nil,
+	)
+	ErrGrantTenantMismatch = errors.New(
+		http.StatusBadRequest,
+		"grant_tenant_mismatch",
+		"不能临时授予其他租户的角色或权限",
+		nil,
+	)
+	ErrResetGrantPolicy = errors.New(
+		http.StatusInternalServerError,
+		"reset_grant_policy_failed",
+		"更新临时授权策略失败",
+		nil,
+	)
+)
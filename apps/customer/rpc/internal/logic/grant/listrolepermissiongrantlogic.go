@@ -0,0 +1,73 @@
+package grantlogic
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRolePermissionGrantLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListRolePermissionGrantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRolePermissionGrantLogic {
+	return &ListRolePermissionGrantLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListRolePermissionGrantLogic) ListRolePermissionGrant(in *pb.ListRolePermissionGrantRequest) (*pb.PagRolePermissionGrantOut, error) {
+	// todo: add your logic here and delete this line
+	var (
+		page int = database.DefaultPage
+		size int = database.DefaultSize
+	)
+	if in.Page > 1 {
+		page = int(in.Page)
+	}
+	if in.Size > 0 {
+		size = int(in.Size)
+	}
+	query := make(map[string]any, 4)
+	if in.RoleId > 0 {
+		query["role_id = ?"] = in.RoleId
+	}
+	if in.PermissionId > 0 {
+		query["permission_id = ?"] = in.PermissionId
+	}
+	if in.Active {
+		now := time.Now()
+		query["start_at <= ?"] = now
+		query["end_at > ?"] = now
+	}
+	qp := database.QueryParams{
+		Preloads: []string{"Permission"},
+		Query:    query,
+		OrderBy:  []string{"id"},
+		Limit:    max(size, 0),
+		Offset:   max(page-1, 0),
+		IsCount:  true,
+	}
+	count, ms, err := l.svcCtx.RoleGrant.ListModel(l.ctx, qp)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	mso := converter.ListRolePermissionGrantModelToOut(ms)
+	return &pb.PagRolePermissionGrantOut{
+		Items: mso,
+		Page:  int64(page),
+		Pages: database.CountPages(count, int64(size)),
+		Size:  int64(size),
+		Total: count,
+	}, nil
+}
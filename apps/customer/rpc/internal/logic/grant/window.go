@@ -0,0 +1,27 @@
+package grantlogic
+
+import (
+	"fmt"
+	"time"
+)
+
+// parseGrantWindow 解析临时授权的有效期，生效时间为空时立即生效
+func parseGrantWindow(startAt, endAt string) (time.Time, time.Time, error) {
+	now := time.Now()
+	start := now
+	if startAt != "" {
+		t, err := time.Parse(time.RFC3339, startAt)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start_at %q: %w", startAt, err)
+		}
+		start = t
+	}
+	end, err := time.Parse(time.RFC3339, endAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end_at %q: %w", endAt, err)
+	}
+	if !end.After(start) || !end.After(now) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end_at must be after start_at and now")
+	}
+	return start, end, nil
+}
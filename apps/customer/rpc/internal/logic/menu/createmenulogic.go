@@ -2,6 +2,7 @@ package menulogic
 
 import (
 	"context"
+	stderrors "errors"
 
 	"gz-dango/apps/customer/rpc/internal/converter"
 	"gz-dango/apps/customer/rpc/internal/models"
@@ -33,17 +34,19 @@ func (l *CreateMenuLogic) CreateMenu(in *pb.CreateMenuRequest) (*pb.MenuOut, err
 		StandardModel: database.StandardModel{
 			BaseModel: database.BaseModel{Id: in.Id},
 		},
-		Path:      in.Path,
-		Component: in.Component,
-		Name:      in.Name,
-		Label:     in.Label,
-		Meta: models.Meta{
-			Icon:  in.Meta.Icon,
-			Title: in.Meta.Title,
-		},
+		Path:         in.Path,
+		Component:    in.Component,
+		Name:         in.Name,
+		Label:        in.Label,
+		Meta:         converter.MetaFromIn(in.Meta),
 		ArrangeOrder: in.ArrangeOrder,
 		IsActive:     in.IsActive,
 		Descr:        in.Descr,
+		TenantId:     in.TenantId,
+	}
+	m.Meta.SetDefaults(m.Name)
+	if err := m.Meta.Validate(); err != nil {
+		return nil, ErrInvalidMenuMeta.WithCause(err)
 	}
 	if in.ParentId != 0 {
 		parent, err := l.svcCtx.Menu.FindModel(l.ctx, nil, in.ParentId)
@@ -61,6 +64,9 @@ func (l *CreateMenuLogic) CreateMenu(in *pb.CreateMenuRequest) (*pb.MenuOut, err
 		m.Permissions = pms
 	}
 	if err := l.svcCtx.Menu.CreateModel(l.ctx, &m); err != nil {
+		if stderrors.Is(err, svc.ErrMenuCycle) {
+			return nil, ErrMenuCycle
+		}
 		return nil, database.NewGormError(err, nil)
 	}
 	if err := l.svcCtx.Menu.AddGroupPolicy(l.ctx, m); err != nil {
@@ -3,6 +3,7 @@ package menulogic
 import (
 	"context"
 
+	"gz-dango/apps/customer/rpc/internal/converter"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 	"gz-dango/pkg/auth"
@@ -25,20 +26,52 @@ func NewDeleteMenuLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Delete
 	}
 }
 
-func (l *DeleteMenuLogic) DeleteMenu(in *pb.DeleteMenuRequest) (*pb.NilOut, error) {
+func (l *DeleteMenuLogic) DeleteMenu(in *pb.DeleteMenuRequest) (*pb.DeleteOut, error) {
 	// todo: add your logic here and delete this line
 	m, err := l.svcCtx.Menu.FindModel(l.ctx, nil, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
+	if !auth.CanWriteTenant(l.ctx, m.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	deps, err := l.svcCtx.Menu.Dependents(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(deps, true, false), nil
+	}
+	if len(deps) > 0 && !in.Force {
+		return nil, ErrMenuHasDependents.WithData(deps.Counts())
+	}
+	// 下级菜单和按钮随之级联删除，需在删除前查询以便一并删除其策略
+	subMenus, err := l.svcCtx.Menu.ListModelByIds(l.ctx, deps.Ids(svc.DependentMenu))
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	subButtons, err := l.svcCtx.Button.ListModelByIds(l.ctx, deps.Ids(svc.DependentButton))
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
 	if err := l.svcCtx.Menu.DeleteModel(l.ctx, in.Pk); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
 	if err := l.svcCtx.Menu.RemoveGroupPolicy(l.ctx, *m, true); err != nil {
 		return nil, ErrRemoveMenuPolicy.WithCause(err)
 	}
+	for _, sm := range subMenus {
+		if err := l.svcCtx.Menu.RemoveGroupPolicy(l.ctx, sm, true); err != nil {
+			return nil, ErrRemoveMenuPolicy.WithCause(err)
+		}
+	}
+	for _, sb := range subButtons {
+		if err := l.svcCtx.Button.RemoveGroupPolicy(l.ctx, sb, true); err != nil {
+			return nil, ErrRemoveMenuPolicy.WithCause(err)
+		}
+	}
 	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
 		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
 	}
-	return &pb.NilOut{}, nil
+	return converter.DependentsToDeleteOut(deps, false, true), nil
 }
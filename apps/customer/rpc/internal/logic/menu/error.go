@@ -19,4 +19,46 @@ var (
 		"删除菜单策略失败",
 		nil,
 	)
+	ErrInvalidMenuTreeRequest = errors.New(
+		http.StatusBadRequest,
+		"invalid_menu_tree_request",
+		"不能同时指定角色和调用方角色",
+		nil,
+	)
+	ErrInvalidMenuRoutesRequest = errors.New(
+		http.StatusBadRequest,
+		"invalid_menu_routes_request",
+		"需指定角色或调用方角色中的一个",
+		nil,
+	)
+	ErrMenuCycle = errors.New(
+		http.StatusBadRequest,
+		"menu_cycle",
+		"菜单的父级不能是自身或其下级菜单",
+		nil,
+	)
+	ErrInvalidMenuOrder = errors.New(
+		http.StatusBadRequest,
+		"invalid_menu_order",
+		"调整排序的菜单须存在、不重复且属于同一父级",
+		nil,
+	)
+	ErrInvalidMenuMeta = errors.New(
+		http.StatusBadRequest,
+		"invalid_menu_meta",
+		"菜单元信息无效",
+		nil,
+	)
+	ErrExportMenuRoutes = errors.New(
+		http.StatusInternalServerError,
+		"export_menu_routes_failed",
+		"导出前端路由失败",
+		nil,
+	)
+	ErrMenuHasDependents = errors.New(
+		http.StatusConflict,
+		"menu_has_dependents",
+		"菜单仍有下级菜单、按钮或被授予角色，需强制删除",
+		nil,
+	)
 )
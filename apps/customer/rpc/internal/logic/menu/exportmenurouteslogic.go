@@ -0,0 +1,46 @@
+package menulogic
+
+import (
+	"context"
+	"encoding/json"
+
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportMenuRoutesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportMenuRoutesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportMenuRoutesLogic {
+	return &ExportMenuRoutesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ExportMenuRoutesLogic) ExportMenuRoutes(in *pb.ExportMenuRoutesRequest) (*pb.MenuRoutesOut, error) {
+	// todo: add your logic here and delete this line
+	if in.Mine == (in.RoleId > 0) {
+		return nil, ErrInvalidMenuRoutesRequest
+	}
+	roleIds, err := menuRoleIds(l.ctx, l.svcCtx, in.RoleId, in.Mine)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := l.svcCtx.Menu.Tree(l.ctx, svc.MenuTreeOptions{ActiveOnly: true, RoleIds: roleIds})
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	content, err := json.Marshal(svc.MenuRoutes(tree))
+	if err != nil {
+		return nil, ErrExportMenuRoutes.WithCause(err)
+	}
+	return &pb.MenuRoutesOut{Content: string(content)}, nil
+}
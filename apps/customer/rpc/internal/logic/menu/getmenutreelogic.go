@@ -0,0 +1,48 @@
+package menulogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMenuTreeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetMenuTreeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMenuTreeLogic {
+	return &GetMenuTreeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetMenuTreeLogic) GetMenuTree(in *pb.GetMenuTreeRequest) (*pb.MenuTreeOut, error) {
+	// todo: add your logic here and delete this line
+	if in.Mine && in.RoleId > 0 {
+		return nil, ErrInvalidMenuTreeRequest
+	}
+	opts := svc.MenuTreeOptions{
+		WithButtons:     in.WithButtons,
+		WithPermissions: in.WithPermissions,
+		ActiveOnly:      in.ActiveOnly,
+	}
+	roleIds, err := menuRoleIds(l.ctx, l.svcCtx, in.RoleId, in.Mine)
+	if err != nil {
+		return nil, err
+	}
+	opts.RoleIds = roleIds
+	tree, err := l.svcCtx.Menu.Tree(l.ctx, opts)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return &pb.MenuTreeOut{Items: converter.MenuTreeToOut(tree)}, nil
+}
@@ -98,6 +98,9 @@ func (l *ListMenuLogic) ListMenu(in *pb.ListMenuRequest) (*pb.PagMenuOutBase, er
 	if in.Descr != "" {
 		query["descr like ?"] = "%" + in.Descr + "%"
 	}
+	if in.TenantId != nil {
+		query["tenant_id = ?"] = in.TenantId.GetValue()
+	}
 	qp := database.QueryParams{
 		Preloads: []string{},
 		Query:    query,
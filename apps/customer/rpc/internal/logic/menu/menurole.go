@@ -0,0 +1,41 @@
+package menulogic
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+)
+
+// menuRoleIds 返回用于限定菜单的角色及其祖先角色，不限定时为nil
+// roleId 按调用方租户隔离，不可见的角色返回不存在；mine 使用调用方用户的角色和当前有效的临时角色
+func menuRoleIds(ctx context.Context, svcCtx *svc.ServiceContext, roleId uint32, mine bool) ([]uint32, error) {
+	var ids []uint32
+	switch {
+	case roleId > 0:
+		m, err := svcCtx.Role.FindModel(ctx, nil, roleId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		ids = []uint32{m.Id}
+	case mine:
+		uc, err := auth.GetUserClaims(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// 与鉴权一致，当前有效的临时角色同样可见其菜单
+		ids, err = svcCtx.User.ListEffectiveRoleIds(ctx, uc.UserId, time.Now())
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+	default:
+		return nil, nil
+	}
+	lineage, err := svcCtx.Role.ListLineageIds(ctx, ids)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return lineage, nil
+}
@@ -0,0 +1,65 @@
+package menulogic
+
+import (
+	"context"
+	stderrors "errors"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MoveMenuLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMoveMenuLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveMenuLogic {
+	return &MoveMenuLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *MoveMenuLogic) MoveMenu(in *pb.MoveMenuRequest) (*pb.MenuOut, error) {
+	// todo: add your logic here and delete this line
+	om, err := l.svcCtx.Menu.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if !auth.CanWriteTenant(l.ctx, om.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	if in.ParentId != 0 {
+		if _, err := l.svcCtx.Menu.FindModel(l.ctx, nil, in.ParentId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+	}
+	if err := l.svcCtx.Menu.MoveModel(l.ctx, in.Pk, in.ParentId, in.ArrangeOrder); err != nil {
+		if stderrors.Is(err, svc.ErrMenuCycle) {
+			return nil, ErrMenuCycle
+		}
+		return nil, database.NewGormError(err, nil)
+	}
+	m, err := l.svcCtx.Menu.FindModel(l.ctx, []string{"Parent", "Permissions"}, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	// 只有被移动菜单继承父级的规则发生变化，同级菜单的排序不影响策略
+	if err := l.svcCtx.Menu.RemoveGroupPolicy(l.ctx, *m, false); err != nil {
+		return nil, ErrRemoveMenuPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.Menu.AddGroupPolicy(l.ctx, *m); err != nil {
+		return nil, ErrAddMenuPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
+	return converter.MenuModelToOut(*m), nil
+}
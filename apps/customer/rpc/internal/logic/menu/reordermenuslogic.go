@@ -0,0 +1,66 @@
+package menulogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReorderMenusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReorderMenusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReorderMenusLogic {
+	return &ReorderMenusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReorderMenusLogic) ReorderMenus(in *pb.ReorderMenusRequest) (*pb.NilOut, error) {
+	// todo: add your logic here and delete this line
+	if len(in.Items) == 0 {
+		return &pb.NilOut{}, nil
+	}
+	orders := make(map[uint32]uint32, len(in.Items))
+	ids := make([]uint32, 0, len(in.Items))
+	for _, item := range in.Items {
+		if _, ok := orders[item.Id]; ok {
+			return nil, ErrInvalidMenuOrder.WithData(map[string]any{"id": item.Id})
+		}
+		orders[item.Id] = item.ArrangeOrder
+		ids = append(ids, item.Id)
+	}
+	ms, err := l.svcCtx.Menu.ListModelByIds(l.ctx, ids)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if len(ms) != len(ids) {
+		return nil, ErrInvalidMenuOrder
+	}
+	for _, m := range ms {
+		var parentId uint32
+		if m.ParentId != nil {
+			parentId = *m.ParentId
+		}
+		if parentId != in.ParentId {
+			return nil, ErrInvalidMenuOrder.WithData(map[string]any{"id": m.Id})
+		}
+		if !auth.CanWriteTenant(l.ctx, m.TenantId) {
+			return nil, auth.ErrTenantReadOnly
+		}
+	}
+	// 排序值不参与策略，无需刷新策略和通知其他实例
+	if err := l.svcCtx.Menu.ReorderModels(l.ctx, orders); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return &pb.NilOut{}, nil
+}
@@ -2,6 +2,7 @@ package menulogic
 
 import (
 	"context"
+	stderrors "errors"
 	"time"
 
 	"gz-dango/apps/customer/rpc/internal/converter"
@@ -29,22 +30,46 @@ func NewUpdateMenuLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Update
 
 func (l *UpdateMenuLogic) UpdateMenu(in *pb.UpdateMenuRequest) (*pb.MenuOut, error) {
 	// todo: add your logic here and delete this line
+	om, err := l.svcCtx.Menu.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if !auth.CanWriteTenant(l.ctx, om.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	meta := converter.MetaFromIn(in.Meta)
+	meta.SetDefaults(in.Name)
+	if err := meta.Validate(); err != nil {
+		return nil, ErrInvalidMenuMeta.WithCause(err)
+	}
 	data := map[string]any{
-		"update_at":     time.Now(),
+		"updated_at":    time.Now(),
 		"path":          in.Path,
 		"component":     in.Component,
 		"name":          in.Name,
-		"meta":          in.Meta,
+		"meta":          meta.Json(),
 		"label":         in.Label,
 		"arrange_order": in.ArrangeOrder,
 		"is_active":     in.IsActive,
 		"descr":         in.Descr,
+		"parent_id":     nil,
+	}
+	if in.ParentId != 0 {
+		if _, err := l.svcCtx.Menu.FindModel(l.ctx, nil, in.ParentId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		data["parent_id"] = in.ParentId
 	}
 	pms, err := l.svcCtx.Perm.ListModelByIds(l.ctx, in.PermissionIds)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	if err := l.svcCtx.Menu.UpdateModel(l.ctx, data, map[string]any{"permissions": pms}); err != nil {
+	// 在写入的事务中锁定菜单行并检查上级关系是否成环，避免并发修改共同形成环
+	upmap := map[string]any{"Permissions": pms}
+	if err := l.svcCtx.Menu.UpdateModelWithParent(l.ctx, in.Pk, in.ParentId, data, upmap); err != nil {
+		if stderrors.Is(err, svc.ErrMenuCycle) {
+			return nil, ErrMenuCycle
+		}
 		return nil, database.NewGormError(err, nil)
 	}
 	m, err := l.svcCtx.Menu.FindModel(l.ctx, []string{"Parent", "Permissions"}, in.Pk)
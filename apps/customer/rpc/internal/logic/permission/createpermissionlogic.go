@@ -29,14 +29,36 @@ func NewCreatePermissionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 
 func (l *CreatePermissionLogic) CreatePermission(in *pb.CreatePermissionRequest) (*pb.PermissionOutBase, error) {
 	// todo: add your logic here and delete this line
+	matcher := in.Matcher
+	if matcher == "" {
+		matcher = auth.MatchExact
+	}
+	if err := auth.ValidatePattern(matcher, in.Url, in.Method); err != nil {
+		return nil, ErrInvalidPermissionPattern.WithCause(err)
+	}
+	effect := in.Effect
+	if effect == "" {
+		effect = auth.EffectAllow
+	}
+	if !auth.IsValidEffect(effect) {
+		return nil, ErrInvalidPermissionEffect
+	}
+	conditions, err := auth.EncodeConditions(converter.PolicyConditionsFromIn(in.Conditions))
+	if err != nil {
+		return nil, auth.ErrInvalidConditions.WithCause(err)
+	}
 	m := models.PermissionModel{
 		StandardModel: database.StandardModel{
 			BaseModel: database.BaseModel{Id: in.Id},
 		},
-		Url:    in.Url,
-		Method: in.Method,
-		Label:  in.Label,
-		Descr:  in.Descr,
+		Url:        in.Url,
+		Method:     in.Method,
+		Matcher:    matcher,
+		Effect:     effect,
+		Conditions: conditions,
+		Label:      in.Label,
+		Descr:      in.Descr,
+		TenantId:   in.TenantId,
 	}
 	if err := l.svcCtx.Perm.CreateModel(l.ctx, &m); err != nil {
 		return nil, database.NewGormError(err, nil)
@@ -3,6 +3,7 @@ package permissionlogic
 import (
 	"context"
 
+	"gz-dango/apps/customer/rpc/internal/converter"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 	"gz-dango/pkg/auth"
@@ -25,20 +26,41 @@ func NewDeletePermissionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 	}
 }
 
-func (l *DeletePermissionLogic) DeletePermission(in *pb.DeletePermissionRequest) (*pb.NilOut, error) {
+func (l *DeletePermissionLogic) DeletePermission(in *pb.DeletePermissionRequest) (*pb.DeleteOut, error) {
 	// todo: add your logic here and delete this line
 	m, err := l.svcCtx.Perm.FindModel(l.ctx, nil, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
+	if !auth.CanWriteTenant(l.ctx, m.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	deps, err := l.svcCtx.Perm.Dependents(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(deps, true, false), nil
+	}
+	if len(deps) > 0 && !in.Force {
+		return nil, ErrPermissionHasDependents.WithData(deps.Counts())
+	}
+	// 删除后关联关系随之删除，需要提前查询自有策略引用了该权限的角色
+	roleIds, err := l.svcCtx.Role.ListPolicyRoleIds(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
 	if err := l.svcCtx.Perm.DeleteModel(l.ctx, in.Pk); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
 	if err := l.svcCtx.Perm.RemovePolicy(l.ctx, *m, true); err != nil {
 		return nil, ErrRemovePermissionPolicy.WithCause(err)
 	}
+	if err := l.svcCtx.Role.ResetRolePolicies(l.ctx, roleIds); err != nil {
+		return nil, ErrRemovePermissionPolicy.WithCause(err)
+	}
 	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
 		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
 	}
-	return &pb.NilOut{}, nil
+	return converter.DependentsToDeleteOut(deps, false, true), nil
 }
@@ -19,4 +19,40 @@ var (
 		"删除权限策略失败",
 		nil,
 	)
+	ErrInvalidPermissionPattern = errors.New(
+		http.StatusBadRequest,
+		"invalid_permission_pattern",
+		"权限的URL、请求方法或匹配方式无效",
+		nil,
+	)
+	ErrInvalidPermissionEffect = errors.New(
+		http.StatusBadRequest,
+		"invalid_permission_effect",
+		"权限的效果只能为 allow 或 deny",
+		nil,
+	)
+	ErrInvalidExplainRequest = errors.New(
+		http.StatusBadRequest,
+		"invalid_explain_request",
+		"需指定用户或角色中的一个，且IP和时间格式正确",
+		nil,
+	)
+	ErrExplainAccess = errors.New(
+		http.StatusInternalServerError,
+		"explain_access_failed",
+		"模拟鉴权失败",
+		nil,
+	)
+	ErrSyncPermissions = errors.New(
+		http.StatusInternalServerError,
+		"sync_permissions_failed",
+		"按接口同步权限失败",
+		nil,
+	)
+	ErrPermissionHasDependents = errors.New(
+		http.StatusConflict,
+		"permission_has_dependents",
+		"权限仍被角色、菜单、按钮或临时授权引用，需强制删除",
+		nil,
+	)
 )
@@ -0,0 +1,124 @@
+package permissionlogic
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExplainAccessLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExplainAccessLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExplainAccessLogic {
+	return &ExplainAccessLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ExplainAccessLogic) ExplainAccess(in *pb.ExplainAccessRequest) (*pb.ExplainAccessOut, error) {
+	// todo: add your logic here and delete this line
+	if (in.UserId == 0) == (in.RoleId == 0) {
+		return nil, ErrInvalidExplainRequest
+	}
+	env := &auth.RequestEnv{}
+	if in.Ip != "" {
+		ip, err := netip.ParseAddr(in.Ip)
+		if err != nil {
+			return nil, ErrInvalidExplainRequest.WithCause(err)
+		}
+		env.IP = ip
+	}
+	if in.Time != "" {
+		t, err := time.Parse(time.RFC3339, in.Time)
+		if err != nil {
+			return nil, ErrInvalidExplainRequest.WithCause(err)
+		}
+		env.Time = t
+	}
+	// 主体和租户域从用户或角色推导，查询时按调用方租户隔离
+	var sub, dom string
+	if in.UserId > 0 {
+		m, err := l.svcCtx.User.FindModel(l.ctx, nil, in.UserId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		sub, dom = auth.UserSubject(m.Id), auth.TenantDomain(m.TenantId)
+		env.IsStaff = m.IsStaff
+	} else {
+		m, err := l.svcCtx.Role.FindModel(l.ctx, nil, in.RoleId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		sub, dom = svc.RoleSubject(*m), auth.TenantDomain(m.TenantId)
+	}
+	if in.IsStaff != nil {
+		env.IsStaff = in.IsStaff.GetValue()
+	}
+	exp, err := l.svcCtx.Enforce().Explain(sub, dom, in.Url, in.Method, env)
+	if err != nil {
+		return nil, ErrExplainAccess.WithCause(err)
+	}
+
+	subs := []string{sub}
+	for _, r := range append(exp.Matched, exp.NearMisses...) {
+		subs = append(subs, r.Rule[0])
+		subs = append(subs, r.Chain...)
+	}
+	infos, err := l.svcCtx.DescribeSubjects(l.ctx, subs)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return &pb.ExplainAccessOut{
+		Allowed:    exp.Allowed,
+		Subject:    sub,
+		Domain:     dom,
+		Matched:    explainRulesToOut(exp.Matched, infos),
+		NearMisses: explainRulesToOut(exp.NearMisses, infos),
+	}, nil
+}
+
+func explainSubjectToOut(info svc.SubjectInfo) *pb.ExplainSubject {
+	return &pb.ExplainSubject{
+		Subject: info.Subject,
+		Kind:    info.Kind,
+		Id:      info.Id,
+		Name:    info.Name,
+	}
+}
+
+func explainRulesToOut(rs []auth.ExplainRule, infos map[string]svc.SubjectInfo) []*pb.ExplainRule {
+	mso := make([]*pb.ExplainRule, 0, len(rs))
+	for _, r := range rs {
+		chain := make([]*pb.ExplainSubject, 0, len(r.Chain))
+		for _, sub := range r.Chain {
+			chain = append(chain, explainSubjectToOut(infos[sub]))
+		}
+		mso = append(mso, &pb.ExplainRule{
+			Subject:           explainSubjectToOut(infos[r.Rule[0]]),
+			Domain:            r.Rule[1],
+			Url:               r.Rule[2],
+			Method:            r.Rule[3],
+			Matcher:           r.Rule[4],
+			Effect:            r.Rule[5],
+			Conditions:        r.Rule[6],
+			Chain:             chain,
+			DomainMatched:     r.DomainMatched,
+			PathMatched:       r.PathMatched,
+			MethodMatched:     r.MethodMatched,
+			ConditionsMatched: r.CondMatched,
+		})
+	}
+	return mso
+}
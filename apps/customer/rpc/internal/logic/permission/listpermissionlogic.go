@@ -78,12 +78,21 @@ func (l *ListPermissionLogic) ListPermission(in *pb.ListPermissionRequest) (*pb.
 	if in.Method != "" {
 		query["method = ?"] = in.Method
 	}
+	if in.Matcher != "" {
+		query["matcher = ?"] = in.Matcher
+	}
+	if in.Effect != "" {
+		query["effect = ?"] = in.Effect
+	}
 	if in.Label != "" {
 		query["label = ?"] = in.Label
 	}
 	if in.Descr != "" {
 		query["descr like ?"] = "%" + in.Descr + "%"
 	}
+	if in.TenantId != nil {
+		query["tenant_id = ?"] = in.TenantId.GetValue()
+	}
 	qp := database.QueryParams{
 		Preloads: []string{},
 		Query:    query,
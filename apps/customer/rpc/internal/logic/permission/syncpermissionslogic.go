@@ -0,0 +1,46 @@
+package permissionlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SyncPermissionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSyncPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SyncPermissionsLogic {
+	return &SyncPermissionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SyncPermissionsLogic) SyncPermissions(in *pb.SyncPermissionsRequest) (*pb.SyncPermissionsOut, error) {
+	// todo: add your logic here and delete this line
+	// 同步的是平台级权限，只允许平台调用方执行
+	if !auth.CanWriteTenant(l.ctx, database.PlatformTenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	res, err := l.svcCtx.SyncPermissions(l.ctx, l.svcCtx.Endpoints(), in.DryRun)
+	if err != nil {
+		return nil, ErrSyncPermissions.WithCause(err)
+	}
+	applied := !in.DryRun && len(res.Created)+len(res.Updated) > 0
+	if applied && len(res.Created) > 0 {
+		if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+			return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+		}
+	}
+	return converter.PermissionSyncResultToOut(res, applied), nil
+}
@@ -29,27 +29,67 @@ func NewUpdatePermissionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 
 func (l *UpdatePermissionLogic) UpdatePermission(in *pb.UpdatePermissionRequest) (*pb.PermissionOutBase, error) {
 	// todo: add your logic here and delete this line
-
+	om, err := l.svcCtx.Perm.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if !auth.CanWriteTenant(l.ctx, om.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	// 未指定匹配方式时保持原有设置
+	matcher := in.Matcher
+	if matcher == "" {
+		matcher = om.Matcher
+	}
+	if err := auth.ValidatePattern(matcher, in.Url, in.Method); err != nil {
+		return nil, ErrInvalidPermissionPattern.WithCause(err)
+	}
+	effect := in.Effect
+	if effect == "" {
+		effect = om.Effect
+	}
+	if !auth.IsValidEffect(effect) {
+		return nil, ErrInvalidPermissionEffect
+	}
+	// 未指定附加条件时保持原有设置
+	conditions := om.Conditions
+	if in.Conditions != nil {
+		conditions, err = auth.EncodeConditions(converter.PolicyConditionsFromIn(in.Conditions))
+		if err != nil {
+			return nil, auth.ErrInvalidConditions.WithCause(err)
+		}
+	}
 	data := map[string]any{
-		"update_at": time.Now(),
-		"url":       in.Url,
-		"method":    in.Method,
-		"label":     in.Label,
-		"descr":     in.Descr,
+		"updated_at": time.Now(),
+		"url":        in.Url,
+		"method":     in.Method,
+		"matcher":    matcher,
+		"effect":     effect,
+		"conditions": conditions,
+		"label":      in.Label,
+		"descr":      in.Descr,
 	}
-	if err := l.svcCtx.Perm.UpdateModel(l.ctx, data); err != nil {
+	if err := l.svcCtx.Perm.UpdateModel(l.ctx, data, "id = ?", in.Pk); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
 	m, err := l.svcCtx.Perm.FindModel(l.ctx, nil, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	if err := l.svcCtx.Perm.RemovePolicy(l.ctx, *m, false); err != nil {
+	if err := l.svcCtx.Perm.RemovePolicy(l.ctx, *om, false); err != nil {
 		return nil, ErrRemovePermissionPolicy.WithCause(err)
 	}
 	if err := l.svcCtx.Perm.AddPolicy(l.ctx, *m); err != nil {
 		return nil, ErrAddPermissionPolicy.WithCause(err)
 	}
+	// 拒绝该权限或设置了条件的角色复制了权限的URL、请求方法和条件，需要同步更新
+	roleIds, err := l.svcCtx.Role.ListPolicyRoleIds(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if err := l.svcCtx.Role.ResetRolePolicies(l.ctx, roleIds); err != nil {
+		return nil, ErrAddPermissionPolicy.WithCause(err)
+	}
 	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
 		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
 	}
@@ -0,0 +1,28 @@
+package rbaclogic
+
+import (
+	"net/http"
+
+	"gz-dango/pkg/errors"
+)
+
+var (
+	ErrInvalidRbacDocument = errors.New(
+		http.StatusBadRequest,
+		"invalid_rbac_document",
+		"权限文档格式错误或引用的数据不存在",
+		nil,
+	)
+	ErrExportRbac = errors.New(
+		http.StatusInternalServerError,
+		"export_rbac_failed",
+		"导出权限文档失败",
+		nil,
+	)
+	ErrResetRbacPolicy = errors.New(
+		http.StatusInternalServerError,
+		"reset_rbac_policy_failed",
+		"导入权限文档后更新策略失败",
+		nil,
+	)
+)
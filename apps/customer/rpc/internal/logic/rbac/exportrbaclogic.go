@@ -0,0 +1,44 @@
+package rbaclogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportRbacLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportRbacLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportRbacLogic {
+	return &ExportRbacLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ExportRbacLogic) ExportRbac(in *pb.ExportRbacRequest) (*pb.RbacDocumentOut, error) {
+	// todo: add your logic here and delete this line
+	format := in.Format
+	if format == "" {
+		format = svc.RbacFormatYAML
+	}
+	doc, err := l.svcCtx.ExportRbac(l.ctx)
+	if err != nil {
+		return nil, ErrExportRbac.WithCause(err)
+	}
+	content, err := svc.MarshalRbacDocument(doc, format)
+	if err != nil {
+		return nil, ErrInvalidRbacDocument.WithCause(err)
+	}
+	return &pb.RbacDocumentOut{
+		Format:  format,
+		Content: string(content),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package rbaclogic
+
+import (
+	"context"
+	stderrors "errors"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportRbacLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImportRbacLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportRbacLogic {
+	return &ImportRbacLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ImportRbacLogic) ImportRbac(in *pb.ImportRbacRequest) (*pb.ImportRbacOut, error) {
+	// todo: add your logic here and delete this line
+	doc, err := svc.UnmarshalRbacDocument([]byte(in.Content), in.Format)
+	if err != nil {
+		return nil, ErrInvalidRbacDocument.WithCause(err)
+	}
+	res, err := l.svcCtx.ImportRbac(l.ctx, doc, in.DryRun, in.Prune)
+	if err != nil {
+		var de *svc.RbacDocumentError
+		if stderrors.As(err, &de) {
+			return nil, ErrInvalidRbacDocument.WithData(map[string]any{
+				"kind": de.Kind,
+				"key":  de.Key,
+			}).WithCause(err)
+		}
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun || len(res.Changes) == 0 {
+		return converter.RbacImportResultToOut(res, false), nil
+	}
+	if err := l.svcCtx.ResetImportedPolicies(l.ctx, res); err != nil {
+		return nil, ErrResetRbacPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
+	return converter.RbacImportResultToOut(res, true), nil
+}
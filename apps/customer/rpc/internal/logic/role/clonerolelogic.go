@@ -0,0 +1,71 @@
+package rolelogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CloneRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCloneRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CloneRoleLogic {
+	return &CloneRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CloneRoleLogic) CloneRole(in *pb.CloneRoleRequest) (*pb.RoleOut, error) {
+	// todo: add your logic here and delete this line
+	if in.Name == "" {
+		return nil, ErrRoleNameRequired
+	}
+	src, err := l.svcCtx.Role.FindModel(l.ctx, roleGrantPreloads, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	descr := in.Descr
+	if descr == "" {
+		descr = src.Descr
+	}
+	// 新角色与原角色属于同一租户，租户调用方复制平台角色时由租户作用域写入调用方租户
+	m := models.RoleModel{
+		Name:            in.Name,
+		Descr:           descr,
+		Permissions:     src.Permissions,
+		Menus:           src.Menus,
+		Buttons:         src.Buttons,
+		Parents:         src.Parents,
+		DataScope:       src.DataScope,
+		Conditions:      src.Conditions,
+		Depts:           src.Depts,
+		TenantId:        src.TenantId,
+		DenyPermissions: src.DenyPermissions,
+	}
+	// 继承关系或菜单、按钮会产生无条件的策略，带附加条件的角色不能复制出绕过条件的角色
+	if svc.RoleConditionsInherited(m) {
+		return nil, ErrConditionalRoleInherits
+	}
+	if err := l.svcCtx.Role.CreateModel(l.ctx, &m); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if err := l.svcCtx.Role.AddGroupPolicy(l.ctx, m); err != nil {
+		return nil, ErrAddRolePolicy.WithCause(err)
+	}
+	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
+	return converter.RoleModelToOut(m), nil
+}
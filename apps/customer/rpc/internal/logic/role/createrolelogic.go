@@ -29,6 +29,10 @@ func NewCreateRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Create
 
 func (l *CreateRoleLogic) CreateRole(in *pb.CreateRoleRequest) (*pb.RoleOut, error) {
 	// todo: add your logic here and delete this line
+	conditions, err := auth.EncodeConditions(converter.PolicyConditionsFromIn(in.Conditions))
+	if err != nil {
+		return nil, auth.ErrInvalidConditions.WithCause(err)
+	}
 	pms, err := l.svcCtx.Perm.ListModelByIds(l.ctx, in.PermissionIds)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
@@ -41,12 +45,37 @@ func (l *CreateRoleLogic) CreateRole(in *pb.CreateRoleRequest) (*pb.RoleOut, err
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
+	dpms, err := l.svcCtx.Perm.ListModelByIds(l.ctx, in.DenyPermissionIds)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	parents, err := listParentsByIds(l.ctx, l.svcCtx, in.ParentIds)
+	if err != nil {
+		return nil, err
+	}
+	dataScope := in.DataScope
+	if dataScope == "" {
+		dataScope = database.DataScopeAll
+	}
+	depts, err := listDataScopeDepts(l.ctx, l.svcCtx, dataScope, in.DeptIds)
+	if err != nil {
+		return nil, err
+	}
 	m := models.RoleModel{
-		Name:        in.Name,
-		Descr:       in.Descr,
-		Permissions: pms,
-		Menus:       mms,
-		Buttons:     bms,
+		Name:            in.Name,
+		Descr:           in.Descr,
+		Permissions:     pms,
+		Menus:           mms,
+		Buttons:         bms,
+		Parents:         parents,
+		DataScope:       dataScope,
+		Conditions:      conditions,
+		Depts:           depts,
+		TenantId:        in.TenantId,
+		DenyPermissions: dpms,
+	}
+	if svc.RoleConditionsInherited(m) {
+		return nil, ErrConditionalRoleInherits
 	}
 	if err := l.svcCtx.Role.CreateModel(l.ctx, &m); err != nil {
 		return nil, database.NewGormError(err, nil)
@@ -0,0 +1,35 @@
+package rolelogic
+
+import (
+	"context"
+	"slices"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/pkg/database"
+)
+
+// listDataScopeDepts 校验数据范围并查询自定义数据范围的部门
+// 只有自定义数据范围需要关联部门，其他数据范围返回空列表
+func listDataScopeDepts(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	dataScope string,
+	ids []uint32,
+) ([]models.DeptModel, error) {
+	if !database.IsValidDataScope(dataScope) {
+		return nil, ErrInvalidDataScope.WithData(map[string]any{"data_scope": dataScope})
+	}
+	if dataScope != database.DataScopeCustom {
+		return []models.DeptModel{}, nil
+	}
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	dms, err := svcCtx.Dept.ListModelByIds(ctx, ids)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if len(dms) != len(ids) {
+		return nil, ErrDeptNotFound.WithData(map[string]any{"dept_ids": ids})
+	}
+	return dms, nil
+}
@@ -2,7 +2,9 @@ package rolelogic
 
 import (
 	"context"
+	stderrors "errors"
 
+	"gz-dango/apps/customer/rpc/internal/converter"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
 	"gz-dango/pkg/auth"
@@ -25,20 +27,62 @@ func NewDeleteRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Delete
 	}
 }
 
-func (l *DeleteRoleLogic) DeleteRole(in *pb.DeleteRoleRequest) (*pb.NilOut, error) {
+func (l *DeleteRoleLogic) DeleteRole(in *pb.DeleteRoleRequest) (*pb.DeleteOut, error) {
 	// todo: add your logic here and delete this line
 	m, err := l.svcCtx.Role.FindModel(l.ctx, nil, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	if err := l.svcCtx.Role.DeleteModel(l.ctx, in.Pk); err != nil {
+	if !auth.CanWriteTenant(l.ctx, m.TenantId) {
+		return nil, auth.ErrTenantReadOnly
+	}
+	if in.ReassignToRoleId != 0 {
+		if in.ReassignToRoleId == in.Pk {
+			return nil, ErrInvalidReassignRole
+		}
+		target, err := l.svcCtx.Role.FindModel(l.ctx, nil, in.ReassignToRoleId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		if target.TenantId != m.TenantId && target.TenantId != database.PlatformTenantId {
+			return nil, ErrInvalidReassignRole
+		}
+	}
+	deps, err := l.svcCtx.Role.Dependents(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(deps, true, false), nil
+	}
+	// 指定接替的角色时用户和临时授权随之转移，只有子角色需要强制删除
+	blocking := deps
+	if in.ReassignToRoleId != 0 {
+		blocking = deps.Without(svc.DependentUser, svc.DependentGrant)
+	}
+	if len(blocking) > 0 && !in.Force {
+		return nil, ErrRoleInUse.WithData(blocking.Counts())
+	}
+	userIds, err := l.svcCtx.Role.DeleteModelReassign(l.ctx, in.Pk, in.ReassignToRoleId, in.Force)
+	if err != nil {
+		var inUse *svc.RoleInUseError
+		if stderrors.As(err, &inUse) {
+			return nil, ErrRoleInUse.WithData(map[string]any{
+				svc.DependentUser:  inUse.Users,
+				svc.DependentGrant: inUse.Grants,
+			})
+		}
 		return nil, database.NewGormError(err, nil)
 	}
-	if err := l.svcCtx.Role.RemoveGroupPolicy(l.ctx, *m); err != nil {
+	if err := l.svcCtx.Role.RemoveGroupPolicy(l.ctx, *m, true); err != nil {
 		return nil, ErrRemoveRolePolicy.WithCause(err)
 	}
+	// 用户转移到接替的角色或失去该角色后按业务表重建其 g 规则
+	if err := l.svcCtx.User.ResetGroupPolicy(l.ctx, userIds); err != nil {
+		return nil, ErrResetUserPolicy.WithCause(err)
+	}
 	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
 		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
 	}
-	return &pb.NilOut{}, nil
+	return converter.DependentsToDeleteOut(deps, false, true), nil
 }
@@ -0,0 +1,49 @@
+package rolelogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiffRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDiffRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiffRolesLogic {
+	return &DiffRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DiffRolesLogic) DiffRoles(in *pb.DiffRolesRequest) (*pb.RoleDiffOut, error) {
+	// todo: add your logic here and delete this line
+	if (in.OtherPk == 0) == (in.Proposed == nil) {
+		return nil, ErrInvalidDiffRequest
+	}
+	base, err := l.svcCtx.Role.FindModel(l.ctx, roleGrantPreloads, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.Proposed != nil {
+		target, err := applyUpdateRequest(l.ctx, l.svcCtx, *base, in.Proposed)
+		if err != nil {
+			return nil, err
+		}
+		return converter.RoleDiffToOut(*base, svc.DiffRoleModels(*base, target)), nil
+	}
+	other, err := l.svcCtx.Role.FindModel(l.ctx, roleGrantPreloads, in.OtherPk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.RoleDiffToOut(*base, svc.DiffRoleModels(*base, *other)), nil
+}
@@ -19,4 +19,64 @@ var (
 		"删除角色策略失败",
 		nil,
 	)
+	ErrRoleCycle = errors.New(
+		http.StatusBadRequest,
+		"role_cycle",
+		"角色继承关系不能形成循环",
+		nil,
+	)
+	ErrInvalidDataScope = errors.New(
+		http.StatusBadRequest,
+		"invalid_data_scope",
+		"不支持的数据范围",
+		nil,
+	)
+	ErrDeptNotFound = errors.New(
+		http.StatusNotFound,
+		"dept_not_found",
+		"自定义数据范围的部门不存在",
+		nil,
+	)
+	ErrParentRoleNotFound = errors.New(
+		http.StatusNotFound,
+		"parent_role_not_found",
+		"父级角色不存在",
+		nil,
+	)
+	ErrRoleInUse = errors.New(
+		http.StatusConflict,
+		"role_in_use",
+		"角色仍被用户持有或被子角色继承，请指定接替的角色或强制删除",
+		nil,
+	)
+	ErrInvalidReassignRole = errors.New(
+		http.StatusBadRequest,
+		"invalid_reassign_role",
+		"接替的角色不能是被删除的角色，且须属于同一租户或平台",
+		nil,
+	)
+	ErrResetUserPolicy = errors.New(
+		http.StatusInternalServerError,
+		"reset_user_policy_failed",
+		"重建用户策略失败",
+		nil,
+	)
+	ErrRoleNameRequired = errors.New(
+		http.StatusBadRequest,
+		"role_name_required",
+		"角色名称不能为空",
+		nil,
+	)
+	ErrInvalidDiffRequest = errors.New(
+		http.StatusBadRequest,
+		"invalid_role_diff_request",
+		"需指定比较的角色或拟更新的内容中的一个",
+		nil,
+	)
+	ErrConditionalRoleInherits = errors.New(
+		http.StatusBadRequest,
+		"conditional_role_inherits",
+		"设置了附加条件的角色只能直接关联权限，不能关联菜单、按钮或父级角色",
+		nil,
+	)
 )
@@ -0,0 +1,35 @@
+package rolelogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRoleImpactLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRoleImpactLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleImpactLogic {
+	return &GetRoleImpactLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetRoleImpactLogic) GetRoleImpact(in *pb.GetRoleRequest) (*pb.RoleImpactOut, error) {
+	// todo: add your logic here and delete this line
+	impact, err := l.svcCtx.Role.Impact(l.ctx, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.RoleImpactToOut(impact), nil
+}
@@ -28,9 +28,18 @@ func NewGetRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleLo
 func (l *GetRoleLogic) GetRole(in *pb.GetRoleRequest) (*pb.RoleOut, error) {
 	// todo: add your logic here and delete this line
 
-	m, err := l.svcCtx.Role.FindModel(l.ctx, []string{"Permissons", "Menus", "Buttons"}, in.Pk)
+	m, err := l.svcCtx.Role.FindModel(l.ctx, []string{"Permissions", "Menus", "Buttons", "Parents", "Depts", "DenyPermissions"}, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	return converter.RoleModelToOut(*m), nil
+	ancestors, err := l.svcCtx.Role.ListAncestors(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	out := converter.RoleModelToOut(*m)
+	pms, mms, bms := inheritedGrants(*m, ancestors)
+	out.InheritedPermissions = converter.ListPermModelToOut(pms)
+	out.InheritedMenus = converter.ListMenuModelToOutBase(mms)
+	out.InheritedButtons = converter.ListButtonModelToOutBase(bms)
+	return out, nil
 }
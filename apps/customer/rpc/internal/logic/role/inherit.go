@@ -0,0 +1,67 @@
+package rolelogic
+
+import (
+	"context"
+	"slices"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/pkg/database"
+)
+
+// listParentsByIds 按ID列表查询父级角色，任一角色不存在时返回错误
+func listParentsByIds(ctx context.Context, svcCtx *svc.ServiceContext, ids []uint32) ([]models.RoleModel, error) {
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	rms, err := svcCtx.Role.ListModelByIds(ctx, ids)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if len(rms) != len(ids) {
+		return nil, ErrParentRoleNotFound.WithData(map[string]any{"parent_ids": ids})
+	}
+	return rms, nil
+}
+
+// inheritedGrants 汇总祖先角色的授权，并排除角色自身直接分配的授权
+func inheritedGrants(
+	m models.RoleModel,
+	ancestors []models.RoleModel,
+) ([]models.PermissionModel, []models.MenuModel, []models.ButtonModel) {
+	seenPerms := make(map[uint32]bool, len(m.Permissions))
+	for _, o := range m.Permissions {
+		seenPerms[o.Id] = true
+	}
+	seenMenus := make(map[uint32]bool, len(m.Menus))
+	for _, o := range m.Menus {
+		seenMenus[o.Id] = true
+	}
+	seenButtons := make(map[uint32]bool, len(m.Buttons))
+	for _, o := range m.Buttons {
+		seenButtons[o.Id] = true
+	}
+
+	pms := make([]models.PermissionModel, 0)
+	mms := make([]models.MenuModel, 0)
+	bms := make([]models.ButtonModel, 0)
+	for _, a := range ancestors {
+		for _, o := range a.Permissions {
+			if !seenPerms[o.Id] {
+				seenPerms[o.Id] = true
+				pms = append(pms, o)
+			}
+		}
+		for _, o := range a.Menus {
+			if !seenMenus[o.Id] {
+				seenMenus[o.Id] = true
+				mms = append(mms, o)
+			}
+		}
+		for _, o := range a.Buttons {
+			if !seenButtons[o.Id] {
+				seenButtons[o.Id] = true
+				bms = append(bms, o)
+			}
+		}
+	}
+	return pms, mms, bms
+}
@@ -78,6 +78,9 @@ func (l *ListRoleLogic) ListRole(in *pb.ListRoleRequest) (*pb.PagRoleOutBase, er
 	if in.Descr != "" {
 		query["descr like ?"] = "%" + in.Descr + "%"
 	}
+	if in.TenantId != nil {
+		query["tenant_id = ?"] = in.TenantId.GetValue()
+	}
 	qp := database.QueryParams{
 		Preloads: []string{},
 		Query:    query,
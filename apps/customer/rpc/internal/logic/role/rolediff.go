@@ -0,0 +1,66 @@
+package rolelogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+)
+
+// roleGrantPreloads 复制和比较角色时需要预加载的关联
+var roleGrantPreloads = []string{"Permissions", "DenyPermissions", "Menus", "Buttons", "Parents", "Depts"}
+
+// applyUpdateRequest 将更新角色的请求应用到角色的副本上并校验，不写入数据，UpdateRole 和 DiffRoles 共用
+// 未指定附加条件或数据范围时保持角色原有的设置；m的关联数据会被请求中的关联替换，无需预加载
+func applyUpdateRequest(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	m models.RoleModel,
+	in *pb.UpdateRoleRequest,
+) (models.RoleModel, error) {
+	m.Name = in.Name
+	m.Descr = in.Descr
+	if in.Conditions != nil {
+		conditions, err := auth.EncodeConditions(converter.PolicyConditionsFromIn(in.Conditions))
+		if err != nil {
+			return m, auth.ErrInvalidConditions.WithCause(err)
+		}
+		m.Conditions = conditions
+	}
+	hasCycle, err := svcCtx.Role.HasCycle(ctx, m.Id, in.ParentIds)
+	if err != nil {
+		return m, database.NewGormError(err, nil)
+	}
+	if hasCycle {
+		return m, ErrRoleCycle
+	}
+	if m.Permissions, err = svcCtx.Perm.ListModelByIds(ctx, in.PermissionIds); err != nil {
+		return m, database.NewGormError(err, nil)
+	}
+	if m.Menus, err = svcCtx.Menu.ListModelByIds(ctx, in.MenuIds); err != nil {
+		return m, database.NewGormError(err, nil)
+	}
+	if m.Buttons, err = svcCtx.Button.ListModelByIds(ctx, in.ButtonIds); err != nil {
+		return m, database.NewGormError(err, nil)
+	}
+	if m.DenyPermissions, err = svcCtx.Perm.ListModelByIds(ctx, in.DenyPermissionIds); err != nil {
+		return m, database.NewGormError(err, nil)
+	}
+	if m.Parents, err = listParentsByIds(ctx, svcCtx, in.ParentIds); err != nil {
+		return m, err
+	}
+	if svc.RoleConditionsInherited(m) {
+		return m, ErrConditionalRoleInherits
+	}
+	if in.DataScope != "" {
+		if m.Depts, err = listDataScopeDepts(ctx, svcCtx, in.DataScope, in.DeptIds); err != nil {
+			return m, err
+		}
+		m.DataScope = in.DataScope
+	}
+	return m, nil
+}
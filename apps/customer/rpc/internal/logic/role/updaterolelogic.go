@@ -2,6 +2,7 @@ package rolelogic
 
 import (
 	"context"
+	stderrors "errors"
 	"time"
 
 	"gz-dango/apps/customer/rpc/internal/converter"
@@ -29,33 +30,44 @@ func NewUpdateRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Update
 
 func (l *UpdateRoleLogic) UpdateRole(in *pb.UpdateRoleRequest) (*pb.RoleOut, error) {
 	// todo: add your logic here and delete this line
-	data := map[string]any{
-		"update_at": time.Now(),
-		"name":      in.Name,
-		"descr":     in.Descr,
-	}
-
-	pms, err := l.svcCtx.Perm.ListModelByIds(l.ctx, in.PermissionIds)
+	om, err := l.svcCtx.Role.FindModel(l.ctx, nil, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	mms, err := l.svcCtx.Menu.ListModelByIds(l.ctx, in.MenuIds)
+	target, err := applyUpdateRequest(l.ctx, l.svcCtx, *om, in)
 	if err != nil {
-		return nil, database.NewGormError(err, nil)
+		return nil, err
 	}
-	bms, err := l.svcCtx.Button.ListModelByIds(l.ctx, in.ButtonIds)
-	if err != nil {
-		return nil, database.NewGormError(err, nil)
+	data := map[string]any{
+		"updated_at": time.Now(),
+		"name":       target.Name,
+		"descr":      target.Descr,
+		"conditions": target.Conditions,
+	}
+	upmap := map[string]any{
+		"Permissions":     target.Permissions,
+		"Menus":           target.Menus,
+		"Buttons":         target.Buttons,
+		"Parents":         target.Parents,
+		"DenyPermissions": target.DenyPermissions,
+	}
+	// 未指定数据范围时保持原有设置
+	if in.DataScope != "" {
+		data["data_scope"] = target.DataScope
+		upmap["Depts"] = target.Depts
 	}
-	upmap := map[string]any{"Permissions": pms, "Menus": mms, "Buttons": bms}
-	if err := l.svcCtx.Role.UpdateModel(l.ctx, data, upmap); err != nil {
+	// 写入时在事务中重新检查继承环，避免并发更新共同形成环
+	if err := l.svcCtx.Role.UpdateModelWithParents(l.ctx, in.Pk, in.ParentIds, data, upmap); err != nil {
+		if stderrors.Is(err, svc.ErrRoleCycle) {
+			return nil, ErrRoleCycle
+		}
 		return nil, database.NewGormError(err, nil)
 	}
-	m, err := l.svcCtx.Role.FindModel(l.ctx, []string{"Permissions", "Menus", "Buttons"}, in.Pk)
+	m, err := l.svcCtx.Role.FindModel(l.ctx, []string{"Permissions", "Menus", "Buttons", "Parents", "Depts", "DenyPermissions", "PermissionGrants.Permission"}, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	if err := l.svcCtx.Role.RemoveGroupPolicy(l.ctx, *m); err != nil {
+	if err := l.svcCtx.Role.RemoveGroupPolicy(l.ctx, *m, false); err != nil {
 		return nil, ErrRemoveRolePolicy.WithCause(err)
 	}
 	if err := l.svcCtx.Role.AddGroupPolicy(l.ctx, *m); err != nil {
@@ -0,0 +1,43 @@
+package tenantlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateTenantLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateTenantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTenantLogic {
+	return &CreateTenantLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateTenantLogic) CreateTenant(in *pb.CreateTenantRequest) (*pb.TenantOut, error) {
+	// todo: add your logic here and delete this line
+	if err := requirePlatform(l.ctx); err != nil {
+		return nil, err
+	}
+	m := models.TenantModel{
+		Name:     in.Name,
+		IsActive: in.IsActive,
+		Descr:    in.Descr,
+	}
+	if err := l.svcCtx.Tenant.CreateModel(l.ctx, &m); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.TenantModelToOut(m), nil
+}
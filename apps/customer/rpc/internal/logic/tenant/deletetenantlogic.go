@@ -0,0 +1,54 @@
+package tenantlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteTenantLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteTenantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteTenantLogic {
+	return &DeleteTenantLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeleteTenantLogic) DeleteTenant(in *pb.DeleteTenantRequest) (*pb.DeleteOut, error) {
+	// todo: add your logic here and delete this line
+	if err := requirePlatform(l.ctx); err != nil {
+		return nil, err
+	}
+	if _, err := l.svcCtx.Tenant.FindModel(l.ctx, nil, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	deps, err := l.svcCtx.Tenant.Dependents(l.ctx, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(deps, true, false), nil
+	}
+	// 只有部门随租户删除，其他数据仍存在时强制删除也不允许
+	if inUse := deps.Without(svc.DependentDept); len(inUse) > 0 {
+		return nil, ErrTenantInUse.WithData(inUse.Counts())
+	}
+	if len(deps) > 0 && !in.Force {
+		return nil, ErrTenantHasDependents.WithData(deps.Counts())
+	}
+	if err := l.svcCtx.Tenant.DeleteModelWithDepts(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.DependentsToDeleteOut(deps, false, true), nil
+}
@@ -0,0 +1,28 @@
+package tenantlogic
+
+import (
+	"net/http"
+
+	"gz-dango/pkg/errors"
+)
+
+var (
+	ErrPlatformOnly = errors.New(
+		http.StatusForbidden,
+		"platform_only",
+		"只有平台工作人员可以管理租户",
+		nil,
+	)
+	ErrTenantInUse = errors.New(
+		http.StatusConflict,
+		"tenant_in_use",
+		"租户下仍有用户、角色、菜单、按钮、权限或临时授权，不能删除",
+		nil,
+	)
+	ErrTenantHasDependents = errors.New(
+		http.StatusConflict,
+		"tenant_has_dependents",
+		"租户下仍有部门，需强制删除",
+		nil,
+	)
+)
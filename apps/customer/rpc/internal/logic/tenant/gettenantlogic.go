@@ -0,0 +1,38 @@
+package tenantlogic
+
+import (
+	"context"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTenantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantLogic {
+	return &GetTenantLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetTenantLogic) GetTenant(in *pb.GetTenantRequest) (*pb.TenantOut, error) {
+	// todo: add your logic here and delete this line
+	if err := requirePlatform(l.ctx); err != nil {
+		return nil, err
+	}
+	m, err := l.svcCtx.Tenant.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.TenantModelToOut(*m), nil
+}
@@ -0,0 +1,15 @@
+package tenantlogic
+
+import (
+	"context"
+
+	"gz-dango/pkg/auth"
+)
+
+// requirePlatform 只有平台工作人员或内部调用可以管理租户
+func requirePlatform(ctx context.Context) error {
+	if _, scoped := auth.TenantFromContext(ctx); scoped {
+		return ErrPlatformOnly
+	}
+	return nil
+}
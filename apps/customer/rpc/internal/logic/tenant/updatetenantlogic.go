@@ -0,0 +1,48 @@
+package tenantlogic
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/converter"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/database"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateTenantLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateTenantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateTenantLogic {
+	return &UpdateTenantLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateTenantLogic) UpdateTenant(in *pb.UpdateTenantRequest) (*pb.TenantOut, error) {
+	// todo: add your logic here and delete this line
+	if err := requirePlatform(l.ctx); err != nil {
+		return nil, err
+	}
+	data := map[string]any{
+		"updated_at": time.Now(),
+		"name":       in.Name,
+		"is_active":  in.IsActive,
+		"descr":      in.Descr,
+	}
+	if err := l.svcCtx.Tenant.UpdateModel(l.ctx, data, "id = ?", in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	m, err := l.svcCtx.Tenant.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	return converter.TenantModelToOut(*m), nil
+}
@@ -0,0 +1,168 @@
+package userlogic
+
+import (
+	"context"
+	"crypto/rand"
+	stderrors "errors"
+	"math/big"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 首次启动初始化的超级管理员
+const (
+	SuperuserRoleName      = "superuser" // 超级管理员角色名称
+	DefaultAdminUsername   = "admin"     // 未指定用户名时的管理员用户名
+	superuserPermissionUrl = "/*"
+	generatedPasswordLen   = 20
+)
+
+// passwordCharsets 生成的密码包含的字符类型，每种至少一个
+var passwordCharsets = []string{
+	"abcdefghijkmnopqrstuvwxyz",
+	"ABCDEFGHJKLMNPQRSTUVWXYZ",
+	"23456789",
+	"!@#$%^&*-_=+",
+}
+
+// BootstrapOptions 首次启动初始化的参数
+type BootstrapOptions struct {
+	Username string // 管理员用户名，为空时为 DefaultAdminUsername
+	Password string // 管理员密码，为空时随机生成
+}
+
+// BootstrapResult 首次启动初始化的结果
+type BootstrapResult struct {
+	User     models.UserModel
+	Role     models.RoleModel
+	Password string // 随机生成的密码，指定密码时为空，只在此时可见
+}
+
+// Bootstrap 在空数据库中初始化超级管理员角色和平台管理员用户，并添加对应的策略
+// 超级管理员角色关联一个允许所有请求方法访问所有URL的平台级权限，权限和角色已存在时复用
+// 已存在任何用户时拒绝执行，因此重复执行不会产生新的数据
+func Bootstrap(ctx context.Context, svcCtx *svc.ServiceContext, opts BootstrapOptions) (*BootstrapResult, error) {
+	username := opts.Username
+	if username == "" {
+		username = DefaultAdminUsername
+	}
+	password, generated, err := bootstrapPassword(opts.Password)
+	if err != nil {
+		return nil, err
+	}
+	hashed, err := hasher.Hash(password)
+	if err != nil {
+		return nil, ErrPasswordHashError.WithCause(err)
+	}
+
+	su := &svc.Superuser{
+		Permission: models.PermissionModel{
+			Url:     superuserPermissionUrl,
+			Method:  auth.MethodAny,
+			Matcher: auth.MatchKeyMatch2,
+			Effect:  auth.EffectAllow,
+			Label:   SuperuserRoleName,
+			Descr:   "超级管理员：允许访问所有接口",
+		},
+		Role: models.RoleModel{
+			Name:      SuperuserRoleName,
+			Descr:     "超级管理员",
+			DataScope: database.DataScopeAll,
+		},
+		User: models.UserModel{
+			Username: username,
+			Password: hashed,
+			IsActive: true,
+			IsStaff:  true,
+		},
+	}
+	if err := svcCtx.BootstrapSuperuser(ctx, su); err != nil {
+		if stderrors.Is(err, svc.ErrUsersExist) {
+			return nil, ErrBootstrapUsersExist
+		}
+		logx.WithContext(ctx).Errorw(
+			"初始化管理员失败",
+			logx.Field("username", username),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, database.NewGormError(err, nil)
+	}
+	res := &BootstrapResult{User: su.User, Role: su.Role, Password: generated}
+
+	// 权限和角色可能已存在，按业务表重建其策略
+	if err := svcCtx.Perm.RemovePolicy(ctx, su.Permission, false); err != nil {
+		return nil, ErrBootstrapPolicy.WithCause(err)
+	}
+	if err := svcCtx.Perm.AddPolicy(ctx, su.Permission); err != nil {
+		return nil, ErrBootstrapPolicy.WithCause(err)
+	}
+	if err := svcCtx.Role.ResetRolePolicies(ctx, []uint32{res.Role.Id}); err != nil {
+		return nil, ErrBootstrapPolicy.WithCause(err)
+	}
+	if err := svcCtx.User.ResetGroupPolicy(ctx, []uint32{res.User.Id}); err != nil {
+		return nil, ErrBootstrapPolicy.WithCause(err)
+	}
+	if err := svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
+	return res, nil
+}
+
+// bootstrapPassword 返回管理员使用的密码，未指定时随机生成并作为第二个返回值返回
+// 指定的密码需要满足强密码要求
+func bootstrapPassword(password string) (string, string, error) {
+	if password != "" {
+		if GetPasswordStrength(password) < StrengthStrong {
+			return "", "", ErrPasswordStrengthFailed
+		}
+		return password, "", nil
+	}
+	generated, err := generatePassword(generatedPasswordLen)
+	if err != nil {
+		return "", "", ErrPasswordHashError.WithCause(err)
+	}
+	return generated, generated, nil
+}
+
+// generatePassword 生成包含大小写字母、数字和特殊字符的随机密码
+func generatePassword(n int) (string, error) {
+	all := ""
+	for _, cs := range passwordCharsets {
+		all += cs
+	}
+	buf := make([]byte, n)
+	for i := range buf {
+		cs := all
+		if i < len(passwordCharsets) {
+			cs = passwordCharsets[i]
+		}
+		c, err := randomChar(cs)
+		if err != nil {
+			return "", err
+		}
+		buf[i] = c
+	}
+	// 打乱顺序，避免前几位的字符类型固定
+	for i := len(buf) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		buf[i], buf[j.Int64()] = buf[j.Int64()], buf[i]
+	}
+	return string(buf), nil
+}
+
+func randomChar(charset string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[i.Int64()], nil
+}
@@ -0,0 +1,35 @@
+package userlogic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBootstrapPassword(t *testing.T) {
+	password, generated, err := bootstrapPassword("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated == "" || password != generated {
+		t.Errorf("bootstrapPassword(\"\") = %q, %q, want a generated password", password, generated)
+	}
+	if len(generated) != generatedPasswordLen || GetPasswordStrength(generated) < StrengthStrong {
+		t.Errorf("generated password %q is not strong", generated)
+	}
+	if _, again, _ := bootstrapPassword(""); again == generated {
+		t.Error("generated the same password twice")
+	}
+
+	const supplied = "Adm1n!Passw0rd#2024"
+	password, generated, err = bootstrapPassword(supplied)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password != supplied || generated != "" {
+		t.Errorf("bootstrapPassword(supplied) = %q, %q, want the supplied password and no generated one", password, generated)
+	}
+
+	if _, _, err := bootstrapPassword("admin"); !errors.Is(err, ErrPasswordStrengthFailed) {
+		t.Errorf("err = %v, want ErrPasswordStrengthFailed", err)
+	}
+}
@@ -54,6 +54,7 @@ func (l *ChangePasswordLogic) ChangePassword(in *pb.ChangePasswordRequest) (*pb.
 	if err := l.svcCtx.User.UpdateModel(
 		l.ctx,
 		map[string]any{"password": password},
+		nil,
 		map[string]any{"id": uc.UserId},
 	); err != nil {
 		return nil, database.NewGormError(err, nil)
@@ -15,9 +15,25 @@ func UserModelToClaims(m *models.UserModel, exp time.Duration) *auth.UserClaims
 			Subject:   m.Username,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(exp)),
 		},
-		UserId:  m.Id,
-		IsStaff: m.IsStaff,
-		Role:    m.Role.Name,
+		UserId:   m.Id,
+		TenantId: m.TenantId,
+		DeptId:   UserModelDeptId(m),
+		IsStaff:  m.IsStaff,
+		Roles:    UserModelToRoleNames(m),
 	}
 }
 
+func UserModelToRoleNames(m *models.UserModel) []string {
+	names := make([]string, 0, len(m.Roles))
+	for _, r := range m.Roles {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+func UserModelDeptId(m *models.UserModel) uint32 {
+	if m.DeptId == nil {
+		return 0
+	}
+	return *m.DeptId
+}
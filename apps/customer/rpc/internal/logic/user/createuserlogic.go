@@ -7,6 +7,7 @@ import (
 	"gz-dango/apps/customer/rpc/internal/models"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
 	"gz-dango/pkg/database"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -35,20 +36,43 @@ func (l *CreateUserLogic) CreateUser(in *pb.CreateUserRequest) (*pb.UserOut, err
 	if err != nil {
 		return nil, ErrPasswordHashError.WithCause(err)
 	}
-	rm, err := l.svcCtx.Role.FindModel(l.ctx, nil, in.RoleId)
+	// 租户调用方创建的用户总是属于调用方租户
+	tenantId := in.TenantId
+	if tid, ok := auth.TenantFromContext(l.ctx); ok {
+		tenantId = tid
+	}
+	rms, err := listRolesByIds(l.ctx, l.svcCtx, tenantId, in.RoleIds)
 	if err != nil {
-		return nil, database.NewGormError(err, nil)
+		return nil, err
 	}
 	m := models.UserModel{
 		Username: in.Username,
 		Password: password,
 		IsActive: in.IsActive,
 		IsStaff:  in.IsStaff,
-		RoleId:   in.RoleId,
-		Role:     *rm,
+		Roles:    rms,
+		TenantId: in.TenantId,
+	}
+	if in.DeptId != 0 {
+		// 只能把用户分配到数据范围内的部门
+		if err := l.svcCtx.Scope.CheckDept(l.ctx, in.DeptId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		dept, err := l.svcCtx.Dept.FindModel(l.ctx, nil, in.DeptId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		m.DeptId = &in.DeptId
+		m.Dept = dept
 	}
 	if err := l.svcCtx.User.CreateModel(l.ctx, &m); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
+	if err := l.svcCtx.User.AddGroupPolicy(l.ctx, m); err != nil {
+		return nil, ErrAddUserPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
 	return converter.UserModelToOut(m), nil
 }
@@ -3,8 +3,10 @@ package userlogic
 import (
 	"context"
 
+	"gz-dango/apps/customer/rpc/internal/converter"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
 	"gz-dango/pkg/database"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -24,10 +26,33 @@ func NewDeleteCustomerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *De
 	}
 }
 
-func (l *DeleteCustomerLogic) DeleteCustomer(in *pb.DeleteUserRequest) (*pb.NilOut, error) {
+func (l *DeleteCustomerLogic) DeleteCustomer(in *pb.DeleteUserRequest) (*pb.DeleteOut, error) {
 	// todo: add your logic here and delete this line
+	if err := l.svcCtx.Scope.CheckUser(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	m, err := l.svcCtx.User.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	deps, err := l.svcCtx.User.Dependents(l.ctx, m.Id)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if in.DryRun {
+		return converter.DependentsToDeleteOut(deps, true, false), nil
+	}
+	if len(deps) > 0 && !in.Force {
+		return nil, ErrUserHasDependents.WithData(deps.Counts())
+	}
 	if err := l.svcCtx.User.DeleteModel(l.ctx, in.Pk); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	return &pb.NilOut{}, nil
+	if err := l.svcCtx.User.RemoveGroupPolicy(l.ctx, *m); err != nil {
+		return nil, ErrRemoveUserPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
+	return converter.DependentsToDeleteOut(deps, false, true), nil
 }
@@ -43,4 +43,70 @@ var (
 		"用户未激活",
 		nil,
 	)
+	ErrTenantInActive = errors.New(
+		http.StatusUnauthorized,
+		"tenant_inactive",
+		"用户所属租户未激活",
+		nil,
+	)
+	ErrCaptchaRequired = errors.New(
+		http.StatusBadRequest,
+		"captcha_required",
+		"请输入验证码",
+		nil,
+	)
+	ErrInvalidCaptcha = errors.New(
+		http.StatusBadRequest,
+		"invalid_captcha",
+		"验证码错误或已过期",
+		nil,
+	)
+	ErrRoleRequired = errors.New(
+		http.StatusBadRequest,
+		"role_required",
+		"用户至少需要分配一个角色",
+		nil,
+	)
+	ErrRoleNotFound = errors.New(
+		http.StatusNotFound,
+		"role_not_found",
+		"角色不存在",
+		nil,
+	)
+	ErrRoleTenantMismatch = errors.New(
+		http.StatusBadRequest,
+		"role_tenant_mismatch",
+		"不能分配其他租户的角色",
+		nil,
+	)
+	ErrAddUserPolicy = errors.New(
+		http.StatusInternalServerError,
+		"add_user_policy_failed",
+		"添加用户策略失败",
+		nil,
+	)
+	ErrRemoveUserPolicy = errors.New(
+		http.StatusInternalServerError,
+		"remove_user_policy_failed",
+		"删除用户策略失败",
+		nil,
+	)
+	ErrBootstrapUsersExist = errors.New(
+		http.StatusConflict,
+		"bootstrap_users_exist",
+		"已存在用户，不能重复初始化管理员",
+		nil,
+	)
+	ErrBootstrapPolicy = errors.New(
+		http.StatusInternalServerError,
+		"bootstrap_policy_failed",
+		"添加管理员策略失败",
+		nil,
+	)
+	ErrUserHasDependents = errors.New(
+		http.StatusConflict,
+		"user_has_dependents",
+		"用户仍有临时角色，需强制删除",
+		nil,
+	)
 )
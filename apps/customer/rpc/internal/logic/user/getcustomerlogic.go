@@ -28,7 +28,10 @@ func NewGetCustomerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCu
 func (l *GetCustomerLogic) GetCustomer(in *pb.GetUserRequest) (*pb.UserOut, error) {
 	// todo: add your logic here and delete this line
 
-	m, err := l.svcCtx.User.FindModel(l.ctx, []string{"Role"}, in.Pk)
+	if err := l.svcCtx.Scope.CheckUser(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	m, err := l.svcCtx.User.FindModel(l.ctx, []string{"Roles", "Dept"}, in.Pk)
 	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
@@ -10,6 +10,7 @@ import (
 	"gz-dango/pkg/database"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 )
 
 type ListCustomerLogic struct {
@@ -82,15 +83,26 @@ func (l *ListCustomerLogic) ListCustomer(in *pb.ListUserRequest) (*pb.PagUserOut
 		query["is_staff = ?"] = in.IsStaff
 	}
 	if in.RoleId > 0 {
-		query["role_id = ?"] = in.RoleId
+		query["id in (select user_id from customer_user_role where role_id = ?)"] = in.RoleId
+	}
+	if in.TenantId != nil {
+		query["tenant_id = ?"] = in.TenantId.GetValue()
+	}
+	if in.DeptId > 0 {
+		query["dept_id = ?"] = in.DeptId
+	}
+	scope, err := l.svcCtx.Scope.Scope(l.ctx, svc.UserDataScopeColumns)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
 	}
 	qp := database.QueryParams{
-		Preloads: []string{},
+		Preloads: []string{"Roles", "Dept"},
 		Query:    query,
 		OrderBy:  []string{"id"},
 		Limit:    max(size, 0),
 		Offset:   max(page-1, 0),
 		IsCount:  true,
+		Scopes:   []func(*gorm.DB) *gorm.DB{scope},
 	}
 	count, ms, err := l.svcCtx.User.ListModel(l.ctx, qp)
 	if err != nil {
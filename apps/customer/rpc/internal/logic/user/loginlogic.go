@@ -2,9 +2,11 @@ package userlogic
 
 import (
 	"context"
+	"time"
 
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
 	"gz-dango/pkg/database"
 	"gz-dango/pkg/errors"
 
@@ -27,8 +29,12 @@ func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic
 
 func (l *LoginLogic) Login(in *pb.LoginRequest) (*pb.LoginOut, error) {
 	// todo: add your logic here and delete this line
-	m, err := l.svcCtx.User.FindModel(l.ctx, nil, "username = ?", in.Username)
+	if err := l.checkCaptcha(in); err != nil {
+		return nil, err
+	}
+	m, err := l.svcCtx.User.FindModel(l.ctx, []string{"Roles"}, "username = ?", in.Username)
 	if err != nil {
+		l.svcCtx.Captcha.IncrLoginFail(l.ctx, in.Username)
 		return nil, database.NewGormError(err, nil)
 	}
 	ok, err := hasher.Verify(in.Password, m.Password)
@@ -37,8 +43,53 @@ func (l *LoginLogic) Login(in *pb.LoginRequest) (*pb.LoginOut, error) {
 		return nil, errors.FromError(err)
 	}
 	if !ok {
+		l.svcCtx.Captcha.IncrLoginFail(l.ctx, in.Username)
 		return nil, ErrInvalidCredentials
 	}
+	l.svcCtx.Captcha.ResetLoginFail(l.ctx, in.Username)
+	if !m.IsActive {
+		return nil, ErrUserInActive
+	}
+	if m.TenantId != database.PlatformTenantId {
+		tm, err := l.svcCtx.Tenant.FindModel(l.ctx, nil, m.TenantId)
+		if err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		if !tm.IsActive {
+			return nil, ErrTenantInActive
+		}
+	}
+
+	exp := time.Duration(l.svcCtx.Config.Security.TokenExpireMinutes) * time.Minute
+	claims := UserModelToClaims(m, exp)
+	claims.ID = auth.GenerateTokenID()
+	token, err := auth.NewJWT([]byte(l.svcCtx.Config.Security.JwtSecret), *claims)
+	if err != nil {
+		return nil, auth.ErrGeneToken.WithCause(err)
+	}
+	return &pb.LoginOut{Token: token}, nil
+}
 
-	return &pb.LoginOut{}, nil
+// checkCaptcha 根据配置的验证码模式校验登录验证码
+// 验证码无论是否正确都只能使用一次
+func (l *LoginLogic) checkCaptcha(in *pb.LoginRequest) error {
+	required, err := l.svcCtx.Captcha.Required(l.ctx, in.Username)
+	if err != nil {
+		return errors.FromError(err)
+	}
+	if !required {
+		return nil
+	}
+	if in.CaptchaId == "" || in.Captcha == "" {
+		return ErrCaptchaRequired
+	}
+	ok, err := l.svcCtx.Captcha.Verify(l.ctx, in.CaptchaId, in.Captcha)
+	if err != nil {
+		return errors.FromError(err)
+	}
+	if !ok {
+		l.svcCtx.Captcha.IncrLoginFail(l.ctx, in.Username)
+		return ErrInvalidCaptcha
+	}
+	return nil
 }
@@ -26,6 +26,9 @@ func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Res
 
 func (l *ResetPasswordLogic) ResetPassword(in *pb.ResetPasswordRequest) (*pb.NilOut, error) {
 	// todo: add your logic here and delete this line
+	if err := l.svcCtx.Scope.CheckUser(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
 	if t := GetPasswordStrength(in.Password); t < StrengthStrong {
 		return nil, ErrPasswordStrengthFailed
 	}
@@ -33,7 +36,7 @@ func (l *ResetPasswordLogic) ResetPassword(in *pb.ResetPasswordRequest) (*pb.Nil
 	if err != nil {
 		return nil, ErrPasswordHashError.WithCause(err)
 	}
-	if err := l.svcCtx.User.UpdateModel(l.ctx, map[string]any{"password": password}, map[string]any{"id": in.Pk}); err != nil {
+	if err := l.svcCtx.User.UpdateModel(l.ctx, map[string]any{"password": password}, nil, map[string]any{"id": in.Pk}); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
 	return &pb.NilOut{}, nil
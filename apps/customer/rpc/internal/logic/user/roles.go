@@ -0,0 +1,32 @@
+package userlogic
+
+import (
+	"context"
+	"slices"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/apps/customer/rpc/internal/svc"
+	"gz-dango/pkg/database"
+)
+
+// listRolesByIds 按ID列表查询用户要分配的角色
+// 角色列表不能为空，任一角色不存在或既不是平台级也不属于用户所在租户时返回错误
+func listRolesByIds(ctx context.Context, svcCtx *svc.ServiceContext, tenantId uint32, ids []uint32) ([]models.RoleModel, error) {
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	if len(ids) == 0 {
+		return nil, ErrRoleRequired
+	}
+	rms, err := svcCtx.Role.ListModelByIds(ctx, ids)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if len(rms) != len(ids) {
+		return nil, ErrRoleNotFound.WithData(map[string]any{"role_ids": ids})
+	}
+	for _, rm := range rms {
+		if rm.TenantId != database.PlatformTenantId && rm.TenantId != tenantId {
+			return nil, ErrRoleTenantMismatch.WithData(map[string]any{"role_id": rm.Id})
+		}
+	}
+	return rms, nil
+}
@@ -7,6 +7,7 @@ import (
 	"gz-dango/apps/customer/rpc/internal/converter"
 	"gz-dango/apps/customer/rpc/internal/svc"
 	"gz-dango/apps/customer/rpc/pb"
+	"gz-dango/pkg/auth"
 	"gz-dango/pkg/database"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -28,19 +29,49 @@ func NewUpdateCustomerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Up
 
 func (l *UpdateCustomerLogic) UpdateCustomer(in *pb.UpdateUserRequest) (*pb.UserOut, error) {
 	// todo: add your logic here and delete this line
+	if err := l.svcCtx.Scope.CheckUser(l.ctx, in.Pk); err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
 	data := map[string]any{
-		"update_at": time.Now(),
-		"username":  in.Username,
-		"is_active": in.IsActive,
-		"is_staff":  in.IsStaff,
-		"role_id":   in.RoleId,
+		"updated_at": time.Now(),
+		"username":   in.Username,
+		"is_active":  in.IsActive,
+		"is_staff":   in.IsStaff,
+		"dept_id":    nil,
+	}
+	if in.DeptId != 0 {
+		if err := l.svcCtx.Scope.CheckDept(l.ctx, in.DeptId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		if _, err := l.svcCtx.Dept.FindModel(l.ctx, nil, in.DeptId); err != nil {
+			return nil, database.NewGormError(err, nil)
+		}
+		data["dept_id"] = in.DeptId
 	}
-	if err := l.svcCtx.User.UpdateModel(l.ctx, data, in.Pk); err != nil {
+	um, err := l.svcCtx.User.FindModel(l.ctx, nil, in.Pk)
+	if err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
-	m, err := l.svcCtx.User.FindModel(l.ctx, []string{"Role"}, in.Pk)
+	rms, err := listRolesByIds(l.ctx, l.svcCtx, um.TenantId, in.RoleIds)
 	if err != nil {
+		return nil, err
+	}
+	upmap := map[string]any{"Roles": rms}
+	if err := l.svcCtx.User.UpdateModel(l.ctx, data, upmap, in.Pk); err != nil {
 		return nil, database.NewGormError(err, nil)
 	}
+	m, err := l.svcCtx.User.FindModel(l.ctx, []string{"Roles", "Dept", "RoleGrants"}, in.Pk)
+	if err != nil {
+		return nil, database.NewGormError(err, nil)
+	}
+	if err := l.svcCtx.User.RemoveGroupPolicy(l.ctx, *m); err != nil {
+		return nil, ErrRemoveUserPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.User.AddGroupPolicy(l.ctx, *m); err != nil {
+		return nil, ErrAddUserPolicy.WithCause(err)
+	}
+	if err := l.svcCtx.NotifyPolicyChange(); err != nil {
+		return nil, auth.ErrCasbinSyncFailed.WithCause(err)
+	}
 	return converter.UserModelToOut(*m), nil
 }
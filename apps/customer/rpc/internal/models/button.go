@@ -1,14 +1,26 @@
 package models
 
-import "gz-dango/pkg/database"
+import (
+	"regexp"
+
+	"gz-dango/pkg/database"
+)
+
+// buttonCodePattern 按钮的权限标识，由冒号分隔的若干段组成，如 user:create、system:user:export
+var buttonCodePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*(:[a-zA-Z0-9_*-]+)*$`)
+
+const buttonCodeMaxLen = 100
 
 type ButtonModel struct {
 	database.StandardModel
-	Name         string            `gorm:"column:name;type:varchar(50);not null;uniqueIndex;comment:名称" json:"name"`
+	TenantId     uint32            `gorm:"column:tenant_id;not null;default:0;uniqueIndex:idx_customer_button_tenant_name,priority:1;comment:租户" json:"tenant_id"`
+	Name         string            `gorm:"column:name;type:varchar(50);not null;uniqueIndex:idx_customer_button_tenant_name,priority:2;comment:名称" json:"name"`
+	// 未设置权限标识时保存为NULL，不参与同一菜单下的唯一约束
+	Code         string            `gorm:"column:code;type:varchar(100);default:null;uniqueIndex:idx_customer_button_menu_code,priority:2;comment:权限标识" json:"code"`
 	ArrangeOrder uint32              `gorm:"column:arrange_order;type:integer;comment:排序" json:"arrange_order"`
 	IsActive     bool              `gorm:"column:is_active;type:boolean;comment:是否激活" json:"is_active"`
 	Descr        string            `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
-	MenuId       uint32              `gorm:"column:menu_id;foreignKey:MenuId;references:Id;not null;constraint:OnDelete:CASCADE;comment:菜单" json:"menu"`
+	MenuId       uint32              `gorm:"column:menu_id;foreignKey:MenuId;references:Id;not null;uniqueIndex:idx_customer_button_menu_code,priority:1;constraint:OnDelete:CASCADE;comment:菜单" json:"menu"`
 	Menu         MenuModel         `gorm:"foreignKey:MenuId;constraint:OnDelete:CASCADE"`
 	Permissions  []PermissionModel `gorm:"many2many:customer_button_permission;joinForeignKey:button_id;joinReferences:permission_id;constraint:OnDelete:CASCADE"`
 }
@@ -16,3 +28,13 @@ type ButtonModel struct {
 func (m *ButtonModel) TableName() string {
 	return "customer_button"
 }
+
+// SharedTenant 平台级按钮对所有租户可见
+func (m *ButtonModel) SharedTenant() bool {
+	return true
+}
+
+// ValidButtonCode 判断按钮的权限标识是否有效，空字符串表示未设置
+func ValidButtonCode(code string) bool {
+	return code == "" || (len(code) <= buttonCodeMaxLen && buttonCodePattern.MatchString(code))
+}
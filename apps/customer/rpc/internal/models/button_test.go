@@ -0,0 +1,36 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidButtonCode(t *testing.T) {
+	cases := []struct {
+		code string
+		want bool
+	}{
+		{"", true},
+		{"user", true},
+		{"user:create", true},
+		{"system:user:export", true},
+		{"user:*", true},
+		{"user-admin:bulk_delete", true},
+		{"User2:Create", true},
+		{"1user:create", false},
+		{":create", false},
+		{"user:", false},
+		{"user::create", false},
+		{"user create", false},
+		{"user.create", false},
+		{"*:create", false},
+		{"用户:新增", false},
+		{"u" + strings.Repeat(":a", (buttonCodeMaxLen-1)/2), true},
+		{"u" + strings.Repeat("a", buttonCodeMaxLen), false},
+	}
+	for _, c := range cases {
+		if got := ValidButtonCode(c.code); got != c.want {
+			t.Errorf("ValidButtonCode(%q) = %v, want %v", c.code, got, c.want)
+		}
+	}
+}
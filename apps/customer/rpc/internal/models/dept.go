@@ -0,0 +1,19 @@
+package models
+
+import "gz-dango/pkg/database"
+
+// DeptModel 部门，部门之间通过父级部门组成树形结构
+type DeptModel struct {
+	database.StandardModel
+	TenantId     uint32     `gorm:"column:tenant_id;not null;default:0;index;comment:租户" json:"tenant_id"`
+	Name         string     `gorm:"column:name;type:varchar(50);not null;comment:名称" json:"name"`
+	ArrangeOrder uint32     `gorm:"column:arrange_order;type:integer;comment:排序" json:"arrange_order"`
+	IsActive     bool       `gorm:"column:is_active;type:boolean;comment:是否激活" json:"is_active"`
+	Descr        string     `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
+	ParentId     *uint32    `gorm:"column:parent_id;foreignKey:ParentId;references:Id;constraint:OnDelete:CASCADE;comment:父级部门" json:"parent"`
+	Parent       *DeptModel `gorm:"foreignKey:ParentId;constraint:OnDelete:CASCADE"`
+}
+
+func (m *DeptModel) TableName() string {
+	return "customer_dept"
+}
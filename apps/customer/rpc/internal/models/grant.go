@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"gz-dango/pkg/database"
+)
+
+// UserRoleGrantModel 用户在有效期内临时拥有的角色
+type UserRoleGrantModel struct {
+	database.StandardModel
+	TenantId uint32     `gorm:"column:tenant_id;not null;default:0;index;comment:租户" json:"tenant_id"`
+	UserId   uint32     `gorm:"column:user_id;not null;index;comment:用户" json:"user_id"`
+	RoleId   uint32     `gorm:"column:role_id;not null;index;comment:角色" json:"role_id"`
+	Role     *RoleModel `gorm:"foreignKey:RoleId;constraint:OnDelete:RESTRICT"`
+	StartAt  time.Time  `gorm:"column:start_at;not null;index;comment:生效时间" json:"start_at"`
+	EndAt    time.Time  `gorm:"column:end_at;not null;index;comment:失效时间" json:"end_at"`
+	Descr    string     `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
+}
+
+func (m *UserRoleGrantModel) TableName() string {
+	return "customer_user_role_grant"
+}
+
+// Active 判断授权在指定时间是否生效
+func (m *UserRoleGrantModel) Active(now time.Time) bool {
+	return !now.Before(m.StartAt) && now.Before(m.EndAt)
+}
+
+// RolePermissionGrantModel 角色在有效期内临时拥有的权限
+type RolePermissionGrantModel struct {
+	database.StandardModel
+	TenantId     uint32           `gorm:"column:tenant_id;not null;default:0;index;comment:租户" json:"tenant_id"`
+	RoleId       uint32           `gorm:"column:role_id;not null;index;comment:角色" json:"role_id"`
+	PermissionId uint32           `gorm:"column:permission_id;not null;index;comment:权限" json:"permission_id"`
+	Permission   *PermissionModel `gorm:"foreignKey:PermissionId;constraint:OnDelete:CASCADE"`
+	StartAt      time.Time        `gorm:"column:start_at;not null;index;comment:生效时间" json:"start_at"`
+	EndAt        time.Time        `gorm:"column:end_at;not null;index;comment:失效时间" json:"end_at"`
+	Descr        string           `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
+}
+
+func (m *RolePermissionGrantModel) TableName() string {
+	return "customer_role_permission_grant"
+}
+
+// Active 判断授权在指定时间是否生效
+func (m *RolePermissionGrantModel) Active(now time.Time) bool {
+	return !now.Before(m.StartAt) && now.Before(m.EndAt)
+}
@@ -2,15 +2,35 @@ package models
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	"gz-dango/pkg/database"
 )
 
+// Meta 前端路由的元信息，字段命名与 Vue Router 和常见后台模板保持一致
 type Meta struct {
-	Title string `json:"title"`
-	Icon  string `json:"icon"`
+	Title        string            `json:"title" yaml:"title"`
+	Icon         string            `json:"icon" yaml:"icon"`
+	Hidden       bool              `json:"hidden,omitempty" yaml:"hidden,omitempty"`             // 不在菜单中显示，路由仍然可以访问
+	KeepAlive    bool              `json:"keepAlive,omitempty" yaml:"keepAlive,omitempty"`       // 缓存页面组件
+	Redirect     string            `json:"redirect,omitempty" yaml:"redirect,omitempty"`         // 访问时重定向的路径
+	Affix        bool              `json:"affix,omitempty" yaml:"affix,omitempty"`               // 固定在标签栏，不能关闭
+	ExternalLink string            `json:"externalLink,omitempty" yaml:"externalLink,omitempty"` // 外部链接，设置后不加载组件
+	Badge        string            `json:"badge,omitempty" yaml:"badge,omitempty"`               // 菜单上显示的徽标
+	ActiveMenu   string            `json:"activeMenu,omitempty" yaml:"activeMenu,omitempty"`     // 访问时高亮的菜单路径，用于隐藏的详情页
+	Titles       map[string]string `json:"titles,omitempty" yaml:"titles,omitempty"`             // 各语言的标题，键为语言标签，如 en-US
 }
 
+const metaBadgeMaxLen = 20
+
+// localePattern 语言标签，如 zh、en-US、zh-Hant-TW
+var localePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
 func (m *Meta) Json() string {
 	metaBytes, err := json.Marshal(m)
 	if err != nil {
@@ -19,11 +39,59 @@ func (m *Meta) Json() string {
 	return string(metaBytes)
 }
 
+// SetDefaults 未设置标题时使用菜单名称，清理空白的多语言标题
+func (m *Meta) SetDefaults(name string) {
+	m.Title = strings.TrimSpace(m.Title)
+	if m.Title == "" {
+		m.Title = name
+	}
+	for locale, title := range m.Titles {
+		if strings.TrimSpace(title) == "" {
+			delete(m.Titles, locale)
+		}
+	}
+	if len(m.Titles) == 0 {
+		m.Titles = nil
+	}
+}
+
+// Validate 校验元信息，路径须为以 / 开头的前端路由，外部链接须为 http 或 https 地址
+func (m *Meta) Validate() error {
+	if m.Redirect != "" && !strings.HasPrefix(m.Redirect, "/") {
+		return fmt.Errorf("redirect must start with /: %q", m.Redirect)
+	}
+	if m.ActiveMenu != "" && !strings.HasPrefix(m.ActiveMenu, "/") {
+		return fmt.Errorf("activeMenu must start with /: %q", m.ActiveMenu)
+	}
+	if m.ExternalLink != "" {
+		u, err := url.Parse(m.ExternalLink)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("externalLink must be an http or https url: %q", m.ExternalLink)
+		}
+		if m.Redirect != "" {
+			return errors.New("externalLink and redirect cannot both be set")
+		}
+	}
+	if m.Hidden && m.Affix {
+		return errors.New("a hidden menu cannot be affixed")
+	}
+	if utf8.RuneCountInString(m.Badge) > metaBadgeMaxLen {
+		return fmt.Errorf("badge must be at most %d characters", metaBadgeMaxLen)
+	}
+	for locale := range m.Titles {
+		if !localePattern.MatchString(locale) {
+			return fmt.Errorf("invalid locale: %q", locale)
+		}
+	}
+	return nil
+}
+
 type MenuModel struct {
 	database.StandardModel
-	Path         string            `gorm:"column:path;type:varchar(100);not null;uniqueIndex;comment:前端路由" json:"url"`
+	TenantId     uint32            `gorm:"column:tenant_id;not null;default:0;uniqueIndex:idx_customer_menu_tenant_path,priority:1;uniqueIndex:idx_customer_menu_tenant_name,priority:1;comment:租户" json:"tenant_id"`
+	Path         string            `gorm:"column:path;type:varchar(100);not null;uniqueIndex:idx_customer_menu_tenant_path,priority:2;comment:前端路由" json:"url"`
 	Component    string            `gorm:"column:component;type:varchar(200);not null;comment:请求方式" json:"method"`
-	Name         string            `gorm:"column:name;type:varchar(50);not null;uniqueIndex;comment:名称" json:"name"`
+	Name         string            `gorm:"column:name;type:varchar(50);not null;uniqueIndex:idx_customer_menu_tenant_name,priority:2;comment:名称" json:"name"`
 	Meta         Meta              `gorm:"column:meta;serializer:json;comment:菜单信息" json:"meta"`
 	Label        string            `gorm:"column:label;type:varchar(50);index:idx_member;comment:标签" json:"label"`
 	ArrangeOrder uint32            `gorm:"column:arrange_order;type:integer;comment:排序" json:"arrange_order"`
@@ -37,3 +105,8 @@ type MenuModel struct {
 func (m *MenuModel) TableName() string {
 	return "customer_menu"
 }
+
+// SharedTenant 平台级菜单对所有租户可见
+func (m *MenuModel) SharedTenant() bool {
+	return true
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMetaSetDefaults(t *testing.T) {
+	cases := []struct {
+		name string
+		meta Meta
+		want Meta
+	}{
+		{"title from name", Meta{}, Meta{Title: "用户管理"}},
+		{"blank title", Meta{Title: "  "}, Meta{Title: "用户管理"}},
+		{"trimmed title", Meta{Title: " 用户 "}, Meta{Title: "用户"}},
+		{
+			"blank locale titles removed",
+			Meta{Title: "用户", Titles: map[string]string{"en-US": "Users", "ja": " "}},
+			Meta{Title: "用户", Titles: map[string]string{"en-US": "Users"}},
+		},
+		{"all locale titles blank", Meta{Title: "用户", Titles: map[string]string{"en": ""}}, Meta{Title: "用户"}},
+		{"empty locale titles", Meta{Title: "用户", Titles: map[string]string{}}, Meta{Title: "用户"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := c.meta
+			m.SetDefaults("用户管理")
+			if !reflect.DeepEqual(m, c.want) {
+				t.Errorf("SetDefaults = %+v, want %+v", m, c.want)
+			}
+		})
+	}
+}
+
+func TestMetaValidate(t *testing.T) {
+	cases := []struct {
+		name  string
+		meta  Meta
+		valid bool
+	}{
+		{"empty", Meta{}, true},
+		{"full", Meta{Redirect: "/user/list", ActiveMenu: "/user", Badge: "new", Titles: map[string]string{"zh-Hant-TW": "使用者"}}, true},
+		{"relative redirect", Meta{Redirect: "user/list"}, false},
+		{"relative active menu", Meta{ActiveMenu: "user"}, false},
+		{"https link", Meta{ExternalLink: "https://example.com/docs"}, true},
+		{"http link", Meta{ExternalLink: "http://example.com"}, true},
+		{"javascript link", Meta{ExternalLink: "javascript:alert(1)"}, false},
+		{"link without host", Meta{ExternalLink: "https:///docs"}, false},
+		{"relative link", Meta{ExternalLink: "/docs"}, false},
+		{"link with redirect", Meta{ExternalLink: "https://example.com", Redirect: "/user"}, false},
+		{"hidden and affixed", Meta{Hidden: true, Affix: true}, false},
+		{"hidden", Meta{Hidden: true}, true},
+		{"badge at limit", Meta{Badge: strings.Repeat("新", metaBadgeMaxLen)}, true},
+		{"badge too long", Meta{Badge: strings.Repeat("a", metaBadgeMaxLen+1)}, false},
+		{"invalid locale", Meta{Titles: map[string]string{"english": "Users"}}, false},
+		{"locale with underscore", Meta{Titles: map[string]string{"en_US": "Users"}}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.meta.Validate()
+			if (err == nil) != c.valid {
+				t.Errorf("Validate(%+v) = %v, want valid %v", c.meta, err, c.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// MigrateUserRoles 将旧版 customer_user.role_id 的单角色数据迁移到用户角色关联表
+// 迁移完成后删除旧的外键约束和 role_id 列，重复执行是安全的
+func MigrateUserRoles(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasColumn(&UserModel{}, "role_id") {
+		return nil
+	}
+	if err := db.Exec(
+		"INSERT INTO customer_user_role (user_id, role_id) " +
+			"SELECT u.id, u.role_id FROM customer_user u " +
+			"WHERE u.role_id IS NOT NULL AND NOT EXISTS (" +
+			"SELECT 1 FROM customer_user_role ur WHERE ur.user_id = u.id AND ur.role_id = u.role_id)",
+	).Error; err != nil {
+		return err
+	}
+	if migrator.HasConstraint(&UserModel{}, "fk_customer_user_role") {
+		if err := migrator.DropConstraint(&UserModel{}, "fk_customer_user_role"); err != nil {
+			return err
+		}
+	}
+	return migrator.DropColumn(&UserModel{}, "role_id")
+}
+
+// MigrateTenantIndexes 删除引入租户前的全局唯一索引
+// 名称等字段改为在租户内唯一，由 (tenant_id, 字段) 联合唯一索引替代，重复执行是安全的
+func MigrateTenantIndexes(db *gorm.DB) error {
+	migrator := db.Migrator()
+	indexes := []struct {
+		model any
+		name  string
+	}{
+		{&RoleModel{}, "idx_customer_role_name"},
+		{&MenuModel{}, "idx_customer_menu_path"},
+		{&MenuModel{}, "idx_customer_menu_name"},
+		{&ButtonModel{}, "idx_customer_button_name"},
+	}
+	for _, idx := range indexes {
+		if !migrator.HasIndex(idx.model, idx.name) {
+			continue
+		}
+		if err := migrator.DropIndex(idx.model, idx.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MigrateRestrictUserRoleKeys 将用户角色关联表和用户临时角色表的外键由级联删除改为限制删除
+// 删除角色或用户前须先处理其关联，避免级联删除静默地收回用户的角色；重复执行是安全的
+// SQLite 不支持修改外键约束，跳过
+func MigrateRestrictUserRoleKeys(db *gorm.DB) error {
+	if db.Dialector.Name() == "sqlite" {
+		return nil
+	}
+	keys := []struct {
+		table any
+		name  string
+	}{
+		{"customer_user_role", "fk_customer_user_role_user_model"},
+		{"customer_user_role", "fk_customer_user_role_role_model"},
+		{&UserRoleGrantModel{}, "fk_customer_user_role_grant_role"},
+	}
+	// 只查询当前库的约束，同一实例的其他库或schema中可能存在同名约束
+	currentSchema := "current_schema()"
+	switch db.Dialector.Name() {
+	case "mysql":
+		currentSchema = "DATABASE()"
+	case "sqlserver":
+		currentSchema = "SCHEMA_NAME()"
+	}
+	changed := false
+	for _, k := range keys {
+		var rules []string
+		if err := db.Raw(
+			"SELECT delete_rule FROM information_schema.referential_constraints "+
+				"WHERE constraint_schema = "+currentSchema+" AND constraint_name = ?",
+			k.name,
+		).Scan(&rules).Error; err != nil {
+			return err
+		}
+		if len(rules) == 0 || !strings.EqualFold(rules[0], "CASCADE") {
+			continue
+		}
+		if err := db.Migrator().DropConstraint(k.table, k.name); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	// 按模型中的限制删除重新创建被删除的外键
+	return db.AutoMigrate(&UserModel{}, &UserRoleGrantModel{})
+}
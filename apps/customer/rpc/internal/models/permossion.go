@@ -6,12 +6,21 @@ import (
 
 type PermissionModel struct {
 	database.StandardModel
-	Url    string `gorm:"column:url;type:varchar(150);index:idx_member;comment:URL地址" json:"url"`
-	Method string `gorm:"column:method;type:varchar(10);index:idx_member;comment:请求方法" json:"method"`
-	Label  string `gorm:"column:label;type:varchar(50);index:idx_member;comment:标签" json:"label"`
-	Descr  string `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
+	TenantId   uint32 `gorm:"column:tenant_id;not null;default:0;index;comment:租户" json:"tenant_id"`
+	Url        string `gorm:"column:url;type:varchar(150);index:idx_member;comment:URL地址" json:"url"`
+	Method     string `gorm:"column:method;type:varchar(50);index:idx_member;comment:请求方法" json:"method"`
+	Matcher    string `gorm:"column:matcher;type:varchar(20);not null;default:'exact';comment:URL匹配方式" json:"matcher"`
+	Effect     string `gorm:"column:effect;type:varchar(10);not null;default:'allow';comment:效果" json:"effect"`
+	Conditions string `gorm:"column:conditions;type:varchar(1000);not null;default:'';comment:附加条件" json:"conditions"`
+	Label      string `gorm:"column:label;type:varchar(50);index:idx_member;comment:标签" json:"label"`
+	Descr      string `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
 }
 
 func (m *PermissionModel) TableName() string {
 	return "customer_permission"
 }
+
+// SharedTenant 平台级权限对所有租户可见
+func (m *PermissionModel) SharedTenant() bool {
+	return true
+}
@@ -4,11 +4,18 @@ import "gz-dango/pkg/database"
 
 type RoleModel struct {
 	database.StandardModel
-	Name        string            `gorm:"column:name;type:varchar(50);not null;uniqueIndex;comment:名称" json:"name"`
-	Descr       string            `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
-	Permissions []PermissionModel `gorm:"many2many:customer_role_permission;joinForeignKey:role_id;joinReferences:permission_id;constraint:OnDelete:CASCADE"`
-	Menus       []MenuModel       `gorm:"many2many:customer_role_menu;joinForeignKey:role_id;joinReferences:menu_id;constraint:OnDelete:CASCADE"`
-	Buttons     []ButtonModel     `gorm:"many2many:customer_role_button;joinForeignKey:role_id;joinReferences:button_id;constraint:OnDelete:CASCADE"`
+	TenantId         uint32                     `gorm:"column:tenant_id;not null;default:0;uniqueIndex:idx_customer_role_tenant_name,priority:1;comment:租户" json:"tenant_id"`
+	Name             string                     `gorm:"column:name;type:varchar(50);not null;uniqueIndex:idx_customer_role_tenant_name,priority:2;comment:名称" json:"name"`
+	Descr            string                     `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
+	DataScope        string                     `gorm:"column:data_scope;type:varchar(20);not null;default:'all';comment:数据范围" json:"data_scope"`
+	Conditions       string                     `gorm:"column:conditions;type:varchar(1000);not null;default:'';comment:附加条件" json:"conditions"`
+	Permissions      []PermissionModel          `gorm:"many2many:customer_role_permission;joinForeignKey:role_id;joinReferences:permission_id;constraint:OnDelete:CASCADE"`
+	Menus            []MenuModel                `gorm:"many2many:customer_role_menu;joinForeignKey:role_id;joinReferences:menu_id;constraint:OnDelete:CASCADE"`
+	Buttons          []ButtonModel              `gorm:"many2many:customer_role_button;joinForeignKey:role_id;joinReferences:button_id;constraint:OnDelete:CASCADE"`
+	Parents          []RoleModel                `gorm:"many2many:customer_role_parent;joinForeignKey:role_id;joinReferences:parent_id;constraint:OnDelete:CASCADE"`
+	Depts            []DeptModel                `gorm:"many2many:customer_role_dept;joinForeignKey:role_id;joinReferences:dept_id;constraint:OnDelete:CASCADE"`
+	DenyPermissions  []PermissionModel          `gorm:"many2many:customer_role_deny_permission;joinForeignKey:role_id;joinReferences:permission_id;constraint:OnDelete:CASCADE"`
+	PermissionGrants []RolePermissionGrantModel `gorm:"foreignKey:RoleId;constraint:OnDelete:CASCADE"`
 }
 
 func (m *RoleModel) TableName() string {
@@ -0,0 +1,16 @@
+package models
+
+import "gz-dango/pkg/database"
+
+// TenantModel 租户，用户、角色、菜单、按钮和权限都归属于某个租户
+// 租户ID为0的数据属于平台
+type TenantModel struct {
+	database.StandardModel
+	Name     string `gorm:"column:name;type:varchar(50);not null;uniqueIndex;comment:名称" json:"name"`
+	IsActive bool   `gorm:"column:is_active;type:boolean;comment:是否激活" json:"is_active"`
+	Descr    string `gorm:"column:descr;type:varchar(254);comment:描述" json:"descr"`
+}
+
+func (m *TenantModel) TableName() string {
+	return "customer_tenant"
+}
@@ -33,7 +33,7 @@ func (s *ButtonServer) UpdateButton(ctx context.Context, in *pb.UpdateButtonRequ
 	return l.UpdateButton(in)
 }
 
-func (s *ButtonServer) DeleteButton(ctx context.Context, in *pb.DeleteButtonRequest) (*pb.NilOut, error) {
+func (s *ButtonServer) DeleteButton(ctx context.Context, in *pb.DeleteButtonRequest) (*pb.DeleteOut, error) {
 	l := buttonlogic.NewDeleteButtonLogic(ctx, s.svcCtx)
 	return l.DeleteButton(in)
 }
@@ -47,3 +47,8 @@ func (s *ButtonServer) ListButton(ctx context.Context, in *pb.ListButtonRequest)
 	l := buttonlogic.NewListButtonLogic(ctx, s.svcCtx)
 	return l.ListButton(in)
 }
+
+func (s *ButtonServer) ListAllowedButtonCodes(ctx context.Context, in *pb.ListAllowedButtonCodesRequest) (*pb.AllowedButtonCodesOut, error) {
+	l := buttonlogic.NewListAllowedButtonCodesLogic(ctx, s.svcCtx)
+	return l.ListAllowedButtonCodes(in)
+}
@@ -33,7 +33,7 @@ func (s *MenuServer) UpdateMenu(ctx context.Context, in *pb.UpdateMenuRequest) (
 	return l.UpdateMenu(in)
 }
 
-func (s *MenuServer) DeleteMenu(ctx context.Context, in *pb.DeleteMenuRequest) (*pb.NilOut, error) {
+func (s *MenuServer) DeleteMenu(ctx context.Context, in *pb.DeleteMenuRequest) (*pb.DeleteOut, error) {
 	l := menulogic.NewDeleteMenuLogic(ctx, s.svcCtx)
 	return l.DeleteMenu(in)
 }
@@ -47,3 +47,23 @@ func (s *MenuServer) ListMenu(ctx context.Context, in *pb.ListMenuRequest) (*pb.
 	l := menulogic.NewListMenuLogic(ctx, s.svcCtx)
 	return l.ListMenu(in)
 }
+
+func (s *MenuServer) GetMenuTree(ctx context.Context, in *pb.GetMenuTreeRequest) (*pb.MenuTreeOut, error) {
+	l := menulogic.NewGetMenuTreeLogic(ctx, s.svcCtx)
+	return l.GetMenuTree(in)
+}
+
+func (s *MenuServer) MoveMenu(ctx context.Context, in *pb.MoveMenuRequest) (*pb.MenuOut, error) {
+	l := menulogic.NewMoveMenuLogic(ctx, s.svcCtx)
+	return l.MoveMenu(in)
+}
+
+func (s *MenuServer) ReorderMenus(ctx context.Context, in *pb.ReorderMenusRequest) (*pb.NilOut, error) {
+	l := menulogic.NewReorderMenusLogic(ctx, s.svcCtx)
+	return l.ReorderMenus(in)
+}
+
+func (s *MenuServer) ExportMenuRoutes(ctx context.Context, in *pb.ExportMenuRoutesRequest) (*pb.MenuRoutesOut, error) {
+	l := menulogic.NewExportMenuRoutesLogic(ctx, s.svcCtx)
+	return l.ExportMenuRoutes(in)
+}
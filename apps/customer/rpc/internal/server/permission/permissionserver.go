@@ -33,7 +33,7 @@ func (s *PermissionServer) UpdatePermission(ctx context.Context, in *pb.UpdatePe
 	return l.UpdatePermission(in)
 }
 
-func (s *PermissionServer) DeletePermission(ctx context.Context, in *pb.DeletePermissionRequest) (*pb.NilOut, error) {
+func (s *PermissionServer) DeletePermission(ctx context.Context, in *pb.DeletePermissionRequest) (*pb.DeleteOut, error) {
 	l := permissionlogic.NewDeletePermissionLogic(ctx, s.svcCtx)
 	return l.DeletePermission(in)
 }
@@ -47,3 +47,13 @@ func (s *PermissionServer) ListPermission(ctx context.Context, in *pb.ListPermis
 	l := permissionlogic.NewListPermissionLogic(ctx, s.svcCtx)
 	return l.ListPermission(in)
 }
+
+func (s *PermissionServer) ExplainAccess(ctx context.Context, in *pb.ExplainAccessRequest) (*pb.ExplainAccessOut, error) {
+	l := permissionlogic.NewExplainAccessLogic(ctx, s.svcCtx)
+	return l.ExplainAccess(in)
+}
+
+func (s *PermissionServer) SyncPermissions(ctx context.Context, in *pb.SyncPermissionsRequest) (*pb.SyncPermissionsOut, error) {
+	l := permissionlogic.NewSyncPermissionsLogic(ctx, s.svcCtx)
+	return l.SyncPermissions(in)
+}
@@ -33,7 +33,7 @@ func (s *RoleServer) UpdateRole(ctx context.Context, in *pb.UpdateRoleRequest) (
 	return l.UpdateRole(in)
 }
 
-func (s *RoleServer) DeleteRole(ctx context.Context, in *pb.DeleteRoleRequest) (*pb.NilOut, error) {
+func (s *RoleServer) DeleteRole(ctx context.Context, in *pb.DeleteRoleRequest) (*pb.DeleteOut, error) {
 	l := rolelogic.NewDeleteRoleLogic(ctx, s.svcCtx)
 	return l.DeleteRole(in)
 }
@@ -47,3 +47,18 @@ func (s *RoleServer) ListRole(ctx context.Context, in *pb.ListRoleRequest) (*pb.
 	l := rolelogic.NewListRoleLogic(ctx, s.svcCtx)
 	return l.ListRole(in)
 }
+
+func (s *RoleServer) GetRoleImpact(ctx context.Context, in *pb.GetRoleRequest) (*pb.RoleImpactOut, error) {
+	l := rolelogic.NewGetRoleImpactLogic(ctx, s.svcCtx)
+	return l.GetRoleImpact(in)
+}
+
+func (s *RoleServer) CloneRole(ctx context.Context, in *pb.CloneRoleRequest) (*pb.RoleOut, error) {
+	l := rolelogic.NewCloneRoleLogic(ctx, s.svcCtx)
+	return l.CloneRole(in)
+}
+
+func (s *RoleServer) DiffRoles(ctx context.Context, in *pb.DiffRolesRequest) (*pb.RoleDiffOut, error) {
+	l := rolelogic.NewDiffRolesLogic(ctx, s.svcCtx)
+	return l.DiffRoles(in)
+}
@@ -33,7 +33,7 @@ func (s *UserServer) UpdateCustomer(ctx context.Context, in *pb.UpdateUserReques
 	return l.UpdateCustomer(in)
 }
 
-func (s *UserServer) DeleteCustomer(ctx context.Context, in *pb.DeleteUserRequest) (*pb.NilOut, error) {
+func (s *UserServer) DeleteCustomer(ctx context.Context, in *pb.DeleteUserRequest) (*pb.DeleteOut, error) {
 	l := userlogic.NewDeleteCustomerLogic(ctx, s.svcCtx)
 	return l.DeleteCustomer(in)
 }
@@ -0,0 +1,72 @@
+package svc
+
+import (
+	"context"
+	stderrors "errors"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/database"
+
+	"gorm.io/gorm"
+)
+
+// ErrUsersExist 已存在用户时不能初始化超级管理员
+var ErrUsersExist = stderrors.New("users already exist")
+
+// Superuser 首次启动初始化的超级管理员权限、角色和用户
+type Superuser struct {
+	Permission models.PermissionModel
+	Role       models.RoleModel
+	User       models.UserModel
+}
+
+// BootstrapSuperuser 在空数据库中创建超级管理员
+// 按平台级的URL、请求方法、匹配方式和效果查找权限，按平台级的名称查找角色，已存在时复用，否则按 su 中的数据创建
+// 角色关联该权限，用户只拥有该角色；已存在任何用户时返回 ErrUsersExist 且不写入任何数据
+func (s *ServiceContext) BootstrapSuperuser(ctx context.Context, su *Superuser) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.UserModel{}).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrUsersExist
+		}
+		// 结构体条件会忽略零值的租户ID，使用map条件
+		perm := su.Permission
+		err := tx.Where(map[string]any{
+			"tenant_id": database.PlatformTenantId,
+			"url":       perm.Url,
+			"method":    perm.Method,
+			"matcher":   perm.Matcher,
+			"effect":    perm.Effect,
+		}).First(&su.Permission).Error
+		if stderrors.Is(err, gorm.ErrRecordNotFound) {
+			su.Permission = perm
+			su.Permission.TenantId = database.PlatformTenantId
+			err = tx.Create(&su.Permission).Error
+		}
+		if err != nil {
+			return err
+		}
+		role := su.Role
+		err = tx.Where(map[string]any{
+			"tenant_id": database.PlatformTenantId,
+			"name":      role.Name,
+		}).First(&su.Role).Error
+		if stderrors.Is(err, gorm.ErrRecordNotFound) {
+			su.Role = role
+			su.Role.TenantId = database.PlatformTenantId
+			err = tx.Create(&su.Role).Error
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&su.Role).Association("Permissions").Append(&su.Permission); err != nil {
+			return err
+		}
+		su.User.TenantId = database.PlatformTenantId
+		su.User.Roles = []models.RoleModel{su.Role}
+		return tx.Omit("Roles.*").Create(&su.User).Error
+	})
+}
@@ -0,0 +1,111 @@
+package svc
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+)
+
+func newTestSuperuser() *Superuser {
+	return &Superuser{
+		Permission: models.PermissionModel{Url: "/*", Method: auth.MethodAny, Matcher: auth.MatchKeyMatch2, Effect: auth.EffectAllow},
+		Role:       models.RoleModel{Name: "superuser", DataScope: database.DataScopeAll},
+		User:       models.UserModel{Username: "admin", Password: "x", IsActive: true, IsStaff: true},
+	}
+}
+
+func TestBootstrapSuperuser(t *testing.T) {
+	db := newTestDB(t)
+	s := &ServiceContext{db: db}
+	su := newTestSuperuser()
+	if err := s.BootstrapSuperuser(context.Background(), su); err != nil {
+		t.Fatal(err)
+	}
+	if su.Permission.Id == 0 || su.Role.Id == 0 || su.User.Id == 0 {
+		t.Fatalf("superuser not created: %+v", su)
+	}
+	var role models.RoleModel
+	if err := db.Preload("Permissions").First(&role, su.Role.Id).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(role.Permissions) != 1 || role.Permissions[0].Id != su.Permission.Id {
+		t.Errorf("role permissions = %+v", role.Permissions)
+	}
+	var user models.UserModel
+	if err := db.Preload("Roles").First(&user, su.User.Id).Error; err != nil {
+		t.Fatal(err)
+	}
+	if user.TenantId != database.PlatformTenantId || len(user.Roles) != 1 || user.Roles[0].Id != su.Role.Id {
+		t.Errorf("user = %+v", user)
+	}
+}
+
+func TestBootstrapSuperuserRefusesWhenUsersExist(t *testing.T) {
+	db := newTestDB(t)
+	s := &ServiceContext{db: db}
+	if err := db.Create(&models.UserModel{Username: "existing", Password: "x"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	err := s.BootstrapSuperuser(context.Background(), newTestSuperuser())
+	if !stderrors.Is(err, ErrUsersExist) {
+		t.Fatalf("err = %v, want ErrUsersExist", err)
+	}
+	for _, m := range []any{&models.PermissionModel{}, &models.RoleModel{}} {
+		var count int64
+		if err := db.Model(m).Count(&count).Error; err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Errorf("%T: %d rows written", m, count)
+		}
+	}
+}
+
+func TestBootstrapSuperuserReusesRoleAndPermission(t *testing.T) {
+	db := newTestDB(t)
+	s := &ServiceContext{db: db}
+	perm := models.PermissionModel{Url: "/*", Method: auth.MethodAny, Matcher: auth.MatchKeyMatch2, Effect: auth.EffectAllow, Label: "existing"}
+	if err := db.Create(&perm).Error; err != nil {
+		t.Fatal(err)
+	}
+	role := models.RoleModel{Name: "superuser", Descr: "existing", Permissions: []models.PermissionModel{perm}}
+	if err := db.Create(&role).Error; err != nil {
+		t.Fatal(err)
+	}
+	// 其他租户的同名角色不会被复用
+	other := models.RoleModel{TenantId: 1, Name: "superuser"}
+	if err := db.Create(&other).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	su := newTestSuperuser()
+	if err := s.BootstrapSuperuser(context.Background(), su); err != nil {
+		t.Fatal(err)
+	}
+	if su.Permission.Id != perm.Id || su.Permission.Label != "existing" {
+		t.Errorf("permission = %+v, want existing %d", su.Permission, perm.Id)
+	}
+	if su.Role.Id != role.Id || su.Role.Descr != "existing" {
+		t.Errorf("role = %+v, want existing %d", su.Role, role.Id)
+	}
+	var counts [2]int64
+	if err := db.Model(&models.PermissionModel{}).Count(&counts[0]).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Model(&models.RoleModel{}).Count(&counts[1]).Error; err != nil {
+		t.Fatal(err)
+	}
+	if counts != [2]int64{1, 2} {
+		t.Errorf("permission and role counts = %v, want [1 2]", counts)
+	}
+	if err := db.Preload("Permissions").First(&role, role.Id).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(role.Permissions) != 1 {
+		t.Errorf("role permissions = %+v, want the existing one only", role.Permissions)
+	}
+}
@@ -3,6 +3,9 @@ package svc
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"gz-dango/apps/customer/rpc/internal/models"
@@ -54,6 +57,7 @@ func (s *ButtonService) UpdateModel(ctx context.Context, data map[string]any, up
 		fields := database.MapToLogFields(data)
 		fields = append(fields, logx.Field(errors.ErrKey, err))
 		logx.WithContext(ctx).Errorw("更新按钮模型失败", fields...)
+		return err
 	}
 	return nil
 }
@@ -114,7 +118,8 @@ func (s *ButtonService) ListModelByIds(
 	return ms, err
 }
 
-func (s *ButtonService) LoadPolicies(ctx context.Context) error {
+// listPolicyModels 查询生成策略所需的全部按钮及其关联数据
+func (s *ButtonService) listPolicyModels(ctx context.Context) ([]models.ButtonModel, error) {
 	qp := database.QueryParams{
 		Preloads: []string{"Menu", "Permissions"},
 		Query:    nil,
@@ -124,15 +129,7 @@ func (s *ButtonService) LoadPolicies(ctx context.Context) error {
 		IsCount:  false,
 	}
 	_, ms, err := s.ListModel(ctx, qp)
-	if err != nil {
-		return err
-	}
-	for _, m := range ms {
-		if err := s.AddGroupPolicy(ctx, m); err != nil {
-			return err
-		}
-	}
-	return nil
+	return ms, err
 }
 
 func (s *ButtonService) AddGroupPolicy(
@@ -144,35 +141,16 @@ func (s *ButtonService) AddGroupPolicy(
 		return ctx.Err()
 	default:
 	}
-	sub := buttonModelToSub(m)
-	menuObj := menuModelToSub(m.Menu)
-	if err := s.cache.AddGroupPolicy(sub, menuObj); err != nil {
+	rules := buttonGroupingRules(m)
+	if err := s.cache.AddGroupPolicies(rules); err != nil {
 		logx.WithContext(ctx).Errorw(
-			"添加按钮与菜单的继承关系策略失败",
-			logx.Field(auth.SubKey, sub),
-			logx.Field(auth.ObjKey, menuObj),
+			"添加按钮的关联策略失败",
 			logx.Field("button_id", m.Id),
-			logx.Field("menu_id", m.MenuId),
+			logx.Field("rules", rules),
 			logx.Field(errors.ErrKey, err),
 		)
 		return err
 	}
-
-	// 批量处理权限
-	for _, o := range m.Permissions {
-		obj := permissionModelToSub(o)
-		if err := s.cache.AddGroupPolicy(sub, obj); err != nil {
-			logx.WithContext(ctx).Errorw(
-				"添加按钮与权限的关联策略失败",
-				logx.Field(auth.SubKey, sub),
-				logx.Field(auth.ObjKey, obj),
-				logx.Field("button_id", m.Id),
-				logx.Field("permission_id", o.Id),
-				logx.Field(errors.ErrKey, err),
-			)
-			return err
-		}
-	}
 	return nil
 }
 
@@ -210,6 +188,102 @@ func (s *ButtonService) RemoveGroupPolicy(
 	return nil
 }
 
+// ListAllowedModels 查询主体在租户域内沿 g 规则（用户→角色→按钮）继承的按钮
+// 只返回激活且设置了权限标识的按钮，预加载所属菜单和权限，按排序值和ID升序
+// 按钮关联的权限全部被主体继承的角色拒绝时不返回该按钮；带附加条件的拒绝规则与请求有关，不参与过滤
+func (s *ButtonService) ListAllowedModels(ctx context.Context, sub, dom string) ([]models.ButtonModel, error) {
+	subjects, err := s.cache.ReachableSubjects(sub, dom)
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询主体继承的按钮失败",
+			logx.Field(auth.SubKey, sub),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	ids := make([]uint32, 0)
+	for _, subject := range subjects {
+		if id, ok := buttonSubToId(subject); ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return []models.ButtonModel{}, nil
+	}
+	qp := database.QueryParams{
+		Preloads: []string{"Menu", "Permissions"},
+		Query: map[string]any{
+			"id in ?":       ids,
+			"is_active = ?": true,
+			// NULL 与空字符串比较的结果不为真，同时排除了未设置的权限标识
+			"code <> ?": "",
+		},
+		OrderBy: []string{"arrange_order", "id"},
+		Limit:   0,
+		Offset:  0,
+		IsCount: false,
+	}
+	_, ms, err := s.ListModel(ctx, qp)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.cache.SubjectPolicies(subjects, dom)
+	if err != nil {
+		return nil, err
+	}
+	denies := slices.DeleteFunc(rules, func(rule []string) bool {
+		return rule[5] != auth.EffectDeny || rule[6] != ""
+	})
+	// 所属菜单未激活时前端不展示其页面，按钮同样不返回
+	return slices.DeleteFunc(ms, func(m models.ButtonModel) bool {
+		return !m.Menu.IsActive || buttonDenied(m, denies)
+	}), nil
+}
+
+// buttonDenied 判断按钮关联的权限是否全部被拒绝规则覆盖，没有关联权限的按钮不会被拒绝
+func buttonDenied(m models.ButtonModel, denies [][]string) bool {
+	if len(m.Permissions) == 0 {
+		return false
+	}
+	for _, o := range m.Permissions {
+		// 拒绝规则由权限的URL、请求方法和匹配方式生成，三者相同时即为拒绝了该权限
+		own := auth.PolicyRule("", "", o.Url, o.Method, o.Matcher, "", "")
+		denied := slices.ContainsFunc(denies, func(rule []string) bool {
+			return slices.Equal(rule[2:5], own[2:5]) ||
+				auth.PathMatch(o.Url, rule[2], rule[4]) && auth.MethodMatch(o.Method, rule[3])
+		})
+		if !denied {
+			return false
+		}
+	}
+	return true
+}
+
 func buttonModelToSub(m models.ButtonModel) string {
 	return fmt.Sprintf("button_%d", m.Id)
 }
+
+// buttonGroupingRules 返回按钮的 g 规则：按钮继承所属菜单和关联的权限
+func buttonGroupingRules(m models.ButtonModel) [][]string {
+	sub := buttonModelToSub(m)
+	dom := auth.TenantDomain(m.TenantId)
+	rules := make([][]string, 0, len(m.Permissions)+1)
+	rules = append(rules, []string{sub, menuModelToSub(m.Menu), dom})
+	for _, o := range m.Permissions {
+		rules = append(rules, []string{sub, permissionModelToSub(o), dom})
+	}
+	return rules
+}
+
+// buttonSubToId 解析按钮在casbin中的主体，不是按钮时返回false
+func buttonSubToId(sub string) (uint32, bool) {
+	rest, ok := strings.CutPrefix(sub, "button_")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(rest, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(id), true
+}
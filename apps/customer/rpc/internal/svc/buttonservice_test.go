@@ -0,0 +1,114 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+)
+
+func TestButtonCodeNullNotUnique(t *testing.T) {
+	db := newTestDB(t)
+	s := NewButtonService(db, nil)
+	ctx := context.Background()
+	menu := models.MenuModel{Path: "/user", Name: "user", Component: "Layout"}
+	if err := db.Create(&menu).Error; err != nil {
+		t.Fatal(err)
+	}
+	// 同一菜单下可以有多个未设置权限标识的按钮
+	bs := []models.ButtonModel{
+		{Name: "a", MenuId: menu.Id},
+		{Name: "b", MenuId: menu.Id},
+		{Name: "c", MenuId: menu.Id, Code: "user:create"},
+	}
+	for i := range bs {
+		if err := s.CreateModel(ctx, &bs[i]); err != nil {
+			t.Fatalf("create %s: %v", bs[i].Name, err)
+		}
+	}
+	var nulls int64
+	if err := db.Model(&models.ButtonModel{}).Where("code IS NULL").Count(&nulls).Error; err != nil {
+		t.Fatal(err)
+	}
+	if nulls != 2 {
+		t.Errorf("buttons with NULL code = %d, want 2", nulls)
+	}
+	// 清空权限标识同样保存为NULL
+	if err := s.UpdateModel(ctx, map[string]any{"code": nil}, nil, "id = ?", bs[2].Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateModel(ctx, map[string]any{"code": "user:update"}, nil, "id = ?", bs[0].Id); err != nil {
+		t.Fatal(err)
+	}
+	// 设置了权限标识时同一菜单下不能重复
+	if err := s.UpdateModel(ctx, map[string]any{"code": "user:update"}, nil, "id = ?", bs[1].Id); err == nil {
+		t.Error("expected duplicate code to be rejected")
+	}
+}
+
+func TestButtonListAllowedModelsSkipsDenied(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	menu := models.MenuModel{Path: "/user", Name: "user", Component: "Layout", IsActive: true}
+	if err := db.Create(&menu).Error; err != nil {
+		t.Fatal(err)
+	}
+	perms := make([]models.PermissionModel, 3)
+	for i := range perms {
+		perms[i] = models.PermissionModel{Url: fmt.Sprintf("/api/v1/user/%d", i), Method: "POST", Matcher: auth.MatchExact, Effect: auth.EffectAllow}
+	}
+	if err := db.Create(&perms).Error; err != nil {
+		t.Fatal(err)
+	}
+	buttons := []models.ButtonModel{
+		{Name: "allowed", Code: "user:allowed", Permissions: perms[:1]},
+		{Name: "denied", Code: "user:denied", Permissions: perms[1:2]},
+		{Name: "partly", Code: "user:partly", Permissions: perms[:2]},
+		{Name: "pattern", Code: "user:pattern", Permissions: perms[2:]},
+		{Name: "plain", Code: "user:plain"},
+	}
+	for i := range buttons {
+		buttons[i].MenuId = menu.Id
+		buttons[i].IsActive = true
+	}
+	if err := db.Create(&buttons).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	sub, dom := auth.UserSubject(1), auth.TenantDomain(1)
+	groupings := [][]string{{sub, "role_1", dom}}
+	for _, b := range buttons {
+		groupings = append(groupings, []string{"role_1", buttonModelToSub(b), auth.PlatformDomain})
+	}
+	enforcer := newTestEnforcer(t)
+	loader := staticLoader{
+		policies: [][]string{
+			auth.PolicyRule("role_1", dom, perms[1].Url, perms[1].Method, perms[1].Matcher, auth.EffectDeny, ""),
+			// 以路径模式覆盖第三个权限
+			auth.PolicyRule("role_1", dom, "/api/v1/user/[23]", "(POST|PUT)", auth.MatchRegex, auth.EffectDeny, ""),
+			// 带条件的拒绝规则与请求有关，不参与过滤
+			auth.PolicyRule("role_1", dom, perms[0].Url, perms[0].Method, perms[0].Matcher, auth.EffectDeny, `[{"cidrs":["10.0.0.0/8"]}]`),
+			// 其他主体的拒绝规则不影响
+			auth.PolicyRule("role_2", dom, perms[0].Url, perms[0].Method, perms[0].Matcher, auth.EffectDeny, ""),
+		},
+		groupings: groupings,
+	}
+	if _, err := enforcer.Reload(ctx, loader); err != nil {
+		t.Fatal(err)
+	}
+
+	ms, err := NewButtonService(db, enforcer).ListAllowedModels(ctx, sub, dom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(ms))
+	for _, m := range ms {
+		names = append(names, m.Name)
+	}
+	if want := []string{"allowed", "partly", "plain"}; !slices.Equal(names, want) {
+		t.Errorf("allowed buttons = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,136 @@
+package svc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/config"
+	"gz-dango/pkg/captcha"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	DefaultLoginFailPrefix = "login_fail:"
+	DefaultLoginFailWindow = 15 * time.Minute
+)
+
+type CaptchaService struct {
+	conf    config.CaptchaConfig
+	redis   *redis.Redis
+	captcha *captcha.Captcha
+}
+
+func NewCaptchaService(
+	conf config.CaptchaConfig,
+	redisClient *redis.Redis,
+) *CaptchaService {
+	if conf.FailPrefix == "" {
+		conf.FailPrefix = DefaultLoginFailPrefix
+	}
+	if conf.FailWindow <= 0 {
+		conf.FailWindow = DefaultLoginFailWindow
+	}
+	return &CaptchaService{
+		conf:  conf,
+		redis: redisClient,
+		captcha: captcha.NewCaptcha(
+			captcha.NewRedisStore(redisClient, conf.Prefix, 0),
+			conf.Width,
+			conf.Height,
+			conf.Length,
+			conf.Expire,
+		),
+	}
+}
+
+func (s *CaptchaService) Generate(ctx context.Context, typ string) (*captcha.Item, error) {
+	if typ == "" {
+		typ = s.conf.Type
+	}
+	item, err := s.captcha.Generate(ctx, typ)
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"生成验证码失败",
+			logx.Field("type", typ),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *CaptchaService) Verify(ctx context.Context, id, answer string) (bool, error) {
+	ok, err := s.captcha.Verify(ctx, id, answer)
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"校验验证码失败",
+			logx.Field("captcha_id", id),
+			logx.Field(errors.ErrKey, err),
+		)
+		return false, err
+	}
+	return ok, nil
+}
+
+// Required 判断该用户本次登录是否需要校验验证码
+func (s *CaptchaService) Required(ctx context.Context, username string) (bool, error) {
+	switch s.conf.Mode {
+	case captcha.ModeAlways:
+		return true, nil
+	case captcha.ModeOnFailure:
+		value, err := s.redis.GetCtx(ctx, s.conf.FailPrefix+username)
+		if err != nil {
+			logx.WithContext(ctx).Errorw(
+				"查询登录失败次数失败",
+				logx.Field("username", username),
+				logx.Field(errors.ErrKey, err),
+			)
+			return false, err
+		}
+		if value == "" {
+			return s.conf.FailThreshold <= 0, nil
+		}
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			return true, nil
+		}
+		return count >= s.conf.FailThreshold, nil
+	default:
+		return false, nil
+	}
+}
+
+// IncrLoginFail 累加用户在统计窗口内的登录失败次数
+func (s *CaptchaService) IncrLoginFail(ctx context.Context, username string) error {
+	key := s.conf.FailPrefix + username
+	count, err := s.redis.IncrCtx(ctx, key)
+	if err == nil && count == 1 {
+		err = s.redis.ExpireCtx(ctx, key, int(s.conf.FailWindow/time.Second))
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"记录登录失败次数失败",
+			logx.Field("username", username),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+// ResetLoginFail 登录成功后清除用户的登录失败次数
+func (s *CaptchaService) ResetLoginFail(ctx context.Context, username string) error {
+	if _, err := s.redis.DelCtx(ctx, s.conf.FailPrefix+username); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"清除登录失败次数失败",
+			logx.Field("username", username),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package svc
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 用户和部门模型中用于数据范围过滤的列
+var (
+	UserDataScopeColumns = database.DataScopeColumns{Dept: "dept_id", User: "id"}
+	DeptDataScopeColumns = database.DataScopeColumns{Dept: "id"}
+)
+
+// DataScopeService 根据调用方身份计算其可以访问的数据行范围
+type DataScopeService struct {
+	user *UserService
+	role *RoleService
+	dept *DeptService
+}
+
+func NewDataScopeService(user *UserService, role *RoleService, dept *DeptService) *DataScopeService {
+	return &DataScopeService{
+		user: user,
+		role: role,
+		dept: dept,
+	}
+}
+
+// Resolve 合并调用方所有角色的数据范围
+// 与鉴权一致，角色包括当前有效的临时角色及其继承的祖先角色
+// 没有用户身份（内部调用）或平台工作人员可以访问全部数据，没有角色的用户只能访问本人数据
+// 未设置数据范围的角色按本人数据处理
+func (s *DataScopeService) Resolve(ctx context.Context) (database.DataScope, error) {
+	uc, err := auth.GetUserClaims(ctx)
+	if err != nil || uc.IsPlatformStaff() {
+		return database.DataScope{All: true}, nil
+	}
+	roles, err := s.listEffectiveRoles(ctx, uc.UserId)
+	if err != nil {
+		return database.DataScope{}, err
+	}
+	ds := database.DataScope{UserId: uc.UserId, Self: len(roles) == 0}
+	deptIds := make([]uint32, 0)
+	for _, r := range roles {
+		switch r.DataScope {
+		case database.DataScopeAll:
+			return database.DataScope{All: true, UserId: uc.UserId}, nil
+		case database.DataScopeDeptAndChildren:
+			if uc.DeptId > 0 {
+				ids, err := s.dept.ListDescendantIds(ctx, uc.DeptId)
+				if err != nil {
+					return database.DataScope{}, err
+				}
+				deptIds = append(deptIds, ids...)
+			}
+		case database.DataScopeDept:
+			if uc.DeptId > 0 {
+				deptIds = append(deptIds, uc.DeptId)
+			}
+		case database.DataScopeCustom:
+			for _, d := range r.Depts {
+				deptIds = append(deptIds, d.Id)
+			}
+		case database.DataScopeSelf, "":
+			ds.Self = true
+		}
+	}
+	ds.DeptIds = slices.Compact(slices.Sorted(slices.Values(deptIds)))
+	return ds, nil
+}
+
+// listEffectiveRoles 查询用户当前有效的角色及其祖先角色，并预加载自定义数据范围的部门
+func (s *DataScopeService) listEffectiveRoles(ctx context.Context, userId uint32) ([]models.RoleModel, error) {
+	ids, err := s.user.ListEffectiveRoleIds(ctx, userId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	lineage, err := s.role.ListLineageIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(lineage) == 0 {
+		return []models.RoleModel{}, nil
+	}
+	qp := database.NewPksQueryParams(lineage)
+	qp.Preloads = []string{"Depts"}
+	_, ms, err := s.role.ListModel(ctx, qp)
+	return ms, err
+}
+
+// Scope 返回调用方数据范围对应的GORM作用域
+func (s *DataScopeService) Scope(
+	ctx context.Context,
+	cols database.DataScopeColumns,
+) (func(*gorm.DB) *gorm.DB, error) {
+	ds, err := s.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ds.Scope(cols), nil
+}
+
+// Check 判断主键为id的数据行是否在调用方的数据范围内，不在范围内时返回 gorm.ErrRecordNotFound
+// 使单条数据的查询、更新和删除与列表接口保持一致，范围外的数据视为不存在
+func (s *DataScopeService) Check(
+	ctx context.Context,
+	model any,
+	cols database.DataScopeColumns,
+	id uint32,
+) error {
+	ds, err := s.Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	if ds.All {
+		return nil
+	}
+	var count int64
+	if err := s.dept.gormDB.WithContext(ctx).
+		Model(model).
+		Scopes(ds.Scope(cols)).
+		Where(clause.Eq{Column: clause.PrimaryColumn, Value: id}).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CheckUser 判断用户是否在调用方的数据范围内
+func (s *DataScopeService) CheckUser(ctx context.Context, id uint32) error {
+	return s.Check(ctx, &models.UserModel{}, UserDataScopeColumns, id)
+}
+
+// CheckDept 判断部门是否在调用方的数据范围内
+func (s *DataScopeService) CheckDept(ctx context.Context, id uint32) error {
+	return s.Check(ctx, &models.DeptModel{}, DeptDataScopeColumns, id)
+}
@@ -0,0 +1,101 @@
+package svc
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+)
+
+func TestDataScopeResolve(t *testing.T) {
+	db := newTestDB(t)
+	s := NewDataScopeService(NewUserService(db, nil), NewRoleService(db, nil), NewDeptService(db))
+	now := time.Now()
+	depts := []models.DeptModel{{Name: "d1"}, {Name: "d2"}, {Name: "d3"}}
+	if err := db.Create(&depts).Error; err != nil {
+		t.Fatal(err)
+	}
+	parent := models.RoleModel{Name: "parent", DataScope: database.DataScopeCustom, Depts: depts[2:3]}
+	if err := db.Create(&parent).Error; err != nil {
+		t.Fatal(err)
+	}
+	roles := []models.RoleModel{
+		{Name: "dept", DataScope: database.DataScopeDept},
+		{Name: "granted", DataScope: database.DataScopeCustom, Depts: depts[1:2], Parents: []models.RoleModel{parent}},
+		{Name: "expired", DataScope: database.DataScopeAll},
+		{Name: "unset", DataScope: database.DataScopeDept},
+	}
+	if err := db.Create(&roles).Error; err != nil {
+		t.Fatal(err)
+	}
+	// 列默认值为all，直接写入空值模拟未设置数据范围的角色
+	if err := db.Model(&roles[3]).Update("data_scope", "").Error; err != nil {
+		t.Fatal(err)
+	}
+	users := []models.UserModel{
+		{Username: "mixed", Password: "x", Roles: roles[:1]},
+		{Username: "unset", Password: "x", Roles: roles[3:]},
+		{Username: "none", Password: "x"},
+	}
+	if err := db.Create(&users).Error; err != nil {
+		t.Fatal(err)
+	}
+	grants := []models.UserRoleGrantModel{
+		{UserId: users[0].Id, RoleId: roles[1].Id, StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour)},
+		{UserId: users[0].Id, RoleId: roles[2].Id, StartAt: now.Add(-2 * time.Hour), EndAt: now.Add(-time.Hour)},
+	}
+	if err := db.Create(&grants).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		uc   *auth.UserClaims
+		want database.DataScope
+	}{
+		{
+			name: "internal call",
+			want: database.DataScope{All: true},
+		},
+		{
+			name: "platform staff",
+			uc:   &auth.UserClaims{IsStaff: true, UserId: users[0].Id},
+			want: database.DataScope{All: true},
+		},
+		{
+			name: "direct, granted and inherited roles",
+			uc:   &auth.UserClaims{UserId: users[0].Id, TenantId: 1, DeptId: depts[0].Id},
+			want: database.DataScope{UserId: users[0].Id, DeptIds: []uint32{depts[0].Id, depts[1].Id, depts[2].Id}},
+		},
+		{
+			name: "unset data scope",
+			uc:   &auth.UserClaims{UserId: users[1].Id, TenantId: 1},
+			want: database.DataScope{UserId: users[1].Id, Self: true, DeptIds: []uint32{}},
+		},
+		{
+			name: "no roles",
+			uc:   &auth.UserClaims{UserId: users[2].Id, TenantId: 1},
+			want: database.DataScope{UserId: users[2].Id, Self: true, DeptIds: []uint32{}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			if c.uc != nil {
+				ctx = auth.SetUserClaims(ctx, c.uc)
+			}
+			got, err := s.Resolve(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.All != c.want.All || got.UserId != c.want.UserId || got.Self != c.want.Self ||
+				!slices.Equal(got.DeptIds, c.want.DeptIds) {
+				t.Errorf("Resolve = %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package svc
+
+import (
+	"strings"
+	"testing"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB 创建内存数据库并建表，未指定模型时创建全部业务表
+// 多个模型声明了同名的索引 idx_member，sqlite中索引名全局唯一，因此逐个建表并在建表前删除该索引，
+// 多对多关联表按模型中的定义单独创建
+func newTestDB(t *testing.T, dst ...any) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{DisableForeignKeyConstraintWhenMigrating: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dst) == 0 {
+		dst = []any{
+			&models.TenantModel{},
+			&models.DeptModel{},
+			&models.PermissionModel{},
+			&models.MenuModel{},
+			&models.ButtonModel{},
+			&models.RoleModel{},
+			&models.UserModel{},
+			&models.UserRoleGrantModel{},
+			&models.RolePermissionGrantModel{},
+		}
+	}
+	for _, m := range dst {
+		if err := db.Exec("DROP INDEX IF EXISTS idx_member").Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Migrator().CreateTable(m); err != nil {
+			t.Fatal(err)
+		}
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(m); err != nil {
+			t.Fatal(err)
+		}
+		for _, rel := range stmt.Schema.Relationships.Many2Many {
+			cols := make([]string, 0, len(rel.JoinTable.PrimaryFieldDBNames))
+			for _, name := range rel.JoinTable.PrimaryFieldDBNames {
+				cols = append(cols, name+" integer NOT NULL")
+			}
+			sql := "CREATE TABLE IF NOT EXISTS " + rel.JoinTable.Table + " (" + strings.Join(cols, ", ") +
+				", PRIMARY KEY (" + strings.Join(rel.JoinTable.PrimaryFieldDBNames, ", ") + "))"
+			if err := db.Exec(sql).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return db
+}
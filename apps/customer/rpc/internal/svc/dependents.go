@@ -0,0 +1,248 @@
+package svc
+
+import (
+	"context"
+	"slices"
+
+	"gz-dango/pkg/database"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 删除数据时受影响的依赖项类型
+const (
+	DependentRole       = "role"       // 失去授权、继承关系或被持有的角色
+	DependentMenu       = "menu"       // 随之删除或失去关联权限的菜单
+	DependentButton     = "button"     // 随之删除或失去关联权限的按钮
+	DependentUser       = "user"       // 失去角色或所属部门的用户
+	DependentDept       = "dept"       // 随之删除或失去数据权限的部门
+	DependentGrant      = "grant"      // 随之删除或被撤销的临时授权
+	DependentPermission = "permission" // 租户自有的权限
+)
+
+// Dependent 删除数据时受影响的一类依赖项
+type Dependent struct {
+	Kind string
+	Ids  []uint32 // 升序且不重复
+}
+
+// Dependents 删除数据时受影响的依赖项，只包含存在依赖项的类型，按添加顺序排列
+type Dependents []Dependent
+
+// add 追加一类依赖项，ID为空时忽略
+func (ds *Dependents) add(kind string, ids ...[]uint32) {
+	merged := slices.Concat(ids...)
+	if len(merged) == 0 {
+		return
+	}
+	slices.Sort(merged)
+	*ds = append(*ds, Dependent{Kind: kind, Ids: slices.Compact(merged)})
+}
+
+// Without 返回去掉指定类型后的依赖项
+func (ds Dependents) Without(kinds ...string) Dependents {
+	return slices.DeleteFunc(slices.Clone(ds), func(d Dependent) bool {
+		return slices.Contains(kinds, d.Kind)
+	})
+}
+
+// Ids 返回指定类型的依赖项ID
+func (ds Dependents) Ids(kind string) []uint32 {
+	for _, d := range ds {
+		if d.Kind == kind {
+			return d.Ids
+		}
+	}
+	return nil
+}
+
+// Counts 各类依赖项的数量，用于拒绝删除时的错误数据
+func (ds Dependents) Counts() map[string]any {
+	counts := make(map[string]any, len(ds))
+	for _, d := range ds {
+		counts[d.Kind] = len(d.Ids)
+	}
+	return counts
+}
+
+// pluckIds 查询表中满足条件的一列ID
+func pluckIds(ctx context.Context, db *gorm.DB, table string, column string, query string, args ...any) ([]uint32, error) {
+	var ids []uint32
+	if err := db.WithContext(ctx).
+		Table(table).
+		Where(query, args...).
+		Pluck(column, &ids).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询依赖项失败",
+			logx.Field("table", table),
+			logx.Field("column", column),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Dependents 查询删除权限时受影响的数据：关联或拒绝该权限的角色、关联该权限的菜单和按钮，以及随之删除的角色临时权限
+func (s *PermissionService) Dependents(ctx context.Context, id uint32) (Dependents, error) {
+	var ds Dependents
+	roleIds, err := pluckIds(ctx, s.gormDB, "customer_role_permission", "role_id", "permission_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	denyIds, err := pluckIds(ctx, s.gormDB, "customer_role_deny_permission", "role_id", "permission_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentRole, roleIds, denyIds)
+	menuIds, err := pluckIds(ctx, s.gormDB, "customer_menu_permission", "menu_id", "permission_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentMenu, menuIds)
+	buttonIds, err := pluckIds(ctx, s.gormDB, "customer_button_permission", "button_id", "permission_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentButton, buttonIds)
+	grantIds, err := pluckIds(ctx, s.gormDB, "customer_role_permission_grant", "id", "permission_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentGrant, grantIds)
+	return ds, nil
+}
+
+// Dependents 查询删除菜单时受影响的数据：随之删除的下级菜单和按钮，以及被授予这些菜单或按钮的角色
+func (s *MenuService) Dependents(ctx context.Context, id uint32) (Dependents, error) {
+	var ds Dependents
+	edges, err := s.listParentEdges(ctx, s.gormDB)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uint32][]uint32, len(edges))
+	for child, parent := range edges {
+		children[parent] = append(children[parent], child)
+	}
+	visited := map[uint32]bool{id: true}
+	descendants := make([]uint32, 0)
+	for stack := slices.Clone(children[id]); len(stack) > 0; {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[cur] {
+			continue
+		}
+		visited[cur] = true
+		descendants = append(descendants, cur)
+		stack = append(stack, children[cur]...)
+	}
+	ds.add(DependentMenu, descendants)
+	menuIds := append([]uint32{id}, descendants...)
+	buttonIds, err := pluckIds(ctx, s.gormDB, "customer_button", "id", "menu_id IN ?", menuIds)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentButton, buttonIds)
+	roleIds, err := pluckIds(ctx, s.gormDB, "customer_role_menu", "role_id", "menu_id IN ?", menuIds)
+	if err != nil {
+		return nil, err
+	}
+	var buttonRoleIds []uint32
+	if len(buttonIds) > 0 {
+		if buttonRoleIds, err = pluckIds(ctx, s.gormDB, "customer_role_button", "role_id", "button_id IN ?", buttonIds); err != nil {
+			return nil, err
+		}
+	}
+	ds.add(DependentRole, roleIds, buttonRoleIds)
+	return ds, nil
+}
+
+// Dependents 查询删除按钮时受影响的数据：被授予该按钮的角色
+func (s *ButtonService) Dependents(ctx context.Context, id uint32) (Dependents, error) {
+	var ds Dependents
+	roleIds, err := pluckIds(ctx, s.gormDB, "customer_role_button", "role_id", "button_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentRole, roleIds)
+	return ds, nil
+}
+
+// Dependents 查询删除角色时受影响的数据：持有该角色的用户、该角色的临时授权和继承该角色的子角色
+func (s *RoleService) Dependents(ctx context.Context, id uint32) (Dependents, error) {
+	var ds Dependents
+	userIds, err := pluckIds(ctx, s.gormDB, "customer_user_role", "user_id", "role_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentUser, userIds)
+	grantIds, err := pluckIds(ctx, s.gormDB, "customer_user_role_grant", "id", "role_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentGrant, grantIds)
+	childIds, err := pluckIds(ctx, s.gormDB, "customer_role_parent", "role_id", "parent_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentRole, childIds)
+	return ds, nil
+}
+
+// Dependents 查询删除用户时受影响的数据：随之删除的临时角色
+func (s *UserService) Dependents(ctx context.Context, id uint32) (Dependents, error) {
+	var ds Dependents
+	grantIds, err := pluckIds(ctx, s.gormDB, "customer_user_role_grant", "id", "user_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentGrant, grantIds)
+	return ds, nil
+}
+
+// Dependents 查询删除部门时受影响的数据：随之删除的下级部门、失去所属部门的用户和数据权限包含这些部门的角色
+func (s *DeptService) Dependents(ctx context.Context, id uint32) (Dependents, error) {
+	var ds Dependents
+	deptIds, err := s.ListDescendantIds(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentDept, slices.DeleteFunc(slices.Clone(deptIds), func(v uint32) bool { return v == id }))
+	userIds, err := pluckIds(ctx, s.gormDB, "customer_user", "id", "dept_id IN ?", deptIds)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentUser, userIds)
+	roleIds, err := pluckIds(ctx, s.gormDB, "customer_role_dept", "role_id", "dept_id IN ?", deptIds)
+	if err != nil {
+		return nil, err
+	}
+	ds.add(DependentRole, roleIds)
+	return ds, nil
+}
+
+// Dependents 查询删除租户时受影响的数据：租户下的用户、角色、部门、菜单、按钮、权限和用户的临时角色
+func (s *TenantService) Dependents(ctx context.Context, id uint32) (Dependents, error) {
+	var ds Dependents
+	for _, dep := range []struct {
+		kind  string
+		table string
+	}{
+		{DependentUser, "customer_user"},
+		{DependentRole, "customer_role"},
+		{DependentDept, "customer_dept"},
+		{DependentMenu, "customer_menu"},
+		{DependentButton, "customer_button"},
+		{DependentPermission, "customer_permission"},
+		{DependentGrant, "customer_user_role_grant"},
+	} {
+		ids, err := pluckIds(ctx, s.gormDB, dep.table, "id", database.TenantKey+" = ?", id)
+		if err != nil {
+			return nil, err
+		}
+		ds.add(dep.kind, ids)
+	}
+	return ds, nil
+}
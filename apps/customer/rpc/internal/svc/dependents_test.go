@@ -0,0 +1,99 @@
+package svc
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+)
+
+func TestTenantDependents(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	now := time.Now()
+	const tenantId, otherId = 1, 2
+	user := models.UserModel{TenantId: tenantId, Username: "u", Password: "x"}
+	rows := []any{
+		&user,
+		&models.RoleModel{TenantId: tenantId, Name: "r"},
+		&models.DeptModel{TenantId: tenantId, Name: "d"},
+		&models.MenuModel{TenantId: tenantId, Name: "m", Path: "/m", Component: "m"},
+		&models.ButtonModel{TenantId: tenantId, Name: "b"},
+		&models.PermissionModel{TenantId: tenantId, Url: "/api/v1/m", Method: "GET", Matcher: auth.MatchExact, Effect: auth.EffectAllow},
+		// 其他租户的数据不是依赖项
+		&models.RoleModel{TenantId: otherId, Name: "r"},
+		&models.MenuModel{TenantId: otherId, Name: "m", Path: "/m", Component: "m"},
+	}
+	for _, m := range rows {
+		if err := db.Create(m).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	grant := models.UserRoleGrantModel{TenantId: tenantId, UserId: user.Id, RoleId: 1, StartAt: now, EndAt: now.Add(time.Hour)}
+	if err := db.Create(&grant).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewTenantService(db)
+	ds, err := s.Dependents(ctx, tenantId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kinds := make([]string, 0, len(ds))
+	for _, d := range ds {
+		kinds = append(kinds, d.Kind)
+		if len(d.Ids) != 1 {
+			t.Errorf("%s: ids = %v, want one", d.Kind, d.Ids)
+		}
+	}
+	want := []string{
+		DependentUser, DependentRole, DependentDept, DependentMenu,
+		DependentButton, DependentPermission, DependentGrant,
+	}
+	if !slices.Equal(kinds, want) {
+		t.Errorf("kinds = %v, want %v", kinds, want)
+	}
+
+	empty, err := s.Dependents(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("tenant without data: dependents = %+v", empty)
+	}
+}
+
+func TestTenantDeleteModelWithDepts(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	tenant := models.TenantModel{Name: "t"}
+	if err := db.Create(&tenant).Error; err != nil {
+		t.Fatal(err)
+	}
+	depts := []models.DeptModel{{TenantId: tenant.Id, Name: "d"}, {TenantId: tenant.Id + 1, Name: "d"}}
+	if err := db.Create(&depts).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewTenantService(db)
+	ds, err := s.Dependents(ctx, tenant.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inUse := ds.Without(DependentDept); len(inUse) > 0 {
+		t.Fatalf("only depts expected, got %+v", inUse)
+	}
+	if err := s.DeleteModelWithDepts(ctx, tenant.Id); err != nil {
+		t.Fatal(err)
+	}
+	var ids []uint32
+	if err := db.Model(&models.DeptModel{}).Pluck("id", &ids).Error; err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(ids, []uint32{depts[1].Id}) {
+		t.Errorf("depts after delete = %v, want %v", ids, []uint32{depts[1].Id})
+	}
+}
@@ -0,0 +1,174 @@
+package svc
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/database"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type DeptService struct {
+	gormDB *gorm.DB
+}
+
+func NewDeptService(gormDB *gorm.DB) *DeptService {
+	return &DeptService{
+		gormDB: gormDB,
+	}
+}
+
+func (s *DeptService) CreateModel(ctx context.Context, m *models.DeptModel) error {
+	now := time.Now()
+	m.CreatedAt = now
+	m.UpdatedAt = now
+	if err := database.DBCreate(ctx, s.gormDB, &models.DeptModel{}, m); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"新增部门模型失败",
+			logx.Field("name", m.Name),
+			logx.Field("parent_id", m.ParentId),
+			logx.Field("is_active", m.IsActive),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *DeptService) UpdateModel(ctx context.Context, data map[string]any, conds ...any) error {
+	if err := database.DBUpdate(ctx, s.gormDB, &models.DeptModel{}, data, nil, conds...); err != nil {
+		fields := database.MapToLogFields(data)
+		fields = append(fields, logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("更新部门模型失败", fields...)
+		return err
+	}
+	return nil
+}
+
+func (s *DeptService) DeleteModel(ctx context.Context, conds ...any) error {
+	if err := database.DBDelete(ctx, s.gormDB, &models.DeptModel{}, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"删除部门模型失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *DeptService) FindModel(
+	ctx context.Context,
+	preloads []string,
+	conds ...any,
+) (*models.DeptModel, error) {
+	var m models.DeptModel
+	if err := database.DBFind(ctx, s.gormDB, preloads, &m, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询部门模型失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (s *DeptService) ListModel(
+	ctx context.Context,
+	qp database.QueryParams,
+) (int64, []models.DeptModel, error) {
+	var ms []models.DeptModel
+	count, err := database.DBList(ctx, s.gormDB, &models.DeptModel{}, &ms, qp)
+	if err != nil {
+		fields := database.QPToLogFields(qp)
+		fields = append(fields, logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("查询部门列表失败", fields...)
+		return 0, nil, err
+	}
+	return count, ms, err
+}
+
+func (s *DeptService) ListModelByIds(
+	ctx context.Context,
+	ids []uint32,
+) ([]models.DeptModel, error) {
+	if len(ids) == 0 {
+		return []models.DeptModel{}, nil
+	}
+	qp := database.NewPksQueryParams(ids)
+	_, ms, err := s.ListModel(ctx, qp)
+	return ms, err
+}
+
+// listChildEdges 查询所有部门的上下级关系，返回部门ID到下级部门ID列表的映射
+func (s *DeptService) listChildEdges(ctx context.Context) (map[uint32][]uint32, error) {
+	var rows []struct {
+		Id       uint32
+		ParentId *uint32
+	}
+	if err := s.gormDB.WithContext(ctx).
+		Model(&models.DeptModel{}).
+		Select("id, parent_id").
+		Scan(&rows).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询部门上下级关系失败",
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	edges := make(map[uint32][]uint32, len(rows))
+	for _, r := range rows {
+		if r.ParentId != nil {
+			edges[*r.ParentId] = append(edges[*r.ParentId], r.Id)
+		}
+	}
+	return edges, nil
+}
+
+// ListDescendantIds 返回部门及其所有下级部门的ID
+func (s *DeptService) ListDescendantIds(ctx context.Context, ids ...uint32) ([]uint32, error) {
+	if len(ids) == 0 {
+		return []uint32{}, nil
+	}
+	edges, err := s.listChildEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	visited := make(map[uint32]bool)
+	result := make([]uint32, 0, len(ids))
+	stack := append([]uint32{}, ids...)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[cur] {
+			continue
+		}
+		visited[cur] = true
+		result = append(result, cur)
+		stack = append(stack, edges[cur]...)
+	}
+	return result, nil
+}
+
+// HasCycle 检查将parentId设置为部门id的父级后是否会形成环
+// 父级为部门自身或其下级部门时返回true
+func (s *DeptService) HasCycle(ctx context.Context, id uint32, parentId uint32) (bool, error) {
+	if id == 0 || parentId == 0 {
+		return false, nil
+	}
+	ids, err := s.ListDescendantIds(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	for _, d := range ids {
+		if d == parentId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
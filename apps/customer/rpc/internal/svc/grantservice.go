@@ -0,0 +1,193 @@
+package svc
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/database"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// UserRoleGrantService 用户临时角色
+type UserRoleGrantService struct {
+	gormDB *gorm.DB
+}
+
+func NewUserRoleGrantService(gormDB *gorm.DB) *UserRoleGrantService {
+	return &UserRoleGrantService{
+		gormDB: gormDB,
+	}
+}
+
+func (s *UserRoleGrantService) CreateModel(ctx context.Context, m *models.UserRoleGrantModel) error {
+	now := time.Now()
+	m.CreatedAt = now
+	m.UpdatedAt = now
+	if err := database.DBCreate(ctx, s.gormDB, &models.UserRoleGrantModel{}, m); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"新增用户临时角色失败",
+			logx.Field("user_id", m.UserId),
+			logx.Field("role_id", m.RoleId),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *UserRoleGrantService) DeleteModel(ctx context.Context, conds ...any) error {
+	if err := database.DBDelete(ctx, s.gormDB, &models.UserRoleGrantModel{}, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"删除用户临时角色失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *UserRoleGrantService) FindModel(
+	ctx context.Context,
+	preloads []string,
+	conds ...any,
+) (*models.UserRoleGrantModel, error) {
+	var m models.UserRoleGrantModel
+	if err := database.DBFind(ctx, s.gormDB, preloads, &m, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询用户临时角色失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (s *UserRoleGrantService) ListModel(
+	ctx context.Context,
+	qp database.QueryParams,
+) (int64, []models.UserRoleGrantModel, error) {
+	var ms []models.UserRoleGrantModel
+	count, err := database.DBList(ctx, s.gormDB, &models.UserRoleGrantModel{}, &ms, qp)
+	if err != nil {
+		fields := database.QPToLogFields(qp)
+		fields = append(fields, logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("查询用户临时角色列表失败", fields...)
+		return 0, nil, err
+	}
+	return count, ms, err
+}
+
+// ListChangedUserIds 查询临时角色在 (since, until] 内生效或失效的用户ID
+func (s *UserRoleGrantService) ListChangedUserIds(ctx context.Context, since, until time.Time) ([]uint32, error) {
+	var ids []uint32
+	if err := s.gormDB.WithContext(ctx).
+		Model(&models.UserRoleGrantModel{}).
+		Where("(start_at > ? AND start_at <= ?) OR (end_at > ? AND end_at <= ?)", since, until, since, until).
+		Distinct().
+		Pluck("user_id", &ids).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询临时角色状态变化的用户失败",
+			logx.Field("since", since),
+			logx.Field("until", until),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// RolePermissionGrantService 角色临时权限
+type RolePermissionGrantService struct {
+	gormDB *gorm.DB
+}
+
+func NewRolePermissionGrantService(gormDB *gorm.DB) *RolePermissionGrantService {
+	return &RolePermissionGrantService{
+		gormDB: gormDB,
+	}
+}
+
+func (s *RolePermissionGrantService) CreateModel(ctx context.Context, m *models.RolePermissionGrantModel) error {
+	now := time.Now()
+	m.CreatedAt = now
+	m.UpdatedAt = now
+	if err := database.DBCreate(ctx, s.gormDB, &models.RolePermissionGrantModel{}, m); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"新增角色临时权限失败",
+			logx.Field("role_id", m.RoleId),
+			logx.Field("permission_id", m.PermissionId),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *RolePermissionGrantService) DeleteModel(ctx context.Context, conds ...any) error {
+	if err := database.DBDelete(ctx, s.gormDB, &models.RolePermissionGrantModel{}, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"删除角色临时权限失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *RolePermissionGrantService) FindModel(
+	ctx context.Context,
+	preloads []string,
+	conds ...any,
+) (*models.RolePermissionGrantModel, error) {
+	var m models.RolePermissionGrantModel
+	if err := database.DBFind(ctx, s.gormDB, preloads, &m, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询角色临时权限失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (s *RolePermissionGrantService) ListModel(
+	ctx context.Context,
+	qp database.QueryParams,
+) (int64, []models.RolePermissionGrantModel, error) {
+	var ms []models.RolePermissionGrantModel
+	count, err := database.DBList(ctx, s.gormDB, &models.RolePermissionGrantModel{}, &ms, qp)
+	if err != nil {
+		fields := database.QPToLogFields(qp)
+		fields = append(fields, logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("查询角色临时权限列表失败", fields...)
+		return 0, nil, err
+	}
+	return count, ms, err
+}
+
+// ListChangedRoleIds 查询临时权限在 (since, until] 内生效或失效的角色ID
+func (s *RolePermissionGrantService) ListChangedRoleIds(ctx context.Context, since, until time.Time) ([]uint32, error) {
+	var ids []uint32
+	if err := s.gormDB.WithContext(ctx).
+		Model(&models.RolePermissionGrantModel{}).
+		Where("(start_at > ? AND start_at <= ?) OR (end_at > ? AND end_at <= ?)", since, until, since, until).
+		Distinct().
+		Pluck("role_id", &ids).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询临时权限状态变化的角色失败",
+			logx.Field("since", since),
+			logx.Field("until", until),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,101 @@
+package svc
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const DefaultGrantSweepInterval = time.Minute
+
+// WatchGrantExpiry 定期检查临时角色和临时权限的生效和失效，重建受影响用户和角色的策略并通知其他实例
+// 授权在检查间隔内生效或失效，最长延迟一个间隔；非阻塞，Close时停止
+// 多个实例通过Redis租约选出一个实例执行检查，租约在三个间隔内未续期时由其他实例接替
+func (s *ServiceContext) WatchGrantExpiry() {
+	interval := s.Config.Security.GrantSweepInterval
+	if interval <= 0 {
+		interval = DefaultGrantSweepInterval
+	}
+	if s.sweepLease == nil {
+		s.sweepLease = newRedisLease(s.goredis, DefaultGrantSweepLeaseKey, s.instanceID, 3*interval)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopSweep = cancel
+	logx.Infow("开始检查临时授权的有效期", logx.Field("interval", interval.String()))
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		defer func() {
+			releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := s.sweepLease.Release(releaseCtx); err != nil {
+				logx.Errorw("释放临时授权检查租约失败", logx.Field(errors.ErrKey, err))
+			}
+		}()
+		// 启动时已全量加载策略，从上一个间隔开始检查以覆盖加载到启动之间的变化，重建是幂等的
+		// 未持有租约时不推进起点，接替检查后覆盖原持有者最后一次检查之后的变化
+		since := time.Now().Add(-interval)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				until := time.Now()
+				swept, err := s.sweepGrantsIfLeader(ctx, since, until)
+				if err != nil {
+					logx.Errorw(
+						"检查临时授权的有效期失败",
+						logx.Field("since", since),
+						logx.Field("until", until),
+						logx.Field(errors.ErrKey, err),
+					)
+					// 失败时保留起点，下次检查时重试
+					continue
+				}
+				if swept {
+					since = until
+				}
+			}
+		}
+	}()
+}
+
+// sweepGrantsIfLeader 获取或续期租约，持有租约时执行检查，返回是否执行了检查
+func (s *ServiceContext) sweepGrantsIfLeader(ctx context.Context, since, until time.Time) (bool, error) {
+	leader, err := s.sweepLease.Acquire(ctx)
+	if err != nil || !leader {
+		return false, err
+	}
+	return true, s.sweepGrants(ctx, since, until)
+}
+
+// sweepGrants 重建临时授权在 (since, until] 内生效或失效的用户和角色的策略
+func (s *ServiceContext) sweepGrants(ctx context.Context, since, until time.Time) error {
+	userIds, err := s.UserGrant.ListChangedUserIds(ctx, since, until)
+	if err != nil {
+		return err
+	}
+	roleIds, err := s.RoleGrant.ListChangedRoleIds(ctx, since, until)
+	if err != nil {
+		return err
+	}
+	if len(userIds) == 0 && len(roleIds) == 0 {
+		return nil
+	}
+	if err := s.User.ResetGroupPolicy(ctx, userIds); err != nil {
+		return err
+	}
+	if err := s.Role.ResetRolePolicies(ctx, roleIds); err != nil {
+		return err
+	}
+	logx.Infow(
+		"临时授权状态变化，已重建策略",
+		logx.Field("user_ids", userIds),
+		logx.Field("role_ids", roleIds),
+	)
+	return s.NotifyPolicyChange()
+}
@@ -0,0 +1,50 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeLease 返回固定结果的租约
+type fakeLease struct {
+	leader   bool
+	err      error
+	acquired int
+}
+
+func (l *fakeLease) Acquire(ctx context.Context) (bool, error) {
+	l.acquired++
+	return l.leader, l.err
+}
+
+func (l *fakeLease) Release(ctx context.Context) error { return nil }
+
+func TestSweepGrantsSkippedWithoutLease(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name  string
+		lease *fakeLease
+		err   bool
+	}{
+		{"follower", &fakeLease{}, false},
+		{"lease error", &fakeLease{err: errors.New("redis down")}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// 未初始化业务服务，若执行了检查会直接panic
+			s := &ServiceContext{sweepLease: c.lease}
+			swept, err := s.sweepGrantsIfLeader(context.Background(), now.Add(-time.Minute), now)
+			if swept {
+				t.Fatal("swept without holding the lease")
+			}
+			if (err != nil) != c.err {
+				t.Fatalf("err = %v, want error=%v", err, c.err)
+			}
+			if c.lease.acquired != 1 {
+				t.Fatalf("acquired = %d, want 1", c.lease.acquired)
+			}
+		})
+	}
+}
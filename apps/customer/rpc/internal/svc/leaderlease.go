@@ -0,0 +1,71 @@
+package svc
+
+import (
+	"context"
+	"time"
+
+	goReids "github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultGrantSweepLeaseKey     = "/casbin/grant-sweep-leader"
+	DefaultPermissionSyncLockKey  = "/casbin/permission-sync-lock"
+	DefaultPermissionSyncLockTTL  = time.Minute
+	permissionSyncLockRetryPeriod = time.Second
+)
+
+// leaderLease 多实例部署时选出唯一执行后台任务的实例
+type leaderLease interface {
+	// Acquire 获取或续期租约，返回本实例当前是否持有租约
+	Acquire(ctx context.Context) (bool, error)
+	// Release 释放本实例持有的租约，未持有时不做任何操作
+	Release(ctx context.Context) error
+}
+
+// redisLease 基于Redis键的租约，键的值为持有者标识，持有者需在过期前续期
+type redisLease struct {
+	client *goReids.Client
+	key    string
+	owner  string
+	ttl    time.Duration
+}
+
+func newRedisLease(client *goReids.Client, key, owner string, ttl time.Duration) *redisLease {
+	return &redisLease{
+		client: client,
+		key:    key,
+		owner:  owner,
+		ttl:    ttl,
+	}
+}
+
+// acquireLeaseScript 持有者续期，或在租约不存在时获取
+var acquireLeaseScript = goReids.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return 1
+end
+return 0
+`)
+
+// releaseLeaseScript 仅持有者可以删除租约
+var releaseLeaseScript = goReids.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (l *redisLease) Acquire(ctx context.Context) (bool, error) {
+	n, err := acquireLeaseScript.Run(ctx, l.client, []string{l.key}, l.owner, l.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (l *redisLease) Release(ctx context.Context) error {
+	return releaseLeaseScript.Run(ctx, l.client, []string{l.key}, l.owner).Err()
+}
@@ -0,0 +1,59 @@
+package svc
+
+import (
+	"strings"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+)
+
+// FrontendRoute 前端路由，结构与 Vue Router 的路由记录一致，React 后台模板可直接映射
+type FrontendRoute struct {
+	Path      string          `json:"path"`
+	Name      string          `json:"name"`
+	Component string          `json:"component,omitempty"` // 组件路径，前端按路径动态加载，外部链接为空
+	Redirect  string          `json:"redirect,omitempty"`
+	Meta      models.Meta     `json:"meta"`
+	Children  []FrontendRoute `json:"children,omitempty"`
+}
+
+// MenuRoutes 将菜单树转换为前端路由，重定向提升到路由上，不再保留在元信息中
+func MenuRoutes(tree []*MenuTreeNode) []FrontendRoute {
+	routes := make([]FrontendRoute, 0, len(tree))
+	for _, n := range tree {
+		meta := n.Menu.Meta
+		if meta.Title == "" {
+			meta.Title = n.Menu.Name
+		}
+		r := FrontendRoute{
+			Path:     n.Menu.Path,
+			Name:     n.Menu.Name,
+			Redirect: meta.Redirect,
+			Meta:     meta,
+		}
+		r.Meta.Redirect = ""
+		if meta.ExternalLink == "" {
+			r.Component = componentPath(n.Menu.Component)
+		}
+		if len(n.Children) > 0 {
+			r.Children = MenuRoutes(n.Children)
+		}
+		routes = append(routes, r)
+	}
+	return routes
+}
+
+// componentPath 规范化组件路径：去掉开头的 / 和文件扩展名，如 /views/user/index.vue 为 views/user/index
+// Layout 等不含路径分隔符的布局组件名保持不变
+func componentPath(component string) string {
+	component = strings.TrimSpace(component)
+	if !strings.Contains(component, "/") {
+		return component
+	}
+	component = strings.TrimLeft(component, "/")
+	for _, ext := range []string{".vue", ".tsx", ".jsx", ".ts", ".js"} {
+		if strings.HasSuffix(component, ext) {
+			return strings.TrimSuffix(component, ext)
+		}
+	}
+	return component
+}
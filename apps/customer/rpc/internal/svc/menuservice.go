@@ -2,6 +2,7 @@ package svc
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"time"
 
@@ -12,8 +13,12 @@ import (
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrMenuCycle 菜单的上级关系形成环
+var ErrMenuCycle = stderrors.New("menu parent cycle")
+
 type MenuService struct {
 	gormDB *gorm.DB
 	cache  *auth.AuthEnforcer
@@ -29,11 +34,24 @@ func NewMenuService(
 	}
 }
 
+// CreateModel 新增菜单，指定了上级菜单时在同一事务中锁定菜单行并检查环，形成环时返回 ErrMenuCycle
+// 指定ID新增的菜单可能已被其他菜单引用为上级，因此新增时同样需要检查
 func (s *MenuService) CreateModel(ctx context.Context, m *models.MenuModel) error {
 	now := time.Now()
 	m.CreatedAt = now
 	m.UpdatedAt = now
-	if err := database.DBCreate(ctx, s.gormDB, &models.MenuModel{}, m); err != nil {
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if m.ParentId != nil {
+			if err := s.checkParentLocked(ctx, tx, m.Id, *m.ParentId); err != nil {
+				return err
+			}
+		}
+		return database.DBCreate(ctx, tx, &models.MenuModel{}, m)
+	})
+	if err != nil {
+		if stderrors.Is(err, ErrMenuCycle) {
+			return err
+		}
 		parent_id := 0
 		if m.ParentId != nil {
 			parent_id = int(*m.ParentId)
@@ -66,6 +84,39 @@ func (s *MenuService) UpdateModel(ctx context.Context, data map[string]any, upma
 	return nil
 }
 
+// UpdateModelWithParent 更新菜单并替换关联关系，在同一事务中锁定菜单行后检查新的上级菜单是否形成环
+// parentId为0表示顶层菜单，形成环时返回 ErrMenuCycle 且不写入任何数据
+func (s *MenuService) UpdateModelWithParent(
+	ctx context.Context,
+	id uint32,
+	parentId uint32,
+	data map[string]any,
+	upmap map[string]any,
+) error {
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.checkParentLocked(ctx, tx, id, parentId); err != nil {
+			return err
+		}
+		if err := tx.Model(&models.MenuModel{}).Where("id = ?", id).Updates(data).Error; err != nil {
+			return err
+		}
+		if len(upmap) == 0 {
+			return nil
+		}
+		var m models.MenuModel
+		if err := tx.First(&m, id).Error; err != nil {
+			return err
+		}
+		return database.DBAssociate(ctx, tx, &m, upmap)
+	})
+	if err != nil && !stderrors.Is(err, ErrMenuCycle) {
+		fields := database.MapToLogFields(data)
+		fields = append(fields, logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("更新菜单模型失败", fields...)
+	}
+	return err
+}
+
 func (s *MenuService) DeleteModel(ctx context.Context, conds ...any) error {
 	if err := database.DBDelete(ctx, s.gormDB, &models.MenuModel{}, conds...); err != nil {
 		logx.WithContext(ctx).Errorw(
@@ -123,7 +174,125 @@ func (s *MenuService) ListModelByIds(
 	return ms, err
 }
 
-func (s *MenuService) LoadPolicies(ctx context.Context) error {
+// listParentEdges 查询所有菜单的父级，返回菜单ID到父级菜单ID的映射
+func (s *MenuService) listParentEdges(ctx context.Context, db *gorm.DB) (map[uint32]uint32, error) {
+	var rows []struct {
+		Id       uint32
+		ParentId *uint32
+	}
+	// 读取全部菜单行（包括顶层菜单），调用方加锁时锁定所有可能参与成环的行
+	if err := db.WithContext(ctx).
+		Model(&models.MenuModel{}).
+		Select("id, parent_id").
+		Scan(&rows).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询菜单父级关系失败",
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	edges := make(map[uint32]uint32, len(rows))
+	for _, r := range rows {
+		if r.ParentId != nil {
+			edges[r.Id] = *r.ParentId
+		}
+	}
+	return edges, nil
+}
+
+// checkParentLocked 锁定全部菜单行后检查将parentId设置为菜单id的父级是否会形成环，形成环时返回 ErrMenuCycle
+// 锁定持续到事务结束，并发修改上级关系的事务依次执行，不会共同形成环
+func (s *MenuService) checkParentLocked(ctx context.Context, tx *gorm.DB, id uint32, parentId uint32) error {
+	if id == 0 || parentId == 0 {
+		return nil
+	}
+	edges, err := s.listParentEdges(ctx, tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}))
+	if err != nil {
+		return err
+	}
+	if menuReaches(edges, parentId, id) {
+		return ErrMenuCycle
+	}
+	return nil
+}
+
+// menuReaches 判断从菜单from沿上级菜单能否到达菜单to，已存在的环不会导致死循环
+func menuReaches(edges map[uint32]uint32, from, to uint32) bool {
+	visited := make(map[uint32]bool)
+	for cur := from; cur != 0 && !visited[cur]; cur = edges[cur] {
+		if cur == to {
+			return true
+		}
+		visited[cur] = true
+	}
+	return false
+}
+
+// MoveModel 在一个事务中修改菜单的父级和排序值，parentId为0时移动到顶层
+// 新位置及之后的同级菜单排序值依次后移；事务内以 SELECT ... FOR UPDATE 锁定菜单行后再检查是否形成环，
+// 并发的移动在锁上串行执行，避免各自检查通过后共同形成环
+func (s *MenuService) MoveModel(ctx context.Context, id uint32, parentId uint32, arrangeOrder uint32) error {
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.checkParentLocked(ctx, tx, id, parentId); err != nil {
+			return err
+		}
+		siblings := tx.Model(&models.MenuModel{}).Where("id <> ? AND arrange_order >= ?", id, arrangeOrder)
+		if parentId == 0 {
+			siblings = siblings.Where("parent_id IS NULL")
+		} else {
+			siblings = siblings.Where("parent_id = ?", parentId)
+		}
+		if err := siblings.UpdateColumn("arrange_order", gorm.Expr("arrange_order + 1")).Error; err != nil {
+			return err
+		}
+		data := map[string]any{
+			"updated_at":    time.Now(),
+			"parent_id":     nil,
+			"arrange_order": arrangeOrder,
+		}
+		if parentId != 0 {
+			data["parent_id"] = parentId
+		}
+		return tx.Model(&models.MenuModel{}).Where("id = ?", id).Updates(data).Error
+	})
+	if err != nil && !stderrors.Is(err, ErrMenuCycle) {
+		logx.WithContext(ctx).Errorw(
+			"移动菜单失败",
+			logx.Field("id", id),
+			logx.Field("parent_id", parentId),
+			logx.Field("arrange_order", arrangeOrder),
+			logx.Field(errors.ErrKey, err),
+		)
+	}
+	return err
+}
+
+// ReorderModels 在一个事务中设置一组同级菜单的排序值，orders为菜单ID到排序值的映射
+func (s *MenuService) ReorderModels(ctx context.Context, orders map[uint32]uint32) error {
+	now := time.Now()
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, order := range orders {
+			if err := tx.Model(&models.MenuModel{}).Where("id = ?", id).Updates(map[string]any{
+				"updated_at":    now,
+				"arrange_order": order,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"调整菜单排序失败",
+			logx.Field("orders", orders),
+			logx.Field(errors.ErrKey, err),
+		)
+	}
+	return err
+}
+
+// listPolicyModels 查询生成策略所需的全部菜单及其关联数据
+func (s *MenuService) listPolicyModels(ctx context.Context) ([]models.MenuModel, error) {
 	qp := database.QueryParams{
 		Preloads: []string{"Parent", "Permissions"},
 		Query:    nil,
@@ -133,15 +302,7 @@ func (s *MenuService) LoadPolicies(ctx context.Context) error {
 		IsCount:  false,
 	}
 	_, ms, err := s.ListModel(ctx, qp)
-	if err != nil {
-		return err
-	}
-	for _, m := range ms {
-		if err := s.AddGroupPolicy(ctx, m); err != nil {
-			return err
-		}
-	}
-	return nil
+	return ms, err
 }
 
 func (s *MenuService) AddGroupPolicy(
@@ -153,38 +314,15 @@ func (s *MenuService) AddGroupPolicy(
 		return ctx.Err()
 	default:
 	}
-	sub := menuModelToSub(m)
-
-	// 处理父级关系
-	if m.Parent != nil {
-		obj := menuModelToSub(*m.Parent)
-		if err := s.cache.AddGroupPolicy(sub, obj); err != nil {
-			logx.WithContext(ctx).Errorw(
-				"添加菜单与父级菜单的继承关系策略失败",
-				logx.Field(auth.SubKey, sub),
-				logx.Field(auth.ObjKey, obj),
-				logx.Field("menu_id", m.Id),
-				logx.Field("parent_menu_id", m.Parent.Id),
-				logx.Field(errors.ErrKey, err),
-			)
-			return err
-		}
-	}
-
-	// 批量处理权限
-	for _, o := range m.Permissions {
-		obj := permissionModelToSub(o)
-		if err := s.cache.AddGroupPolicy(sub, obj); err != nil {
-			logx.WithContext(ctx).Errorw(
-				"添加菜单与权限的关联策略失败",
-				logx.Field(auth.SubKey, sub),
-				logx.Field(auth.ObjKey, obj),
-				logx.Field("menu_id", m.Id),
-				logx.Field("permission_id", o.Id),
-				logx.Field(errors.ErrKey, err),
-			)
-			return err
-		}
+	rules := menuGroupingRules(m)
+	if err := s.cache.AddGroupPolicies(rules); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"添加菜单的关联策略失败",
+			logx.Field("menu_id", m.Id),
+			logx.Field("rules", rules),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
 	}
 	return nil
 }
@@ -226,3 +364,17 @@ func (s *MenuService) RemoveGroupPolicy(
 func menuModelToSub(m models.MenuModel) string {
 	return fmt.Sprintf("menu_%d", m.Id)
 }
+
+// menuGroupingRules 返回菜单的 g 规则：菜单继承父级菜单和关联的权限
+func menuGroupingRules(m models.MenuModel) [][]string {
+	sub := menuModelToSub(m)
+	dom := auth.TenantDomain(m.TenantId)
+	rules := make([][]string, 0, len(m.Permissions)+1)
+	if m.Parent != nil {
+		rules = append(rules, []string{sub, menuModelToSub(*m.Parent), dom})
+	}
+	for _, o := range m.Permissions {
+		rules = append(rules, []string{sub, permissionModelToSub(o), dom})
+	}
+	return rules
+}
@@ -0,0 +1,152 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+)
+
+// createTestMenus 创建菜单，parents[i] 为第i个菜单的父级下标，-1表示顶层
+func createTestMenus(t *testing.T, s *MenuService, parents ...int) []models.MenuModel {
+	t.Helper()
+	ms := make([]models.MenuModel, len(parents))
+	for i, p := range parents {
+		ms[i] = models.MenuModel{
+			Path:         fmt.Sprintf("/m%d", i),
+			Name:         fmt.Sprintf("m%d", i),
+			Component:    "Layout",
+			ArrangeOrder: uint32(i),
+		}
+		if p >= 0 {
+			ms[i].ParentId = &ms[p].Id
+		}
+		if err := s.gormDB.Create(&ms[i]).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ms
+}
+
+func TestMenuMoveModelRejectsCycle(t *testing.T) {
+	s := NewMenuService(newTestDB(t), nil)
+	ctx := context.Background()
+	// m0 -> m1 -> m2，m3为顶层
+	ms := createTestMenus(t, s, -1, 0, 1, -1)
+	cases := []struct {
+		name   string
+		id     uint32
+		parent uint32
+	}{
+		{"self", ms[0].Id, ms[0].Id},
+		{"under child", ms[0].Id, ms[1].Id},
+		{"under grandchild", ms[0].Id, ms[2].Id},
+	}
+	for _, c := range cases {
+		if err := s.MoveModel(ctx, c.id, c.parent, 0); !errors.Is(err, ErrMenuCycle) {
+			t.Errorf("%s: err = %v, want ErrMenuCycle", c.name, err)
+		}
+	}
+
+	if err := s.MoveModel(ctx, ms[3].Id, ms[2].Id, 0); err != nil {
+		t.Fatal(err)
+	}
+	// m3 已在 m2 之下，m2 不能再移动到 m3 之下
+	if err := s.MoveModel(ctx, ms[2].Id, ms[3].Id, 0); !errors.Is(err, ErrMenuCycle) {
+		t.Fatalf("err = %v, want ErrMenuCycle", err)
+	}
+}
+
+func TestMenuMoveModelShiftsSiblings(t *testing.T) {
+	s := NewMenuService(newTestDB(t), nil)
+	ctx := context.Background()
+	// 顶层 m0(0) m1(1) m2(2)，m3 在 m0 之下
+	ms := createTestMenus(t, s, -1, -1, -1, 0)
+	if err := s.MoveModel(ctx, ms[3].Id, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	var got []models.MenuModel
+	if err := s.gormDB.Where("parent_id IS NULL").Order("arrange_order").Find(&got).Error; err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		id    uint32
+		order uint32
+	}{{ms[0].Id, 0}, {ms[3].Id, 1}, {ms[1].Id, 2}, {ms[2].Id, 3}}
+	if len(got) != len(want) {
+		t.Fatalf("top-level menus = %d, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Id != w.id || got[i].ArrangeOrder != w.order {
+			t.Errorf("menus[%d] = (id %d, order %d), want (id %d, order %d)", i, got[i].Id, got[i].ArrangeOrder, w.id, w.order)
+		}
+	}
+}
+
+func TestMenuUpdateModelWithParent(t *testing.T) {
+	s := NewMenuService(newTestDB(t), nil)
+	ctx := context.Background()
+	// m0 -> m1 -> m2
+	ms := createTestMenus(t, s, -1, 0, 1)
+	perm := models.PermissionModel{Url: "/api/v1/menu", Method: "GET"}
+	if err := s.gormDB.Create(&perm).Error; err != nil {
+		t.Fatal(err)
+	}
+	upmap := map[string]any{"Permissions": []models.PermissionModel{perm}}
+
+	err := s.UpdateModelWithParent(ctx, ms[0].Id, ms[2].Id, map[string]any{"descr": "cycle", "parent_id": ms[2].Id}, upmap)
+	if !errors.Is(err, ErrMenuCycle) {
+		t.Fatalf("err = %v, want ErrMenuCycle", err)
+	}
+	m, err := s.FindModel(ctx, []string{"Permissions"}, ms[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Descr != "" || m.ParentId != nil || len(m.Permissions) != 0 {
+		t.Errorf("rejected update was written: %+v", m)
+	}
+
+	// m2 改为顶层菜单后，m0 可以移动到 m2 之下
+	if err := s.UpdateModelWithParent(ctx, ms[2].Id, 0, map[string]any{"parent_id": nil}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateModelWithParent(ctx, ms[0].Id, ms[2].Id, map[string]any{"descr": "moved", "parent_id": ms[2].Id}, upmap); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = s.FindModel(ctx, []string{"Permissions"}, ms[0].Id); err != nil {
+		t.Fatal(err)
+	}
+	if m.Descr != "moved" || m.ParentId == nil || *m.ParentId != ms[2].Id || len(m.Permissions) != 1 {
+		t.Errorf("menu after update = %+v", m)
+	}
+}
+
+func TestMenuCreateModelRejectsCycle(t *testing.T) {
+	s := NewMenuService(newTestDB(t), nil)
+	ctx := context.Background()
+	// 已有菜单引用了尚不存在的菜单100作为上级
+	dangling := uint32(100)
+	child := models.MenuModel{Path: "/child", Name: "child", Component: "Layout", ParentId: &dangling}
+	if err := s.gormDB.Create(&child).Error; err != nil {
+		t.Fatal(err)
+	}
+	m := models.MenuModel{Path: "/m100", Name: "m100", Component: "Layout", ParentId: &child.Id}
+	m.Id = dangling
+	if err := s.CreateModel(ctx, &m); !errors.Is(err, ErrMenuCycle) {
+		t.Fatalf("err = %v, want ErrMenuCycle", err)
+	}
+	var count int64
+	if err := s.gormDB.Model(&models.MenuModel{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("menus = %d, want 1", count)
+	}
+
+	ok := models.MenuModel{Path: "/m1", Name: "m1", Component: "Layout", ParentId: &child.Id}
+	if err := s.CreateModel(ctx, &ok); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,178 @@
+package svc
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// MenuTreeOptions 查询菜单树的选项
+type MenuTreeOptions struct {
+	WithButtons     bool     // 返回菜单下的按钮
+	WithPermissions bool     // 返回菜单和按钮关联的权限
+	ActiveOnly      bool     // 只返回激活的菜单和按钮，未激活菜单的子菜单同样不返回
+	RoleIds         []uint32 // 只返回授予这些角色的菜单和按钮，为nil时不限定
+}
+
+// MenuTreeNode 菜单树的节点，子菜单和按钮按排序值和ID升序
+type MenuTreeNode struct {
+	Menu     models.MenuModel
+	Buttons  []models.ButtonModel
+	Children []*MenuTreeNode
+}
+
+// Tree 查询调用方可见的菜单并组装为树，父级菜单不可见的菜单作为根节点
+// 菜单、按钮和关联数据各用一次查询加载，不随菜单数量增加查询次数
+// 限定角色时保留被授予的菜单及其所有上级菜单，使树保持连通
+func (s *MenuService) Tree(ctx context.Context, opts MenuTreeOptions) ([]*MenuTreeNode, error) {
+	db := s.gormDB.WithContext(ctx)
+	var ms []models.MenuModel
+	query := db.Model(&models.MenuModel{})
+	if opts.WithPermissions {
+		query = query.Preload("Permissions")
+	}
+	if err := query.Find(&ms).Error; err != nil {
+		logx.WithContext(ctx).Errorw("查询菜单树失败", logx.Field(errors.ErrKey, err))
+		return nil, err
+	}
+
+	var grantedMenus, grantedButtons map[uint32]bool
+	if opts.RoleIds != nil {
+		var err error
+		if grantedMenus, err = s.listGrantedIds(ctx, "customer_role_menu", "menu_id", opts.RoleIds); err != nil {
+			return nil, err
+		}
+		if grantedButtons, err = s.listGrantedIds(ctx, "customer_role_button", "button_id", opts.RoleIds); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes := make(map[uint32]*MenuTreeNode, len(ms))
+	for _, m := range ms {
+		nodes[m.Id] = &MenuTreeNode{Menu: m}
+	}
+	children := make(map[uint32][]*MenuTreeNode, len(ms))
+	roots := make([]*MenuTreeNode, 0)
+	for _, m := range ms {
+		if m.ParentId != nil && *m.ParentId != m.Id && nodes[*m.ParentId] != nil {
+			children[*m.ParentId] = append(children[*m.ParentId], nodes[m.Id])
+			continue
+		}
+		roots = append(roots, nodes[m.Id])
+	}
+
+	// 自顶向下裁剪，存在继承环时环上的菜单不可达，不返回
+	visited := make(map[uint32]bool, len(ms))
+	var prune func(ns []*MenuTreeNode) []*MenuTreeNode
+	prune = func(ns []*MenuTreeNode) []*MenuTreeNode {
+		kept := make([]*MenuTreeNode, 0, len(ns))
+		for _, n := range ns {
+			if visited[n.Menu.Id] || (opts.ActiveOnly && !n.Menu.IsActive) {
+				continue
+			}
+			visited[n.Menu.Id] = true
+			n.Children = prune(children[n.Menu.Id])
+			if grantedMenus != nil && !grantedMenus[n.Menu.Id] && len(n.Children) == 0 {
+				continue
+			}
+			kept = append(kept, n)
+		}
+		sortMenuTreeNodes(kept)
+		return kept
+	}
+	tree := prune(roots)
+
+	if opts.WithButtons {
+		ids := make([]uint32, 0, len(nodes))
+		for id := range visited {
+			ids = append(ids, id)
+		}
+		if err := s.attachButtons(ctx, nodes, ids, grantedButtons, opts); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+// listGrantedIds 查询授予角色的菜单或按钮ID
+func (s *MenuService) listGrantedIds(
+	ctx context.Context,
+	table string,
+	column string,
+	roleIds []uint32,
+) (map[uint32]bool, error) {
+	granted := make(map[uint32]bool)
+	if len(roleIds) == 0 {
+		return granted, nil
+	}
+	var ids []uint32
+	if err := s.gormDB.WithContext(ctx).
+		Table(table).
+		Where("role_id IN ?", roleIds).
+		Distinct().
+		Pluck(column, &ids).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询角色授权失败",
+			logx.Field("table", table),
+			logx.Field("role_ids", roleIds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	for _, id := range ids {
+		granted[id] = true
+	}
+	return granted, nil
+}
+
+// attachButtons 一次查询树中所有菜单的按钮，挂载到对应的节点
+func (s *MenuService) attachButtons(
+	ctx context.Context,
+	nodes map[uint32]*MenuTreeNode,
+	menuIds []uint32,
+	granted map[uint32]bool,
+	opts MenuTreeOptions,
+) error {
+	if len(menuIds) == 0 {
+		return nil
+	}
+	query := s.gormDB.WithContext(ctx).Model(&models.ButtonModel{}).Where("menu_id IN ?", menuIds)
+	if opts.ActiveOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if opts.WithPermissions {
+		query = query.Preload("Permissions")
+	}
+	var bs []models.ButtonModel
+	if err := query.Order("arrange_order").Order("id").Find(&bs).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询菜单树的按钮失败",
+			logx.Field("menu_ids", menuIds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	for _, b := range bs {
+		if granted != nil && !granted[b.Id] {
+			continue
+		}
+		if n := nodes[b.MenuId]; n != nil {
+			n.Buttons = append(n.Buttons, b)
+		}
+	}
+	return nil
+}
+
+func sortMenuTreeNodes(ns []*MenuTreeNode) {
+	slices.SortFunc(ns, func(a, b *MenuTreeNode) int {
+		return cmp.Or(
+			cmp.Compare(a.Menu.ArrangeOrder, b.Menu.ArrangeOrder),
+			cmp.Compare(a.Menu.Id, b.Menu.Id),
+		)
+	})
+}
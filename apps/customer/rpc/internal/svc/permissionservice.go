@@ -114,7 +114,8 @@ func (s *PermissionService) ListModelByIds(
 	return ms, err
 }
 
-func (s *PermissionService) LoadPolicies(ctx context.Context) error {
+// listPolicyModels 查询生成策略所需的全部权限
+func (s *PermissionService) listPolicyModels(ctx context.Context) ([]models.PermissionModel, error) {
 	qp := database.QueryParams{
 		Preloads: []string{},
 		Query:    nil,
@@ -124,15 +125,7 @@ func (s *PermissionService) LoadPolicies(ctx context.Context) error {
 		IsCount:  false,
 	}
 	_, ms, err := s.ListModel(ctx, qp)
-	if err != nil {
-		return err
-	}
-	for _, m := range ms {
-		if err := s.AddPolicy(ctx, m); err != nil {
-			return err
-		}
-	}
-	return nil
+	return ms, err
 }
 
 func (s *PermissionService) AddPolicy(
@@ -144,13 +137,11 @@ func (s *PermissionService) AddPolicy(
 		return ctx.Err()
 	default:
 	}
-	sub := permissionModelToSub(m)
-	if err := s.cache.AddPolicy(sub, m.Url, m.Method); err != nil {
+	rule := permissionPolicyRule(m)
+	if err := s.cache.AddPolicies([][]string{rule}); err != nil {
 		logx.WithContext(ctx).Errorw(
 			"添加权限策略失败",
-			logx.Field(auth.SubKey, sub),
-			logx.Field(auth.ObjKey, m.Url),
-			logx.Field(auth.ActKey, m.Method),
+			logx.Field("rule", rule),
 			logx.Field(errors.ErrKey, err),
 		)
 		return err
@@ -169,12 +160,11 @@ func (s *PermissionService) RemovePolicy(
 	default:
 	}
 	sub := permissionModelToSub(m)
-	if err := s.cache.RemovePolicy(sub, m.Url, m.Method); err != nil {
+	// 按主体删除，URL、请求方法等字段修改后也能删除旧的规则
+	if err := s.cache.RemoveFilteredPolicy(0, sub); err != nil {
 		logx.WithContext(ctx).Errorw(
 			"删除权限策略失败",
 			logx.Field(auth.SubKey, sub),
-			logx.Field(auth.ObjKey, m.Url),
-			logx.Field(auth.ActKey, m.Method),
 			logx.Field(errors.ErrKey, err),
 		)
 		return err
@@ -193,5 +183,22 @@ func (s *PermissionService) RemovePolicy(
 }
 
 func permissionModelToSub(m models.PermissionModel) string {
-	return strconv.FormatUint(uint64(m.Id), 10)
+	return permissionIdToSub(m.Id)
+}
+
+func permissionIdToSub(id uint32) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// permissionPolicyRule 返回权限的 p 规则：[权限, 域, URL, 请求方法, 匹配方式, 效果, 附加条件]
+func permissionPolicyRule(m models.PermissionModel) []string {
+	return auth.PolicyRule(
+		permissionModelToSub(m),
+		auth.TenantDomain(m.TenantId),
+		m.Url,
+		m.Method,
+		m.Matcher,
+		m.Effect,
+		auth.JoinConditions(m.Conditions),
+	)
 }
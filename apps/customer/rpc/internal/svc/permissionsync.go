@@ -0,0 +1,210 @@
+package svc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 启动时按已注册的接口同步权限的方式
+const (
+	PermissionSyncOff    = "off"    // 不同步
+	PermissionSyncReport = "report" // 只输出差异
+	PermissionSyncApply  = "apply"  // 新增缺失的权限
+)
+
+// PermissionSyncResult 按接口列表同步平台级权限的结果
+type PermissionSyncResult struct {
+	Created []models.PermissionModel // 新增的权限，试运行时未写入，ID为0
+	Updated []models.PermissionModel // 补充了标签或描述的权限
+	Stale   []models.PermissionModel // 接口已不存在的权限，只标记不删除
+}
+
+// SetEndpoints 记录本服务对外提供的接口，需在 SyncPermissionsOnStart 前调用
+func (s *ServiceContext) SetEndpoints(endpoints []auth.Endpoint) {
+	s.endpoints.Store(&endpoints)
+}
+
+// Endpoints 本服务对外提供的接口，未记录时为空
+func (s *ServiceContext) Endpoints() []auth.Endpoint {
+	if p := s.endpoints.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// SyncPermissions 为每个接口新增一条平台级权限，已存在的权限只补充空白的标签和描述，不覆盖人工修改
+// 与接口同类（gRPC或REST）、未使用通配符且接口已不存在的权限标记为失效，由管理员确认后删除
+// dryRun为true时只返回差异，不写入数据；写入后需调用 NotifyPolicyChange
+func (s *ServiceContext) SyncPermissions(
+	ctx context.Context,
+	endpoints []auth.Endpoint,
+	dryRun bool,
+) (*PermissionSyncResult, error) {
+	res := &PermissionSyncResult{
+		Created: make([]models.PermissionModel, 0),
+		Updated: make([]models.PermissionModel, 0),
+		Stale:   make([]models.PermissionModel, 0),
+	}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var pms []models.PermissionModel
+		if err := tx.Where("tenant_id = ?", database.PlatformTenantId).Order("id").Find(&pms).Error; err != nil {
+			return err
+		}
+		existing := make(map[string]models.PermissionModel, len(pms))
+		for _, m := range pms {
+			if _, ok := existing[permissionKey(m)]; !ok {
+				existing[permissionKey(m)] = m
+			}
+		}
+		keys := make(map[string]bool, len(endpoints))
+		var hasGrpc, hasRest bool
+		for _, e := range endpoints {
+			keys[e.Key()] = true
+			if e.Method == auth.MethodGrpc {
+				hasGrpc = true
+			} else {
+				hasRest = true
+			}
+			om, ok := existing[e.Key()]
+			if !ok {
+				m := models.PermissionModel{
+					TenantId: database.PlatformTenantId,
+					Url:      e.Url,
+					Method:   e.Method,
+					Matcher:  e.Matcher,
+					Effect:   auth.EffectAllow,
+					Label:    e.Label,
+					Descr:    e.Descr,
+				}
+				if m.Matcher == "" {
+					m.Matcher = auth.MatchExact
+				}
+				if !dryRun {
+					if err := tx.Create(&m).Error; err != nil {
+						return err
+					}
+				}
+				res.Created = append(res.Created, m)
+				continue
+			}
+			data := make(map[string]any)
+			if om.Label == "" && e.Label != "" {
+				data["label"] = e.Label
+				om.Label = e.Label
+			}
+			if om.Descr == "" && e.Descr != "" {
+				data["descr"] = e.Descr
+				om.Descr = e.Descr
+			}
+			if len(data) == 0 {
+				continue
+			}
+			if !dryRun {
+				if err := tx.Model(&models.PermissionModel{}).Where("id = ?", om.Id).Updates(data).Error; err != nil {
+					return err
+				}
+			}
+			res.Updated = append(res.Updated, om)
+		}
+		for _, m := range pms {
+			if keys[permissionKey(m)] || strings.Contains(m.Url, "*") || m.Matcher == auth.MatchRegex {
+				continue
+			}
+			switch {
+			case m.Method == auth.MethodGrpc && hasGrpc,
+				auth.IsHttpMethod(m.Method) && hasRest:
+				res.Stale = append(res.Stale, m)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"按接口同步权限失败",
+			logx.Field("endpoints", len(endpoints)),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	if dryRun {
+		return res, nil
+	}
+	for _, m := range res.Created {
+		if err := s.Perm.AddPolicy(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// SyncPermissionsOnStart 按配置在启动时同步权限并输出差异，失败时只记录日志
+func (s *ServiceContext) SyncPermissionsOnStart() {
+	mode := s.Config.Security.PermissionSync
+	if mode == "" || mode == PermissionSyncOff {
+		return
+	}
+	ctx := context.Background()
+	if mode == PermissionSyncApply {
+		// 多个实例同时写入会新增重复的权限，持有锁的实例写入后，其余实例再按已有数据同步
+		if err := s.lockPermissionSync(ctx); err != nil {
+			logx.Errorw("获取同步权限的锁失败", logx.Field(errors.ErrKey, err))
+			return
+		}
+		defer func() {
+			if err := s.syncLock.Release(ctx); err != nil {
+				logx.Errorw("释放同步权限的锁失败", logx.Field(errors.ErrKey, err))
+			}
+		}()
+	}
+	res, err := s.SyncPermissions(ctx, s.Endpoints(), mode != PermissionSyncApply)
+	if err != nil {
+		return
+	}
+	if mode == PermissionSyncApply {
+		if err := s.NotifyPolicyChange(); err != nil {
+			logx.Errorw("发布同步权限的策略变更失败", logx.Field(errors.ErrKey, err))
+		}
+	}
+	msg := "接口缺少对应的权限"
+	if mode == PermissionSyncApply {
+		msg = "已新增接口对应的权限"
+	}
+	for _, m := range res.Created {
+		logx.Infow(msg, logx.Field("key", permissionKey(m)))
+	}
+	for _, m := range res.Stale {
+		logx.Infow("权限对应的接口已不存在", logx.Field("permission_id", m.Id), logx.Field("key", permissionKey(m)))
+	}
+}
+
+// lockPermissionSync 等待获取同步权限的锁，最长等待一个锁的有效期
+func (s *ServiceContext) lockPermissionSync(ctx context.Context) error {
+	if s.syncLock == nil {
+		s.syncLock = newRedisLease(s.goredis, DefaultPermissionSyncLockKey, s.instanceID, DefaultPermissionSyncLockTTL)
+	}
+	ctx, cancel := context.WithTimeout(ctx, DefaultPermissionSyncLockTTL)
+	defer cancel()
+	for {
+		ok, err := s.syncLock.Acquire(ctx)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(permissionSyncLockRetryPeriod):
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package svc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+)
+
+func newPermissionSyncTestContext(t *testing.T) *ServiceContext {
+	t.Helper()
+	db := newTestDB(t, &models.PermissionModel{})
+	enforcer := newTestEnforcer(t)
+	return &ServiceContext{
+		db:       db,
+		enforcer: enforcer,
+		Perm:     NewPermissionService(db, enforcer),
+	}
+}
+
+func createTestPermission(t *testing.T, s *ServiceContext, m models.PermissionModel) models.PermissionModel {
+	t.Helper()
+	if m.Matcher == "" {
+		m.Matcher = auth.MatchExact
+	}
+	if m.Effect == "" {
+		m.Effect = auth.EffectAllow
+	}
+	if err := s.db.Create(&m).Error; err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func grpcEndpoint(method, descr string) auth.Endpoint {
+	return auth.Endpoint{
+		Url:     "/customer.User/" + method,
+		Method:  auth.MethodGrpc,
+		Matcher: auth.MatchExact,
+		Label:   "User",
+		Descr:   descr,
+	}
+}
+
+func TestSyncPermissions(t *testing.T) {
+	s := newPermissionSyncTestContext(t)
+	ctx := context.Background()
+	createTestPermission(t, s, models.PermissionModel{Url: "/customer.User/GetCustomer", Method: auth.MethodGrpc})
+	createTestPermission(t, s, models.PermissionModel{Url: "/customer.User/ListCustomer", Method: auth.MethodGrpc, Label: "User", Descr: "人工描述"})
+	stale := createTestPermission(t, s, models.PermissionModel{Url: "/customer.User/Removed", Method: auth.MethodGrpc})
+	createTestPermission(t, s, models.PermissionModel{Url: "/customer.User/*", Method: auth.MethodGrpc, Matcher: auth.MatchKeyMatch2})
+	createTestPermission(t, s, models.PermissionModel{Url: "/api/v1/user", Method: "GET"})
+	createTestPermission(t, s, models.PermissionModel{TenantId: 1, Url: "/customer.User/CreateUser", Method: auth.MethodGrpc})
+	endpoints := []auth.Endpoint{
+		grpcEndpoint("CreateUser", "新增用户"),
+		grpcEndpoint("GetCustomer", "查询用户详情"),
+		grpcEndpoint("ListCustomer", "查询用户列表"),
+	}
+
+	res, err := s.SyncPermissions(ctx, endpoints, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 租户级的同名权限不影响平台级权限的同步
+	if len(res.Created) != 1 || res.Created[0].Url != "/customer.User/CreateUser" || res.Created[0].Id != 0 {
+		t.Fatalf("dry run created = %+v", res.Created)
+	}
+	if len(res.Updated) != 1 || res.Updated[0].Url != "/customer.User/GetCustomer" || res.Updated[0].Descr != "查询用户详情" {
+		t.Fatalf("dry run updated = %+v", res.Updated)
+	}
+	// 通配符和其他类型的权限不标记为失效
+	if len(res.Stale) != 1 || res.Stale[0].Id != stale.Id {
+		t.Fatalf("dry run stale = %+v", res.Stale)
+	}
+	var count int64
+	s.db.Model(&models.PermissionModel{}).Count(&count)
+	if count != 6 {
+		t.Fatalf("dry run wrote data: %d permissions", count)
+	}
+
+	if _, err := s.SyncPermissions(ctx, endpoints, false); err != nil {
+		t.Fatal(err)
+	}
+	var created models.PermissionModel
+	if err := s.db.Where("tenant_id = ? AND url = ?", database.PlatformTenantId, "/customer.User/CreateUser").First(&created).Error; err != nil {
+		t.Fatal(err)
+	}
+	if created.Descr != "新增用户" || created.Label != "User" {
+		t.Fatalf("created = %+v", created)
+	}
+	var kept models.PermissionModel
+	s.db.Where("url = ?", "/customer.User/ListCustomer").First(&kept)
+	if kept.Descr != "人工描述" {
+		t.Fatalf("manual descr overwritten: %q", kept.Descr)
+	}
+	rule := permissionPolicyRule(created)
+	if ok, err := s.Perm.cache.Authorization(rule[0], rule[1], created.Url, created.Method, nil); err != nil || !ok {
+		t.Fatalf("policy for created permission missing: ok=%v err=%v", ok, err)
+	}
+
+	// 再次同步时没有差异
+	res, err = s.SyncPermissions(ctx, endpoints, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Created) != 0 || len(res.Updated) != 0 {
+		t.Fatalf("second sync created=%d updated=%d, want none", len(res.Created), len(res.Updated))
+	}
+}
+
+func TestSyncPermissionsOnStartWaitsForLock(t *testing.T) {
+	s := newPermissionSyncTestContext(t)
+	s.Config.Security.PermissionSync = PermissionSyncApply
+	s.watcher = auth.NewMemoryPolicyWatcher(auth.NewMemoryPolicyBus())
+	s.SetEndpoints([]auth.Endpoint{grpcEndpoint("CreateUser", "")})
+	lock := &fakeLease{err: context.DeadlineExceeded}
+	s.syncLock = lock
+
+	// 获取锁失败时不写入
+	s.SyncPermissionsOnStart()
+	var count int64
+	s.db.Model(&models.PermissionModel{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("synced without the lock: %d permissions", count)
+	}
+
+	lock.err, lock.leader = nil, true
+	done := make(chan struct{})
+	go func() {
+		s.SyncPermissionsOnStart()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sync did not finish")
+	}
+	s.db.Model(&models.PermissionModel{}).Count(&count)
+	if count != 1 {
+		t.Fatalf("permissions = %d, want 1", count)
+	}
+}
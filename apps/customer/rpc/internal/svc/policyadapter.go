@@ -0,0 +1,146 @@
+package svc
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+)
+
+// PolicyAdapter 基于 customer_* 业务表的casbin适配器
+// 策略由权限、菜单、按钮、角色和用户及其关联表推导而来，业务表是唯一的数据来源，
+// 因此适配器只负责读取，写入操作均为空操作
+type PolicyAdapter struct {
+	perm   *PermissionService
+	menu   *MenuService
+	button *ButtonService
+	role   *RoleService
+	user   *UserService
+}
+
+func NewPolicyAdapter(
+	perm *PermissionService,
+	menu *MenuService,
+	button *ButtonService,
+	role *RoleService,
+	user *UserService,
+) *PolicyAdapter {
+	return &PolicyAdapter{
+		perm:   perm,
+		menu:   menu,
+		button: button,
+		role:   role,
+		user:   user,
+	}
+}
+
+// LoadRules 从业务表读取全部 p 规则和 g 规则，重复的规则只保留一条
+func (a *PolicyAdapter) LoadRules(ctx context.Context) ([][]string, [][]string, error) {
+	pms, err := a.perm.listPolicyModels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	policies := make([][]string, 0, len(pms))
+	for _, m := range pms {
+		policies = append(policies, permissionPolicyRule(m))
+	}
+
+	groupings := make([][]string, 0)
+	mms, err := a.menu.listPolicyModels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range mms {
+		groupings = append(groupings, menuGroupingRules(m)...)
+	}
+	bms, err := a.button.listPolicyModels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range bms {
+		groupings = append(groupings, buttonGroupingRules(m)...)
+	}
+	rms, err := a.role.listPolicyModels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range rms {
+		policies = append(policies, rolePolicyRules(m)...)
+		groupings = append(groupings, roleGroupingRules(m)...)
+	}
+	ums, err := a.user.listPolicyModels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range ums {
+		groupings = append(groupings, userGroupingRules(m)...)
+	}
+	return uniqueRules(policies), uniqueRules(groupings), nil
+}
+
+// LoadPolicy 实现 persist.Adapter，将业务表中的策略加载到模型
+func (a *PolicyAdapter) LoadPolicy(m model.Model) error {
+	policies, groupings, err := a.LoadRules(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, rule := range policies {
+		if err := persist.LoadPolicyArray(append([]string{"p"}, rule...), m); err != nil {
+			return err
+		}
+	}
+	for _, rule := range groupings {
+		if err := persist.LoadPolicyArray(append([]string{"g"}, rule...), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SavePolicy 策略随业务表一同保存，无需单独持久化
+func (a *PolicyAdapter) SavePolicy(m model.Model) error {
+	return nil
+}
+
+// AddPolicy 策略随业务表一同保存，无需单独持久化
+func (a *PolicyAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return nil
+}
+
+// AddPolicies 策略随业务表一同保存，无需单独持久化
+func (a *PolicyAdapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	return nil
+}
+
+// RemovePolicy 策略随业务表一同删除，无需单独持久化
+func (a *PolicyAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return nil
+}
+
+// RemovePolicies 策略随业务表一同删除，无需单独持久化
+func (a *PolicyAdapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	return nil
+}
+
+// RemoveFilteredPolicy 策略随业务表一同删除，无需单独持久化
+func (a *PolicyAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	return nil
+}
+
+// uniqueRules 去除重复的规则并保持原有顺序
+func uniqueRules(rules [][]string) [][]string {
+	seen := make(map[string]bool, len(rules))
+	result := make([][]string, 0, len(rules))
+	for _, rule := range rules {
+		// 规则字段（如URL、条件）可能包含逗号，使用不会出现在字段中的分隔符
+		key := strings.Join(rule, "\x00")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, slices.Clone(rule))
+	}
+	return result
+}
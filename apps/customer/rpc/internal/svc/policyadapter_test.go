@@ -0,0 +1,23 @@
+package svc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUniqueRules(t *testing.T) {
+	rules := [][]string{
+		{"role_1", "/a,b", "GET"},
+		{"role_1", "/a", "b,GET"},
+		{"role_1", "/a,b", "GET"},
+		{"role_2", "/a", "GET"},
+	}
+	want := [][]string{
+		{"role_1", "/a,b", "GET"},
+		{"role_1", "/a", "b,GET"},
+		{"role_2", "/a", "GET"},
+	}
+	if got := uniqueRules(rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("uniqueRules = %v, want %v", got, want)
+	}
+}
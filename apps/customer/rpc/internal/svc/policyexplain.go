@@ -0,0 +1,109 @@
+package svc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/database"
+)
+
+// casbin主体的类型
+const (
+	SubjectUser       = "user"
+	SubjectRole       = "role"
+	SubjectMenu       = "menu"
+	SubjectButton     = "button"
+	SubjectPermission = "permission"
+)
+
+// SubjectInfo casbin主体对应的业务数据
+type SubjectInfo struct {
+	Subject string // casbin主体
+	Kind    string // 主体类型
+	Id      uint32 // 业务表ID
+	Name    string // 用户名、角色名、菜单名、按钮名或权限的URL，数据不存在时为空
+}
+
+// parseSubject 解析casbin主体的类型和业务表ID，权限的主体为不带前缀的ID
+func parseSubject(sub string) (string, uint32, bool) {
+	kind := SubjectPermission
+	raw := sub
+	for _, k := range []string{SubjectUser, SubjectRole, SubjectMenu, SubjectButton} {
+		if rest, ok := strings.CutPrefix(sub, k+"_"); ok {
+			kind, raw = k, rest
+			break
+		}
+	}
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return "", 0, false
+	}
+	return kind, uint32(id), true
+}
+
+// DescribeSubjects 查询casbin主体对应的业务数据，用于展示继承链，无法解析的主体只返回主体本身
+func (s *ServiceContext) DescribeSubjects(ctx context.Context, subs []string) (map[string]SubjectInfo, error) {
+	infos := make(map[string]SubjectInfo, len(subs))
+	ids := make(map[string][]uint32)
+	for _, sub := range subs {
+		kind, id, ok := parseSubject(sub)
+		infos[sub] = SubjectInfo{Subject: sub, Kind: kind, Id: id}
+		if ok {
+			ids[kind] = append(ids[kind], id)
+		}
+	}
+	names := make(map[string]string, len(subs))
+	if len(ids[SubjectUser]) > 0 {
+		_, ms, err := s.User.ListModel(ctx, database.NewPksQueryParams(ids[SubjectUser]))
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range ms {
+			names[userModelToSub(m)] = m.Username
+		}
+	}
+	rms, err := s.Role.ListModelByIds(ctx, ids[SubjectRole])
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range rms {
+		names[roleModelToSub(m)] = m.Name
+	}
+	mms, err := s.Menu.ListModelByIds(ctx, ids[SubjectMenu])
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range mms {
+		names[menuModelToSub(m)] = m.Name
+	}
+	bms, err := s.Button.ListModelByIds(ctx, ids[SubjectButton])
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range bms {
+		names[buttonModelToSub(m)] = m.Name
+	}
+	pms, err := s.Perm.ListModelByIds(ctx, ids[SubjectPermission])
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range pms {
+		names[permissionModelToSub(m)] = permissionName(m)
+	}
+	for sub, info := range infos {
+		info.Name = names[sub]
+		infos[sub] = info
+	}
+	return infos, nil
+}
+
+// permissionName 权限的展示名称：请求方法、URL和标签
+func permissionName(m models.PermissionModel) string {
+	name := m.Method + " " + m.Url
+	if m.Label != "" {
+		name += " (" + m.Label + ")"
+	}
+	return name
+}
@@ -0,0 +1,94 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+)
+
+func TestParseSubject(t *testing.T) {
+	cases := []struct {
+		sub  string
+		kind string
+		id   uint32
+		ok   bool
+	}{
+		{"user_1", SubjectUser, 1, true},
+		{"role_2", SubjectRole, 2, true},
+		{"menu_3", SubjectMenu, 3, true},
+		{"button_4", SubjectButton, 4, true},
+		{"5", SubjectPermission, 5, true},
+		{"role_", "", 0, false},
+		{"role_x", "", 0, false},
+		{"tenant_1", "", 0, false},
+		{"user_4294967296", "", 0, false},
+	}
+	for _, c := range cases {
+		kind, id, ok := parseSubject(c.sub)
+		if kind != c.kind || id != c.id || ok != c.ok {
+			t.Errorf("parseSubject(%q) = %q, %d, %v, want %q, %d, %v", c.sub, kind, id, ok, c.kind, c.id, c.ok)
+		}
+	}
+}
+
+func TestDescribeSubjects(t *testing.T) {
+	db := newTestDB(t)
+	s := &ServiceContext{
+		db:     db,
+		Perm:   NewPermissionService(db, nil),
+		Menu:   NewMenuService(db, nil),
+		Button: NewButtonService(db, nil),
+		Role:   NewRoleService(db, nil),
+		User:   NewUserService(db, nil),
+	}
+	perm := models.PermissionModel{Url: "/api/v1/user", Method: "GET", Label: "用户列表", Matcher: auth.MatchExact, Effect: auth.EffectAllow}
+	if err := db.Create(&perm).Error; err != nil {
+		t.Fatal(err)
+	}
+	menu := models.MenuModel{Path: "/user", Component: "user/index", Name: "用户管理"}
+	if err := db.Create(&menu).Error; err != nil {
+		t.Fatal(err)
+	}
+	button := models.ButtonModel{Name: "新增用户", MenuId: menu.Id}
+	if err := db.Create(&button).Error; err != nil {
+		t.Fatal(err)
+	}
+	role := models.RoleModel{Name: "admin"}
+	if err := db.Create(&role).Error; err != nil {
+		t.Fatal(err)
+	}
+	user := models.UserModel{Username: "alice", Password: "x"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]SubjectInfo{
+		userModelToSub(user):         {Kind: SubjectUser, Id: user.Id, Name: "alice"},
+		roleModelToSub(role):         {Kind: SubjectRole, Id: role.Id, Name: "admin"},
+		menuModelToSub(menu):         {Kind: SubjectMenu, Id: menu.Id, Name: "用户管理"},
+		buttonModelToSub(button):     {Kind: SubjectButton, Id: button.Id, Name: "新增用户"},
+		permissionModelToSub(perm):   {Kind: SubjectPermission, Id: perm.Id, Name: "GET /api/v1/user (用户列表)"},
+		fmt.Sprintf("role_%d", 9999): {Kind: SubjectRole, Id: 9999},
+		"tenant_1":                   {},
+	}
+	subs := make([]string, 0, len(want))
+	for sub := range want {
+		subs = append(subs, sub)
+	}
+	infos, err := s.DescribeSubjects(context.Background(), subs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != len(want) {
+		t.Errorf("infos = %+v, want %d subjects", infos, len(want))
+	}
+	for sub, w := range want {
+		w.Subject = sub
+		if got := infos[sub]; got != w {
+			t.Errorf("DescribeSubjects[%q] = %+v, want %+v", sub, got, w)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/config"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/errors"
+
+	goReids "github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	DefaultPolicyChangeKey      = "/casbin/policy-change-signal"
+	DefaultPolicyResyncInterval = 10 * time.Minute
+)
+
+// newPolicyWatcher 根据配置创建策略变更监听器，使用etcd时一并返回其客户端以便关闭
+func newPolicyWatcher(c config.Config, goredis *goReids.Client) (auth.PolicyWatcher, *clientv3.Client, error) {
+	key := c.Security.PolicyChangeKey
+	if key == "" {
+		key = DefaultPolicyChangeKey
+	}
+	switch c.Security.PolicyWatcher {
+	case auth.WatcherRedis, "":
+		return auth.NewRedisPolicyWatcher(goredis, key), nil, nil
+	case auth.WatcherEtcd:
+		cli, err := clientv3.New(clientv3.Config{
+			Endpoints:   c.Etcd.Hosts,
+			Username:    c.Etcd.User,
+			Password:    c.Etcd.Pass,
+			DialTimeout: 5 * time.Second,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return auth.NewEtcdPolicyWatcher(cli, key), cli, nil
+	case auth.WatcherMemory:
+		return auth.NewMemoryPolicyWatcher(auth.NewMemoryPolicyBus()), nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported policy watcher: %s", c.Security.PolicyWatcher)
+	}
+}
+
+// RefreshPolicies 从业务表重新加载全部casbin策略
+// 新的策略在独立的执行器中构建完成后原子替换，加载期间的鉴权请求仍使用旧策略
+// 加载前记录全局策略版本号，此后的变更消息将在加载完成后继续增量应用
+func (s *ServiceContext) RefreshPolicies() error {
+	timeout := s.Config.Security.PolicyLoadTimeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	version, err := s.watcher.Version(ctx)
+	if err != nil {
+		// 版本号未知时，收到的下一条变更消息会再次触发全量加载
+		logx.Errorw("读取策略版本号失败", logx.Field(errors.ErrKey, err))
+		version = -1
+	}
+	stats, err := s.enforcer.Reload(ctx, s.adapter)
+	if err != nil {
+		logx.Errorw("加载casbin策略失败", logx.Field(errors.ErrKey, err))
+		return err
+	}
+	s.policyVersion.Store(version)
+	logx.Infow(
+		"策略加载完成",
+		logx.Field("version", version),
+		logx.Field("duration", stats.Duration.String()),
+		logx.Field("policies", stats.Policies),
+		logx.Field("groupings", stats.Groupings),
+	)
+	return nil
+}
+
+// PolicyWatcherHealth 返回策略变更监听连接的健康状态
+func (s *ServiceContext) PolicyWatcherHealth() auth.PolicyWatcherHealth {
+	return s.watcher.Health()
+}
+
+// policySyncHandler 将监听到的策略变更应用到本实例
+type policySyncHandler struct {
+	s *ServiceContext
+}
+
+// OnResync 增量同步无法继续时全量重新加载策略
+func (h policySyncHandler) OnResync(reason string) {
+	h.s.syncMu.Lock()
+	defer h.s.syncMu.Unlock()
+	logx.Infow("开始全量同步策略", logx.Field("reason", reason))
+	if err := h.s.RefreshPolicies(); err != nil {
+		logx.Errorw("策略同步失败", logx.Field("reason", reason), logx.Field(errors.ErrKey, err))
+	}
+}
+
+// OnChange 版本号连续时直接应用消息中的事件，出现缺口时全量重新加载
+func (h policySyncHandler) OnChange(change auth.PolicyChange) {
+	h.s.syncMu.Lock()
+	last := h.s.policyVersion.Load()
+	if last >= 0 && change.Version <= last {
+		// 变更已包含在最近一次全量加载中
+		h.s.syncMu.Unlock()
+		return
+	}
+	if last < 0 || change.Version != last+1 {
+		h.s.syncMu.Unlock()
+		logx.Infow(
+			"策略版本号不连续",
+			logx.Field("current", last),
+			logx.Field("received", change.Version),
+		)
+		h.OnResync("version_gap")
+		return
+	}
+	// 本实例发布的事件在发布前已经应用过
+	if change.Instance != h.s.instanceID {
+		if err := h.s.enforcer.ApplyEvents(change.Events); err != nil {
+			h.s.syncMu.Unlock()
+			logx.Errorw(
+				"应用策略变更事件失败",
+				logx.Field("version", change.Version),
+				logx.Field(errors.ErrKey, err),
+			)
+			h.OnResync("apply_failed")
+			return
+		}
+	}
+	h.s.policyVersion.Store(change.Version)
+	h.s.syncMu.Unlock()
+}
+
+// WatchCasbinPolicies 在后台监听casbin策略变更消息，并定期全量同步策略兜底
+// 调用Close时停止
+func (s *ServiceContext) WatchCasbinPolicies() {
+	interval := s.Config.Security.PolicyResyncInterval
+	if interval <= 0 {
+		interval = DefaultPolicyResyncInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopWatch = cancel
+
+	handler := policySyncHandler{s: s}
+	if err := s.watcher.Start(ctx, handler); err != nil {
+		logx.Errorw("启动策略变更监听失败", logx.Field(errors.ErrKey, err))
+	}
+	logx.Infow(
+		"开始监听casbin策略变更消息",
+		logx.Field("watcher", s.Config.Security.PolicyWatcher),
+		logx.Field("resync_interval", interval.String()),
+	)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if health := s.watcher.Health(); !health.Connected {
+					logx.Errorw(
+						"策略变更监听未连接",
+						logx.Field("reconnects", health.Reconnects),
+						logx.Field("last_error", health.LastError),
+						logx.Field("last_error_at", health.LastErrorAt),
+					)
+				}
+				handler.OnResync("periodic")
+			}
+		}
+	}()
+}
+
+// NotifyPolicyChange 将本实例尚未发布的策略修改事件发布给其他实例
+// 发布失败时事件不会重发，其他实例将通过版本号缺口或定期同步恢复一致
+func (s *ServiceContext) NotifyPolicyChange() error {
+	s.publishMu.Lock()
+	defer s.publishMu.Unlock()
+
+	events := s.enforcer.DrainEvents()
+	if len(events) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	version, err := s.watcher.Publish(ctx, auth.PolicyChange{
+		Instance: s.instanceID,
+		Events:   events,
+	})
+	if err != nil {
+		logx.Errorw("发送策略变更消息失败", logx.Field(errors.ErrKey, err))
+		return err
+	}
+
+	logx.Infow("策略变更消息发送成功", logx.Field("version", version), logx.Field("events", len(events)))
+	return nil
+}
@@ -0,0 +1,116 @@
+package svc
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gz-dango/pkg/auth"
+)
+
+// countingLoader 记录全量加载的次数
+type countingLoader struct {
+	loads atomic.Int32
+}
+
+func (l *countingLoader) LoadRules(ctx context.Context) ([][]string, [][]string, error) {
+	l.loads.Add(1)
+	return nil, nil, nil
+}
+
+func newPolicySyncTestContext(t *testing.T, bus *auth.MemoryPolicyBus) (*ServiceContext, *countingLoader) {
+	t.Helper()
+	loader := &countingLoader{}
+	s := &ServiceContext{
+		enforcer:   newTestEnforcer(t),
+		adapter:    loader,
+		watcher:    auth.NewMemoryPolicyWatcher(bus),
+		instanceID: "self",
+	}
+	if err := s.RefreshPolicies(); err != nil {
+		t.Fatal(err)
+	}
+	return s, loader
+}
+
+func publishChange(t *testing.T, bus *auth.MemoryPolicyBus, events ...auth.PolicyEvent) auth.PolicyChange {
+	t.Helper()
+	change := auth.PolicyChange{Instance: "other", Events: events}
+	version, err := auth.NewMemoryPolicyWatcher(bus).Publish(context.Background(), change)
+	if err != nil {
+		t.Fatal(err)
+	}
+	change.Version = version
+	return change
+}
+
+func TestPolicySyncAppliesConsecutiveChange(t *testing.T) {
+	bus := auth.NewMemoryPolicyBus()
+	s, loader := newPolicySyncTestContext(t, bus)
+	h := policySyncHandler{s: s}
+
+	rule := auth.PolicyRule("role_1", auth.PlatformDomain, "/api/v1/user", "GET", auth.MatchExact, auth.EffectAllow, "")
+	h.OnChange(publishChange(t, bus, auth.PolicyEvent{Op: auth.PolicyOpAddPolicy, Rules: [][]string{rule}}))
+
+	if got := loader.loads.Load(); got != 1 {
+		t.Fatalf("loads = %d, want 1 (no full reload)", got)
+	}
+	if got := s.policyVersion.Load(); got != 1 {
+		t.Fatalf("policy version = %d, want 1", got)
+	}
+	if ok, err := s.enforcer.Authorization("role_1", auth.PlatformDomain, "/api/v1/user", "GET", nil); err != nil || !ok {
+		t.Fatalf("event not applied: ok=%v err=%v", ok, err)
+	}
+
+	// 已应用过的版本被忽略
+	h.OnChange(auth.PolicyChange{Version: 1, Instance: "other"})
+	if got := loader.loads.Load(); got != 1 {
+		t.Fatalf("loads = %d after duplicate, want 1", got)
+	}
+}
+
+func TestPolicySyncReloadsOnVersionGap(t *testing.T) {
+	bus := auth.NewMemoryPolicyBus()
+	s, loader := newPolicySyncTestContext(t, bus)
+	h := policySyncHandler{s: s}
+
+	publishChange(t, bus) // 版本1的消息丢失
+	h.OnChange(publishChange(t, bus))
+
+	if got := loader.loads.Load(); got != 2 {
+		t.Fatalf("loads = %d, want 2 (full reload on gap)", got)
+	}
+	if got := s.policyVersion.Load(); got != 2 {
+		t.Fatalf("policy version = %d, want 2", got)
+	}
+}
+
+func TestPolicySyncReloadsAfterReconnect(t *testing.T) {
+	bus := auth.NewMemoryPolicyBus()
+	s, loader := newPolicySyncTestContext(t, bus)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := s.watcher.Start(ctx, policySyncHandler{s: s}); err != nil {
+		t.Fatal(err)
+	}
+	defer s.watcher.Stop()
+	waitFor(t, func() bool { return s.watcher.Health().Connected })
+
+	bus.Disconnect()
+	waitFor(t, func() bool { return loader.loads.Load() == 2 })
+	if health := s.watcher.Health(); health.Reconnects != 1 {
+		t.Fatalf("reconnects = %d, want 1", health.Reconnects)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before deadline")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
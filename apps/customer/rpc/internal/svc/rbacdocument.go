@@ -0,0 +1,262 @@
+package svc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RbacDocumentVersion 声明式权限文档的格式版本
+const RbacDocumentVersion = 1
+
+// 声明式权限文档的序列化格式
+const (
+	RbacFormatYAML = "yaml"
+	RbacFormatJSON = "json"
+)
+
+// RbacDocument 声明式的权限、菜单、按钮和角色数据，用于在不同环境之间导出和导入
+// 数据之间使用自然键引用：权限为 "请求方法 URL"，菜单、按钮和角色为名称
+type RbacDocument struct {
+	Version     int              `json:"version" yaml:"version"`
+	Permissions []RbacPermission `json:"permissions" yaml:"permissions"`
+	Menus       []RbacMenu       `json:"menus" yaml:"menus"`
+	Buttons     []RbacButton     `json:"buttons" yaml:"buttons"`
+	Roles       []RbacRole       `json:"roles" yaml:"roles"`
+}
+
+type RbacPermission struct {
+	Url        string           `json:"url" yaml:"url"`
+	Method     string           `json:"method" yaml:"method"`
+	Matcher    string           `json:"matcher,omitempty" yaml:"matcher,omitempty"`
+	Effect     string           `json:"effect,omitempty" yaml:"effect,omitempty"`
+	Conditions *auth.Conditions `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Label      string           `json:"label,omitempty" yaml:"label,omitempty"`
+	Descr      string           `json:"descr,omitempty" yaml:"descr,omitempty"`
+}
+
+// Key 权限的自然键
+func (p RbacPermission) Key() string {
+	return PermissionKey(p.Method, p.Url)
+}
+
+type RbacMenu struct {
+	Name         string      `json:"name" yaml:"name"`
+	Path         string      `json:"path" yaml:"path"`
+	Component    string      `json:"component" yaml:"component"`
+	Meta         models.Meta `json:"meta" yaml:"meta"`
+	Label        string      `json:"label,omitempty" yaml:"label,omitempty"`
+	ArrangeOrder uint32      `json:"arrange_order" yaml:"arrange_order"`
+	IsActive     bool        `json:"is_active" yaml:"is_active"`
+	Descr        string      `json:"descr,omitempty" yaml:"descr,omitempty"`
+	Parent       string      `json:"parent,omitempty" yaml:"parent,omitempty"`           // 父级菜单名称
+	Permissions  []string    `json:"permissions,omitempty" yaml:"permissions,omitempty"` // 权限的自然键
+}
+
+type RbacButton struct {
+	Name         string   `json:"name" yaml:"name"`
+	Code         string   `json:"code,omitempty" yaml:"code,omitempty"` // 权限标识，同一菜单下唯一
+	Menu         string   `json:"menu" yaml:"menu"`                     // 所属菜单名称
+	ArrangeOrder uint32   `json:"arrange_order" yaml:"arrange_order"`
+	IsActive     bool     `json:"is_active" yaml:"is_active"`
+	Descr        string   `json:"descr,omitempty" yaml:"descr,omitempty"`
+	Permissions  []string `json:"permissions,omitempty" yaml:"permissions,omitempty"` // 权限的自然键
+}
+
+// RbacRole 角色，自定义数据范围的部门与环境相关，不在文档中描述，导入时保持原有设置
+type RbacRole struct {
+	Name            string           `json:"name" yaml:"name"`
+	Descr           string           `json:"descr,omitempty" yaml:"descr,omitempty"`
+	DataScope       string           `json:"data_scope,omitempty" yaml:"data_scope,omitempty"`
+	Conditions      *auth.Conditions `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Permissions     []string         `json:"permissions,omitempty" yaml:"permissions,omitempty"`           // 权限的自然键
+	DenyPermissions []string         `json:"deny_permissions,omitempty" yaml:"deny_permissions,omitempty"` // 拒绝的权限的自然键
+	Menus           []string         `json:"menus,omitempty" yaml:"menus,omitempty"`                       // 菜单名称
+	Buttons         []string         `json:"buttons,omitempty" yaml:"buttons,omitempty"`                   // 按钮名称
+	Parents         []string         `json:"parents,omitempty" yaml:"parents,omitempty"`                   // 父级角色名称
+}
+
+// PermissionKey 权限的自然键：请求方法和URL
+func PermissionKey(method, url string) string {
+	return method + " " + url
+}
+
+// RbacDocumentError 声明式权限文档的内容无效
+type RbacDocumentError struct {
+	Kind   string // 数据类型，文档本身的错误为空
+	Key    string // 自然键
+	Reason string
+}
+
+func (e *RbacDocumentError) Error() string {
+	if e.Kind == "" {
+		return e.Reason
+	}
+	return fmt.Sprintf("%s %q: %s", e.Kind, e.Key, e.Reason)
+}
+
+// MarshalRbacDocument 按指定格式序列化文档，格式为空时使用YAML
+func MarshalRbacDocument(doc *RbacDocument, format string) ([]byte, error) {
+	switch format {
+	case "", RbacFormatYAML:
+		return yaml.Marshal(doc)
+	case RbacFormatJSON:
+		return json.MarshalIndent(doc, "", "  ")
+	default:
+		return nil, &RbacDocumentError{Reason: "unsupported format: " + format}
+	}
+}
+
+// UnmarshalRbacDocument 按指定格式解析并校验文档，格式为空时使用YAML，不允许出现未知字段
+func UnmarshalRbacDocument(data []byte, format string) (*RbacDocument, error) {
+	var doc RbacDocument
+	switch format {
+	case "", RbacFormatYAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&doc); err != nil {
+			return nil, &RbacDocumentError{Reason: err.Error()}
+		}
+	case RbacFormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&doc); err != nil {
+			return nil, &RbacDocumentError{Reason: err.Error()}
+		}
+	default:
+		return nil, &RbacDocumentError{Reason: "unsupported format: " + format}
+	}
+	if err := doc.validate(); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// validate 校验文档中各项数据的字段和自然键的唯一性，引用的数据是否存在在导入时校验
+func (d *RbacDocument) validate() error {
+	if d.Version > RbacDocumentVersion {
+		return &RbacDocumentError{Reason: fmt.Sprintf("unsupported version: %d", d.Version)}
+	}
+	perms := make(map[string]bool, len(d.Permissions))
+	for _, p := range d.Permissions {
+		key := p.Key()
+		if perms[key] {
+			return &RbacDocumentError{Kind: SubjectPermission, Key: key, Reason: "duplicate key"}
+		}
+		perms[key] = true
+		if err := auth.ValidatePattern(p.matcher(), p.Url, p.Method); err != nil {
+			return &RbacDocumentError{Kind: SubjectPermission, Key: key, Reason: err.Error()}
+		}
+		if !auth.IsValidEffect(p.Effect) {
+			return &RbacDocumentError{Kind: SubjectPermission, Key: key, Reason: "unsupported effect: " + p.Effect}
+		}
+		if _, err := encodeRbacConditions(p.Conditions); err != nil {
+			return &RbacDocumentError{Kind: SubjectPermission, Key: key, Reason: err.Error()}
+		}
+	}
+	menus := make(map[string]bool, len(d.Menus))
+	paths := make(map[string]bool, len(d.Menus))
+	for _, m := range d.Menus {
+		switch {
+		case m.Name == "" || m.Path == "":
+			return &RbacDocumentError{Kind: SubjectMenu, Key: m.Name, Reason: "name and path are required"}
+		case menus[m.Name]:
+			return &RbacDocumentError{Kind: SubjectMenu, Key: m.Name, Reason: "duplicate name"}
+		case paths[m.Path]:
+			return &RbacDocumentError{Kind: SubjectMenu, Key: m.Name, Reason: "duplicate path: " + m.Path}
+		case m.Parent == m.Name:
+			return &RbacDocumentError{Kind: SubjectMenu, Key: m.Name, Reason: "menu cannot be its own parent"}
+		}
+		if err := m.Meta.Validate(); err != nil {
+			return &RbacDocumentError{Kind: SubjectMenu, Key: m.Name, Reason: err.Error()}
+		}
+		menus[m.Name] = true
+		paths[m.Path] = true
+	}
+	buttons := make(map[string]bool, len(d.Buttons))
+	codes := make(map[string]bool, len(d.Buttons))
+	for _, b := range d.Buttons {
+		code := b.Menu + " " + b.Code
+		switch {
+		case b.Name == "" || b.Menu == "":
+			return &RbacDocumentError{Kind: SubjectButton, Key: b.Name, Reason: "name and menu are required"}
+		case buttons[b.Name]:
+			return &RbacDocumentError{Kind: SubjectButton, Key: b.Name, Reason: "duplicate name"}
+		case !models.ValidButtonCode(b.Code):
+			return &RbacDocumentError{Kind: SubjectButton, Key: b.Name, Reason: "invalid code: " + b.Code}
+		case b.Code != "" && codes[code]:
+			return &RbacDocumentError{Kind: SubjectButton, Key: b.Name, Reason: "duplicate code in menu: " + b.Code}
+		}
+		buttons[b.Name] = true
+		codes[code] = true
+	}
+	roles := make(map[string]bool, len(d.Roles))
+	for _, r := range d.Roles {
+		switch {
+		case r.Name == "":
+			return &RbacDocumentError{Kind: SubjectRole, Key: r.Name, Reason: "name is required"}
+		case roles[r.Name]:
+			return &RbacDocumentError{Kind: SubjectRole, Key: r.Name, Reason: "duplicate name"}
+		case !database.IsValidDataScope(r.dataScope()):
+			return &RbacDocumentError{Kind: SubjectRole, Key: r.Name, Reason: "unsupported data scope: " + r.DataScope}
+		}
+		cond, err := encodeRbacConditions(r.Conditions)
+		if err != nil {
+			return &RbacDocumentError{Kind: SubjectRole, Key: r.Name, Reason: err.Error()}
+		}
+		if cond != "" && (len(r.Menus) > 0 || len(r.Buttons) > 0 || len(r.Parents) > 0) {
+			return &RbacDocumentError{Kind: SubjectRole, Key: r.Name, Reason: "conditions cannot be combined with menus, buttons or parents"}
+		}
+		roles[r.Name] = true
+	}
+	return nil
+}
+
+// matcher 未指定匹配方式时完全匹配
+func (p RbacPermission) matcher() string {
+	if p.Matcher == "" {
+		return auth.MatchExact
+	}
+	return p.Matcher
+}
+
+// effect 未指定效果时允许访问
+func (p RbacPermission) effect() string {
+	if p.Effect == "" {
+		return auth.EffectAllow
+	}
+	return p.Effect
+}
+
+// dataScope 未指定数据范围时为全部数据
+func (r RbacRole) dataScope() string {
+	if r.DataScope == "" {
+		return database.DataScopeAll
+	}
+	return r.DataScope
+}
+
+func encodeRbacConditions(c *auth.Conditions) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return auth.EncodeConditions(*c)
+}
+
+// decodeRbacConditions 业务表中的条件转换为文档中的条件，未设置条件时为nil
+func decodeRbacConditions(s string) (*auth.Conditions, error) {
+	if s == "" {
+		return nil, nil
+	}
+	c, err := auth.DecodeConditions(s)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
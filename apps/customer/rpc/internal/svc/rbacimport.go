@@ -0,0 +1,1001 @@
+package svc
+
+import (
+	"context"
+	stderrors "errors"
+	"slices"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 导入声明式权限文档产生的变更类型
+const (
+	RbacActionCreate = "create"
+	RbacActionUpdate = "update"
+	RbacActionDelete = "delete"
+	RbacActionSkip   = "skip" // 清理时未删除的数据，如仍被用户持有的角色
+)
+
+// errRbacDryRun 试运行时回滚事务
+var errRbacDryRun = stderrors.New("rbac import dry run")
+
+// RbacChange 导入声明式权限文档产生的一项变更
+type RbacChange struct {
+	Kind   string   // 数据类型
+	Key    string   // 自然键
+	Action string   // 变更类型
+	Fields []string // 更新时发生变化的字段
+	Reason string   // 跳过的原因
+}
+
+// RbacImportResult 导入声明式权限文档的结果
+type RbacImportResult struct {
+	Changes []RbacChange
+
+	// 以下为提交后需要重建策略的数据
+	permIds        []uint32
+	menuIds        []uint32
+	buttonIds      []uint32
+	roleIds        []uint32
+	removedPerms   []models.PermissionModel
+	removedMenus   []models.MenuModel
+	removedButtons []models.ButtonModel
+	removedRoles   []models.RoleModel
+}
+
+// rbacTenant 声明式权限文档所属的租户，内部调用和平台工作人员为平台级数据
+func rbacTenant(ctx context.Context) uint32 {
+	if tid, ok := auth.TenantFromContext(ctx); ok {
+		return tid
+	}
+	return database.PlatformTenantId
+}
+
+// rbacSnapshot 租户的权限、菜单、按钮和角色及其关联数据
+type rbacSnapshot struct {
+	perms   []models.PermissionModel
+	menus   []models.MenuModel
+	buttons []models.ButtonModel
+	roles   []models.RoleModel
+}
+
+// loadRbacSnapshot 查询属于该租户的数据，不包括租户可见的平台级数据
+func loadRbacSnapshot(db *gorm.DB, tenantId uint32) (*rbacSnapshot, error) {
+	var snap rbacSnapshot
+	if err := db.Where("tenant_id = ?", tenantId).Order("id").Find(&snap.perms).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Preload("Parent").Preload("Permissions").
+		Where("tenant_id = ?", tenantId).Order("id").Find(&snap.menus).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Preload("Menu").Preload("Permissions").
+		Where("tenant_id = ?", tenantId).Order("id").Find(&snap.buttons).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Preload("Permissions").Preload("DenyPermissions").Preload("Menus").Preload("Buttons").Preload("Parents").
+		Where("tenant_id = ?", tenantId).Order("id").Find(&snap.roles).Error; err != nil {
+		return nil, err
+	}
+	return &snap, nil
+}
+
+// ExportRbac 导出调用方租户的权限、菜单、按钮和角色，内部调用和平台工作人员导出平台级数据
+// 租户数据引用的平台级数据只以自然键出现，不包含其内容
+func (s *ServiceContext) ExportRbac(ctx context.Context) (*RbacDocument, error) {
+	tenantId := rbacTenant(ctx)
+	snap, err := loadRbacSnapshot(s.db.WithContext(ctx), tenantId)
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询导出的权限数据失败",
+			logx.Field(database.TenantKey, tenantId),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	doc := &RbacDocument{
+		Version:     RbacDocumentVersion,
+		Permissions: make([]RbacPermission, 0, len(snap.perms)),
+		Menus:       make([]RbacMenu, 0, len(snap.menus)),
+		Buttons:     make([]RbacButton, 0, len(snap.buttons)),
+		Roles:       make([]RbacRole, 0, len(snap.roles)),
+	}
+	for _, m := range snap.perms {
+		cond, err := decodeRbacConditions(m.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		doc.Permissions = append(doc.Permissions, RbacPermission{
+			Url:        m.Url,
+			Method:     m.Method,
+			Matcher:    m.Matcher,
+			Effect:     m.Effect,
+			Conditions: cond,
+			Label:      m.Label,
+			Descr:      m.Descr,
+		})
+	}
+	for _, m := range menuTreeOrder(snap.menus) {
+		doc.Menus = append(doc.Menus, RbacMenu{
+			Name:         m.Name,
+			Path:         m.Path,
+			Component:    m.Component,
+			Meta:         m.Meta,
+			Label:        m.Label,
+			ArrangeOrder: m.ArrangeOrder,
+			IsActive:     m.IsActive,
+			Descr:        m.Descr,
+			Parent:       menuParentName(m),
+			Permissions:  permissionKeys(m.Permissions),
+		})
+	}
+	for _, m := range snap.buttons {
+		doc.Buttons = append(doc.Buttons, RbacButton{
+			Name:         m.Name,
+			Code:         m.Code,
+			Menu:         m.Menu.Name,
+			ArrangeOrder: m.ArrangeOrder,
+			IsActive:     m.IsActive,
+			Descr:        m.Descr,
+			Permissions:  permissionKeys(m.Permissions),
+		})
+	}
+	for _, m := range snap.roles {
+		cond, err := decodeRbacConditions(m.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		doc.Roles = append(doc.Roles, RbacRole{
+			Name:            m.Name,
+			Descr:           m.Descr,
+			DataScope:       m.DataScope,
+			Conditions:      cond,
+			Permissions:     permissionKeys(m.Permissions),
+			DenyPermissions: permissionKeys(m.DenyPermissions),
+			Menus:           menuNames(m.Menus),
+			Buttons:         buttonNames(m.Buttons),
+			Parents:         roleNames(m.Parents),
+		})
+	}
+	return doc, nil
+}
+
+// menuTreeOrder 按父级在前的顺序排列菜单，便于阅读导出的菜单树
+func menuTreeOrder(ms []models.MenuModel) []models.MenuModel {
+	children := make(map[uint32][]models.MenuModel)
+	ids := make(map[uint32]bool, len(ms))
+	for _, m := range ms {
+		ids[m.Id] = true
+	}
+	roots := make([]models.MenuModel, 0)
+	for _, m := range ms {
+		if m.ParentId != nil && ids[*m.ParentId] && *m.ParentId != m.Id {
+			children[*m.ParentId] = append(children[*m.ParentId], m)
+			continue
+		}
+		roots = append(roots, m)
+	}
+	ordered := make([]models.MenuModel, 0, len(ms))
+	visited := make(map[uint32]bool, len(ms))
+	var walk func(m models.MenuModel)
+	walk = func(m models.MenuModel) {
+		if visited[m.Id] {
+			return
+		}
+		visited[m.Id] = true
+		ordered = append(ordered, m)
+		for _, c := range children[m.Id] {
+			walk(c)
+		}
+	}
+	for _, m := range roots {
+		walk(m)
+	}
+	// 存在继承环时环上的菜单不可达，追加到末尾
+	for _, m := range ms {
+		walk(m)
+	}
+	return ordered
+}
+
+// ImportRbac 在一个事务中将声明式权限文档应用到调用方租户，内部调用和平台工作人员导入平台级数据
+// 按自然键新增或更新数据，关联关系以文档为准；prune为true时删除租户中文档未描述的数据
+// dryRun为true时回滚事务，只返回将产生的变更
+// 导入提交后需调用 ResetImportedPolicies 重建策略
+func (s *ServiceContext) ImportRbac(
+	ctx context.Context,
+	doc *RbacDocument,
+	dryRun bool,
+	prune bool,
+) (*RbacImportResult, error) {
+	tenantId := rbacTenant(ctx)
+	var res *RbacImportResult
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		im, err := newRbacImporter(ctx, tx, tenantId, prune)
+		if err != nil {
+			return err
+		}
+		if err := im.apply(doc); err != nil {
+			return err
+		}
+		res = im.res
+		if dryRun {
+			return errRbacDryRun
+		}
+		return nil
+	})
+	if err != nil && !(dryRun && stderrors.Is(err, errRbacDryRun)) {
+		var de *RbacDocumentError
+		if !stderrors.As(err, &de) {
+			logx.WithContext(ctx).Errorw(
+				"导入声明式权限文档失败",
+				logx.Field(database.TenantKey, tenantId),
+				logx.Field(errors.ErrKey, err),
+			)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// ResetImportedPolicies 按导入后的业务表重建受影响数据的策略
+func (s *ServiceContext) ResetImportedPolicies(ctx context.Context, res *RbacImportResult) error {
+	for _, m := range res.removedRoles {
+		if err := s.Role.RemoveGroupPolicy(ctx, m, true); err != nil {
+			return err
+		}
+	}
+	for _, m := range res.removedButtons {
+		if err := s.Button.RemoveGroupPolicy(ctx, m, true); err != nil {
+			return err
+		}
+	}
+	for _, m := range res.removedMenus {
+		if err := s.Menu.RemoveGroupPolicy(ctx, m, true); err != nil {
+			return err
+		}
+	}
+	for _, m := range res.removedPerms {
+		if err := s.Perm.RemovePolicy(ctx, m, true); err != nil {
+			return err
+		}
+	}
+	pms, err := s.Perm.ListModelByIds(ctx, res.permIds)
+	if err != nil {
+		return err
+	}
+	for _, m := range pms {
+		if err := s.Perm.RemovePolicy(ctx, m, false); err != nil {
+			return err
+		}
+		if err := s.Perm.AddPolicy(ctx, m); err != nil {
+			return err
+		}
+	}
+	if len(res.menuIds) > 0 {
+		qp := database.NewPksQueryParams(res.menuIds)
+		qp.Preloads = []string{"Parent", "Permissions"}
+		_, mms, err := s.Menu.ListModel(ctx, qp)
+		if err != nil {
+			return err
+		}
+		for _, m := range mms {
+			if err := s.Menu.RemoveGroupPolicy(ctx, m, false); err != nil {
+				return err
+			}
+			if err := s.Menu.AddGroupPolicy(ctx, m); err != nil {
+				return err
+			}
+		}
+	}
+	if len(res.buttonIds) > 0 {
+		qp := database.NewPksQueryParams(res.buttonIds)
+		qp.Preloads = []string{"Menu", "Permissions"}
+		_, bms, err := s.Button.ListModel(ctx, qp)
+		if err != nil {
+			return err
+		}
+		for _, m := range bms {
+			if err := s.Button.RemoveGroupPolicy(ctx, m, false); err != nil {
+				return err
+			}
+			if err := s.Button.AddGroupPolicy(ctx, m); err != nil {
+				return err
+			}
+		}
+	}
+	return s.Role.ResetRolePolicies(ctx, res.roleIds)
+}
+
+// rbacImporter 在事务中按自然键比对并写入声明式权限文档
+type rbacImporter struct {
+	ctx      context.Context
+	tx       *gorm.DB
+	tenantId uint32
+	prune    bool
+	now      time.Time
+	snap     *rbacSnapshot
+	// 自然键到ID的映射，包括租户可引用的平台级数据、未被清理的已有数据和文档中的数据
+	permIds   map[string]uint32
+	menuIds   map[string]uint32
+	buttonIds map[string]uint32
+	roleIds   map[string]uint32
+	res       *RbacImportResult
+}
+
+func newRbacImporter(ctx context.Context, tx *gorm.DB, tenantId uint32, prune bool) (*rbacImporter, error) {
+	snap, err := loadRbacSnapshot(tx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	im := &rbacImporter{
+		ctx:       ctx,
+		tx:        tx,
+		tenantId:  tenantId,
+		prune:     prune,
+		now:       time.Now(),
+		snap:      snap,
+		permIds:   make(map[string]uint32),
+		menuIds:   make(map[string]uint32),
+		buttonIds: make(map[string]uint32),
+		roleIds:   make(map[string]uint32),
+		res:       &RbacImportResult{Changes: make([]RbacChange, 0)},
+	}
+	if tenantId != database.PlatformTenantId {
+		// 租户数据可以引用平台级的权限、菜单和按钮，同名时租户数据优先
+		shared, err := loadRbacSnapshot(tx, database.PlatformTenantId)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range shared.perms {
+			im.permIds[permissionKey(m)] = m.Id
+		}
+		for _, m := range shared.menus {
+			im.menuIds[m.Name] = m.Id
+		}
+		for _, m := range shared.buttons {
+			im.buttonIds[m.Name] = m.Id
+		}
+	}
+	if !prune {
+		for _, m := range snap.perms {
+			im.permIds[permissionKey(m)] = m.Id
+		}
+		for _, m := range snap.menus {
+			im.menuIds[m.Name] = m.Id
+		}
+		for _, m := range snap.buttons {
+			im.buttonIds[m.Name] = m.Id
+		}
+		for _, m := range snap.roles {
+			im.roleIds[m.Name] = m.Id
+		}
+	}
+	return im, nil
+}
+
+func (im *rbacImporter) apply(doc *RbacDocument) error {
+	if err := im.applyPermissions(doc.Permissions); err != nil {
+		return err
+	}
+	if err := im.applyMenus(doc.Menus); err != nil {
+		return err
+	}
+	if err := im.applyButtons(doc.Buttons); err != nil {
+		return err
+	}
+	if err := im.applyRoles(doc.Roles); err != nil {
+		return err
+	}
+	// 自有 p 规则引用了变更权限的角色需要重建策略
+	roleIds, err := listPolicyRoleIds(im.ctx, im.tx, im.res.permIds)
+	if err != nil {
+		return err
+	}
+	// 最后清理，文档中的子菜单和按钮已离开待删除的菜单，不会被级联删除
+	if im.prune {
+		ids, err := im.pruneRemoved(doc)
+		if err != nil {
+			return err
+		}
+		roleIds = append(roleIds, ids...)
+	}
+	im.res.roleIds = slices.Compact(slices.Sorted(slices.Values(slices.Concat(im.res.roleIds, roleIds))))
+	return nil
+}
+
+func (im *rbacImporter) record(kind, key, action string, fields ...string) {
+	im.res.Changes = append(im.res.Changes, RbacChange{Kind: kind, Key: key, Action: action, Fields: fields})
+}
+
+// update 更新数据的字段，data为空时不执行
+func (im *rbacImporter) update(model any, id uint32, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	data["updated_at"] = im.now
+	return im.tx.Model(model).Where("id = ?", id).Updates(data).Error
+}
+
+// replace 替换数据的关联关系
+func (im *rbacImporter) replace(model any, assoc string, values any) error {
+	return im.tx.Model(model).Association(assoc).Replace(values)
+}
+
+func (im *rbacImporter) applyPermissions(items []RbacPermission) error {
+	existing := make(map[string]models.PermissionModel, len(im.snap.perms))
+	for _, m := range im.snap.perms {
+		if _, ok := existing[permissionKey(m)]; !ok {
+			existing[permissionKey(m)] = m
+		}
+	}
+	for _, p := range items {
+		key := p.Key()
+		cond, err := encodeRbacConditions(p.Conditions)
+		if err != nil {
+			return &RbacDocumentError{Kind: SubjectPermission, Key: key, Reason: err.Error()}
+		}
+		om, ok := existing[key]
+		if !ok {
+			m := models.PermissionModel{
+				TenantId:   im.tenantId,
+				Url:        p.Url,
+				Method:     p.Method,
+				Matcher:    p.matcher(),
+				Effect:     p.effect(),
+				Conditions: cond,
+				Label:      p.Label,
+				Descr:      p.Descr,
+			}
+			m.CreatedAt, m.UpdatedAt = im.now, im.now
+			if err := im.tx.Create(&m).Error; err != nil {
+				return err
+			}
+			im.permIds[key] = m.Id
+			im.res.permIds = append(im.res.permIds, m.Id)
+			im.record(SubjectPermission, key, RbacActionCreate)
+			continue
+		}
+		im.permIds[key] = om.Id
+		data, fields := diffFields(
+			[]string{"matcher", "effect", "conditions", "label", "descr"},
+			[]any{om.Matcher, om.Effect, om.Conditions, om.Label, om.Descr},
+			[]any{p.matcher(), p.effect(), cond, p.Label, p.Descr},
+		)
+		if len(fields) == 0 {
+			continue
+		}
+		if err := im.update(&models.PermissionModel{}, om.Id, data); err != nil {
+			return err
+		}
+		im.res.permIds = append(im.res.permIds, om.Id)
+		im.record(SubjectPermission, key, RbacActionUpdate, fields...)
+	}
+	return nil
+}
+
+func (im *rbacImporter) applyMenus(items []RbacMenu) error {
+	existing := make(map[string]models.MenuModel, len(im.snap.menus))
+	for _, m := range im.snap.menus {
+		existing[m.Name] = m
+	}
+	// 先写入所有菜单，再设置父级和权限，文档中子菜单可以出现在父级之前
+	for _, d := range items {
+		om, ok := existing[d.Name]
+		if !ok {
+			m := models.MenuModel{
+				TenantId:     im.tenantId,
+				Path:         d.Path,
+				Component:    d.Component,
+				Name:         d.Name,
+				Meta:         d.Meta,
+				Label:        d.Label,
+				ArrangeOrder: d.ArrangeOrder,
+				IsActive:     d.IsActive,
+				Descr:        d.Descr,
+			}
+			m.CreatedAt, m.UpdatedAt = im.now, im.now
+			if err := im.tx.Omit("Parent", "Permissions").Create(&m).Error; err != nil {
+				return err
+			}
+			im.menuIds[d.Name] = m.Id
+			continue
+		}
+		im.menuIds[d.Name] = om.Id
+	}
+	for _, d := range items {
+		id := im.menuIds[d.Name]
+		var parentId *uint32
+		if d.Parent != "" {
+			pid, ok := im.menuIds[d.Parent]
+			if !ok {
+				return &RbacDocumentError{Kind: SubjectMenu, Key: d.Name, Reason: "unknown parent menu: " + d.Parent}
+			}
+			parentId = &pid
+		}
+		pms, err := im.permissionRefs(SubjectMenu, d.Name, d.Permissions)
+		if err != nil {
+			return err
+		}
+		om, ok := existing[d.Name]
+		if !ok {
+			if err := im.update(&models.MenuModel{}, id, map[string]any{"parent_id": parentId}); err != nil {
+				return err
+			}
+			if err := im.replace(&models.MenuModel{StandardModel: standardModel(id)}, "Permissions", pms); err != nil {
+				return err
+			}
+			im.res.menuIds = append(im.res.menuIds, id)
+			im.record(SubjectMenu, d.Name, RbacActionCreate)
+			continue
+		}
+		data, fields := diffFields(
+			[]string{"path", "component", "meta", "label", "arrange_order", "is_active", "descr"},
+			// meta 以JSON保存，按JSON比较
+			[]any{om.Path, om.Component, om.Meta.Json(), om.Label, om.ArrangeOrder, om.IsActive, om.Descr},
+			[]any{d.Path, d.Component, d.Meta.Json(), d.Label, d.ArrangeOrder, d.IsActive, d.Descr},
+		)
+		if menuParentName(om) != d.Parent {
+			data["parent_id"] = parentId
+			fields = append(fields, "parent")
+		}
+		if err := im.update(&models.MenuModel{}, id, data); err != nil {
+			return err
+		}
+		if !sameKeys(permissionKeys(om.Permissions), d.Permissions) {
+			if err := im.replace(&om, "Permissions", pms); err != nil {
+				return err
+			}
+			fields = append(fields, "permissions")
+		}
+		if len(fields) > 0 {
+			im.res.menuIds = append(im.res.menuIds, id)
+			im.record(SubjectMenu, d.Name, RbacActionUpdate, fields...)
+		}
+	}
+	return im.checkMenuCycle(items)
+}
+
+func (im *rbacImporter) applyButtons(items []RbacButton) error {
+	existing := make(map[string]models.ButtonModel, len(im.snap.buttons))
+	for _, m := range im.snap.buttons {
+		existing[m.Name] = m
+	}
+	for _, d := range items {
+		menuId, ok := im.menuIds[d.Menu]
+		if !ok {
+			return &RbacDocumentError{Kind: SubjectButton, Key: d.Name, Reason: "unknown menu: " + d.Menu}
+		}
+		pms, err := im.permissionRefs(SubjectButton, d.Name, d.Permissions)
+		if err != nil {
+			return err
+		}
+		om, ok := existing[d.Name]
+		if !ok {
+			m := models.ButtonModel{
+				TenantId:     im.tenantId,
+				Name:         d.Name,
+				Code:         d.Code,
+				ArrangeOrder: d.ArrangeOrder,
+				IsActive:     d.IsActive,
+				Descr:        d.Descr,
+				MenuId:       menuId,
+			}
+			m.CreatedAt, m.UpdatedAt = im.now, im.now
+			if err := im.tx.Omit("Menu", "Permissions").Create(&m).Error; err != nil {
+				return err
+			}
+			if err := im.replace(&m, "Permissions", pms); err != nil {
+				return err
+			}
+			im.buttonIds[d.Name] = m.Id
+			im.res.buttonIds = append(im.res.buttonIds, m.Id)
+			im.record(SubjectButton, d.Name, RbacActionCreate)
+			continue
+		}
+		im.buttonIds[d.Name] = om.Id
+		data, fields := diffFields(
+			[]string{"code", "arrange_order", "is_active", "descr"},
+			[]any{om.Code, om.ArrangeOrder, om.IsActive, om.Descr},
+			[]any{d.Code, d.ArrangeOrder, d.IsActive, d.Descr},
+		)
+		if _, ok := data["code"]; ok && d.Code == "" {
+			// 未设置权限标识时保存为NULL
+			data["code"] = nil
+		}
+		if om.Menu.Name != d.Menu {
+			data["menu_id"] = menuId
+			fields = append(fields, "menu")
+		}
+		if err := im.update(&models.ButtonModel{}, om.Id, data); err != nil {
+			return err
+		}
+		if !sameKeys(permissionKeys(om.Permissions), d.Permissions) {
+			if err := im.replace(&om, "Permissions", pms); err != nil {
+				return err
+			}
+			fields = append(fields, "permissions")
+		}
+		if len(fields) > 0 {
+			im.res.buttonIds = append(im.res.buttonIds, om.Id)
+			im.record(SubjectButton, d.Name, RbacActionUpdate, fields...)
+		}
+	}
+	return nil
+}
+
+func (im *rbacImporter) applyRoles(items []RbacRole) error {
+	existing := make(map[string]models.RoleModel, len(im.snap.roles))
+	for _, m := range im.snap.roles {
+		existing[m.Name] = m
+	}
+	// 先写入所有角色，再设置关联关系，文档中子角色可以出现在父级之前
+	for _, d := range items {
+		if om, ok := existing[d.Name]; ok {
+			im.roleIds[d.Name] = om.Id
+			continue
+		}
+		cond, err := encodeRbacConditions(d.Conditions)
+		if err != nil {
+			return &RbacDocumentError{Kind: SubjectRole, Key: d.Name, Reason: err.Error()}
+		}
+		m := models.RoleModel{
+			TenantId:   im.tenantId,
+			Name:       d.Name,
+			Descr:      d.Descr,
+			DataScope:  d.dataScope(),
+			Conditions: cond,
+		}
+		m.CreatedAt, m.UpdatedAt = im.now, im.now
+		if err := im.tx.Omit(
+			"Permissions", "Menus", "Buttons", "Parents", "Depts", "DenyPermissions", "PermissionGrants",
+		).Create(&m).Error; err != nil {
+			return err
+		}
+		im.roleIds[d.Name] = m.Id
+	}
+	for _, d := range items {
+		id := im.roleIds[d.Name]
+		pms, err := im.permissionRefs(SubjectRole, d.Name, d.Permissions)
+		if err != nil {
+			return err
+		}
+		dpms, err := im.permissionRefs(SubjectRole, d.Name, d.DenyPermissions)
+		if err != nil {
+			return err
+		}
+		mms := make([]models.MenuModel, 0, len(d.Menus))
+		for _, name := range d.Menus {
+			mid, ok := im.menuIds[name]
+			if !ok {
+				return &RbacDocumentError{Kind: SubjectRole, Key: d.Name, Reason: "unknown menu: " + name}
+			}
+			mms = append(mms, models.MenuModel{StandardModel: standardModel(mid)})
+		}
+		bms := make([]models.ButtonModel, 0, len(d.Buttons))
+		for _, name := range d.Buttons {
+			bid, ok := im.buttonIds[name]
+			if !ok {
+				return &RbacDocumentError{Kind: SubjectRole, Key: d.Name, Reason: "unknown button: " + name}
+			}
+			bms = append(bms, models.ButtonModel{StandardModel: standardModel(bid)})
+		}
+		parents := make([]models.RoleModel, 0, len(d.Parents))
+		for _, name := range d.Parents {
+			rid, ok := im.roleIds[name]
+			if !ok {
+				return &RbacDocumentError{Kind: SubjectRole, Key: d.Name, Reason: "unknown parent role: " + name}
+			}
+			if rid == id {
+				return &RbacDocumentError{Kind: SubjectRole, Key: d.Name, Reason: "role cannot be its own parent"}
+			}
+			parents = append(parents, models.RoleModel{StandardModel: standardModel(rid)})
+		}
+		om, ok := existing[d.Name]
+		if !ok {
+			m := models.RoleModel{StandardModel: standardModel(id)}
+			assocs := map[string]any{
+				"Permissions":     pms,
+				"DenyPermissions": dpms,
+				"Menus":           mms,
+				"Buttons":         bms,
+				"Parents":         parents,
+			}
+			for assoc, values := range assocs {
+				if err := im.replace(&m, assoc, values); err != nil {
+					return err
+				}
+			}
+			im.res.roleIds = append(im.res.roleIds, id)
+			im.record(SubjectRole, d.Name, RbacActionCreate)
+			continue
+		}
+		cond, err := encodeRbacConditions(d.Conditions)
+		if err != nil {
+			return &RbacDocumentError{Kind: SubjectRole, Key: d.Name, Reason: err.Error()}
+		}
+		data, fields := diffFields(
+			[]string{"descr", "data_scope", "conditions"},
+			[]any{om.Descr, om.DataScope, om.Conditions},
+			[]any{d.Descr, d.dataScope(), cond},
+		)
+		if err := im.update(&models.RoleModel{}, id, data); err != nil {
+			return err
+		}
+		assocs := []struct {
+			field  string
+			name   string
+			old    []string
+			new    []string
+			values any
+		}{
+			{"permissions", "Permissions", permissionKeys(om.Permissions), d.Permissions, pms},
+			{"deny_permissions", "DenyPermissions", permissionKeys(om.DenyPermissions), d.DenyPermissions, dpms},
+			{"menus", "Menus", menuNames(om.Menus), d.Menus, mms},
+			{"buttons", "Buttons", buttonNames(om.Buttons), d.Buttons, bms},
+			{"parents", "Parents", roleNames(om.Parents), d.Parents, parents},
+		}
+		for _, a := range assocs {
+			if sameKeys(a.old, a.new) {
+				continue
+			}
+			if err := im.replace(&om, a.name, a.values); err != nil {
+				return err
+			}
+			fields = append(fields, a.field)
+		}
+		if len(fields) > 0 {
+			im.res.roleIds = append(im.res.roleIds, id)
+			im.record(SubjectRole, d.Name, RbacActionUpdate, fields...)
+		}
+	}
+	return im.checkRoleCycle(items)
+}
+
+// permissionRefs 按自然键查找引用的权限
+func (im *rbacImporter) permissionRefs(kind, owner string, keys []string) ([]models.PermissionModel, error) {
+	pms := make([]models.PermissionModel, 0, len(keys))
+	for _, key := range keys {
+		id, ok := im.permIds[key]
+		if !ok {
+			return nil, &RbacDocumentError{Kind: kind, Key: owner, Reason: "unknown permission: " + key}
+		}
+		pms = append(pms, models.PermissionModel{StandardModel: standardModel(id)})
+	}
+	return pms, nil
+}
+
+// pruneRemoved 删除租户中文档未描述的数据，关联关系随之删除，仍被使用的角色记录为跳过
+// 返回自有 p 规则引用了被删除权限的角色ID
+func (im *rbacImporter) pruneRemoved(doc *RbacDocument) ([]uint32, error) {
+	roles := make(map[string]bool, len(doc.Roles))
+	for _, d := range doc.Roles {
+		roles[d.Name] = true
+	}
+	// 跳过的角色保留，其引用的菜单、按钮和权限可能被清理，需要重建策略
+	skipped := make([]uint32, 0)
+	for _, m := range im.snap.roles {
+		if roles[m.Name] {
+			continue
+		}
+		// 与删除角色的接口一致，仍被用户持有或存在临时授权的角色不删除，以免静默地收回用户的角色
+		inUse, err := roleUsage(im.tx, m.Id)
+		if err != nil {
+			return nil, err
+		}
+		if inUse != nil {
+			skipped = append(skipped, m.Id)
+			im.res.Changes = append(im.res.Changes, RbacChange{
+				Kind:   SubjectRole,
+				Key:    m.Name,
+				Action: RbacActionSkip,
+				Reason: inUse.Error(),
+			})
+			continue
+		}
+		if err := im.tx.Delete(&models.RoleModel{}, m.Id).Error; err != nil {
+			return nil, err
+		}
+		im.res.removedRoles = append(im.res.removedRoles, m)
+		im.record(SubjectRole, m.Name, RbacActionDelete)
+	}
+	buttons := make(map[string]bool, len(doc.Buttons))
+	for _, d := range doc.Buttons {
+		buttons[d.Name] = true
+	}
+	for _, m := range im.snap.buttons {
+		if buttons[m.Name] {
+			continue
+		}
+		if err := im.tx.Delete(&models.ButtonModel{}, m.Id).Error; err != nil {
+			return nil, err
+		}
+		im.res.removedButtons = append(im.res.removedButtons, m)
+		im.record(SubjectButton, m.Name, RbacActionDelete)
+	}
+	menus := make(map[string]bool, len(doc.Menus))
+	for _, d := range doc.Menus {
+		menus[d.Name] = true
+	}
+	for _, m := range im.snap.menus {
+		if menus[m.Name] {
+			continue
+		}
+		if err := im.tx.Delete(&models.MenuModel{}, m.Id).Error; err != nil {
+			return nil, err
+		}
+		im.res.removedMenus = append(im.res.removedMenus, m)
+		im.record(SubjectMenu, m.Name, RbacActionDelete)
+	}
+	perms := make(map[string]bool, len(doc.Permissions))
+	for _, d := range doc.Permissions {
+		perms[d.Key()] = true
+	}
+	removed := make([]models.PermissionModel, 0)
+	for _, m := range im.snap.perms {
+		if !perms[permissionKey(m)] {
+			removed = append(removed, m)
+		}
+	}
+	// 删除权限后关联关系随之删除，需要提前查询
+	permIds := make([]uint32, 0, len(removed))
+	for _, m := range removed {
+		permIds = append(permIds, m.Id)
+	}
+	roleIds, err := listPolicyRoleIds(im.ctx, im.tx, permIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range removed {
+		if err := im.tx.Delete(&models.PermissionModel{}, m.Id).Error; err != nil {
+			return nil, err
+		}
+		im.res.removedPerms = append(im.res.removedPerms, m)
+		im.record(SubjectPermission, permissionKey(m), RbacActionDelete)
+	}
+	return append(roleIds, skipped...), nil
+}
+
+// checkMenuCycle 检查导入后文档中的菜单是否存在父级环
+func (im *rbacImporter) checkMenuCycle(items []RbacMenu) error {
+	var rows []struct {
+		Id       uint32
+		ParentId *uint32
+	}
+	if err := im.tx.Model(&models.MenuModel{}).
+		Where("tenant_id = ?", im.tenantId).
+		Select("id, parent_id").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+	edges := make(map[uint32][]uint32, len(rows))
+	for _, r := range rows {
+		if r.ParentId != nil {
+			edges[r.Id] = []uint32{*r.ParentId}
+		}
+	}
+	for _, d := range items {
+		if inCycle(im.menuIds[d.Name], edges) {
+			return &RbacDocumentError{Kind: SubjectMenu, Key: d.Name, Reason: "menu parents form a cycle"}
+		}
+	}
+	return nil
+}
+
+// checkRoleCycle 检查导入后文档中的角色是否存在继承环
+func (im *rbacImporter) checkRoleCycle(items []RbacRole) error {
+	var rows []struct {
+		RoleId   uint32
+		ParentId uint32
+	}
+	if err := im.tx.Table("customer_role_parent").
+		Select("role_id, parent_id").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+	edges := make(map[uint32][]uint32, len(rows))
+	for _, r := range rows {
+		edges[r.RoleId] = append(edges[r.RoleId], r.ParentId)
+	}
+	for _, d := range items {
+		if inCycle(im.roleIds[d.Name], edges) {
+			return &RbacDocumentError{Kind: SubjectRole, Key: d.Name, Reason: "role parents form a cycle"}
+		}
+	}
+	return nil
+}
+
+// inCycle 判断沿父级关系能否从id回到自身
+func inCycle(id uint32, edges map[uint32][]uint32) bool {
+	visited := make(map[uint32]bool)
+	stack := slices.Clone(edges[id])
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if cur == id {
+			return true
+		}
+		if visited[cur] {
+			continue
+		}
+		visited[cur] = true
+		stack = append(stack, edges[cur]...)
+	}
+	return false
+}
+
+// diffFields 比较字段的原值和新值，返回需要更新的数据和发生变化的字段
+func diffFields(columns []string, olds []any, news []any) (map[string]any, []string) {
+	data := make(map[string]any)
+	fields := make([]string, 0)
+	for i, col := range columns {
+		if olds[i] == news[i] {
+			continue
+		}
+		data[col] = news[i]
+		fields = append(fields, col)
+	}
+	return data, fields
+}
+
+// sameKeys 判断两组自然键是否相同，忽略顺序和重复
+func sameKeys(a, b []string) bool {
+	return slices.Equal(
+		slices.Compact(slices.Sorted(slices.Values(a))),
+		slices.Compact(slices.Sorted(slices.Values(b))),
+	)
+}
+
+func standardModel(id uint32) database.StandardModel {
+	return database.StandardModel{BaseModel: database.BaseModel{Id: id}}
+}
+
+func permissionKey(m models.PermissionModel) string {
+	return PermissionKey(m.Method, m.Url)
+}
+
+func permissionKeys(ms []models.PermissionModel) []string {
+	keys := make([]string, 0, len(ms))
+	for _, m := range ms {
+		keys = append(keys, permissionKey(m))
+	}
+	return keys
+}
+
+func menuParentName(m models.MenuModel) string {
+	if m.Parent == nil {
+		return ""
+	}
+	return m.Parent.Name
+}
+
+func menuNames(ms []models.MenuModel) []string {
+	names := make([]string, 0, len(ms))
+	for _, m := range ms {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func buttonNames(ms []models.ButtonModel) []string {
+	names := make([]string, 0, len(ms))
+	for _, m := range ms {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func roleNames(ms []models.RoleModel) []string {
+	names := make([]string, 0, len(ms))
+	for _, m := range ms {
+		names = append(names, m.Name)
+	}
+	return names
+}
@@ -0,0 +1,116 @@
+package svc
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+)
+
+func TestImportRbacPruneSkipsRolesInUse(t *testing.T) {
+	db := newTestDB(t)
+	s := &ServiceContext{db: db}
+	now := time.Now()
+	perm := models.PermissionModel{Url: "/customer.User/GetCustomer", Method: auth.MethodGrpc, Matcher: auth.MatchExact, Effect: auth.EffectAllow}
+	if err := db.Create(&perm).Error; err != nil {
+		t.Fatal(err)
+	}
+	roles := []models.RoleModel{
+		{Name: "held", Permissions: []models.PermissionModel{perm}},
+		{Name: "granted"},
+		{Name: "unused"},
+		{Name: "kept"},
+	}
+	if err := db.Create(&roles).Error; err != nil {
+		t.Fatal(err)
+	}
+	user := models.UserModel{Username: "u", Password: "x", Roles: roles[:1]}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	grant := models.UserRoleGrantModel{UserId: user.Id, RoleId: roles[1].Id, StartAt: now, EndAt: now.Add(time.Hour)}
+	if err := db.Create(&grant).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	doc := &RbacDocument{Version: RbacDocumentVersion, Roles: []RbacRole{{Name: "kept"}}}
+	res, err := s.ImportRbac(context.Background(), doc, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := make(map[string]RbacChange)
+	for _, c := range res.Changes {
+		actions[c.Kind+" "+c.Key] = c
+	}
+	cases := []struct {
+		key    string
+		action string
+	}{
+		{SubjectRole + " held", RbacActionSkip},
+		{SubjectRole + " granted", RbacActionSkip},
+		{SubjectRole + " unused", RbacActionDelete},
+		{SubjectPermission + " " + permissionKey(perm), RbacActionDelete},
+	}
+	for _, c := range cases {
+		got, ok := actions[c.key]
+		if !ok || got.Action != c.action {
+			t.Errorf("%s: got %+v, want action %q", c.key, got, c.action)
+		}
+		if c.action == RbacActionSkip && got.Reason == "" {
+			t.Errorf("%s: skip without reason", c.key)
+		}
+	}
+	if _, ok := actions[SubjectRole+" kept"]; ok {
+		t.Errorf("kept: unexpected change %+v", actions[SubjectRole+" kept"])
+	}
+
+	var names []string
+	if err := db.Model(&models.RoleModel{}).Order("name").Pluck("name", &names).Error; err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"granted", "held", "kept"}; !slices.Equal(names, want) {
+		t.Errorf("roles after prune = %v, want %v", names, want)
+	}
+	for _, m := range res.removedRoles {
+		if m.Name != "unused" {
+			t.Errorf("removed role %q is still in use", m.Name)
+		}
+	}
+	// 跳过的角色引用的权限被清理，需要重建策略
+	if !slices.Contains(res.roleIds, roles[0].Id) {
+		t.Errorf("roleIds %v missing skipped role %d", res.roleIds, roles[0].Id)
+	}
+}
+
+func TestImportRbacDryRun(t *testing.T) {
+	db := newTestDB(t)
+	s := &ServiceContext{db: db}
+	doc := &RbacDocument{
+		Version:     RbacDocumentVersion,
+		Permissions: []RbacPermission{{Url: "/customer.User/GetCustomer", Method: auth.MethodGrpc}},
+		Roles:       []RbacRole{{Name: "viewer", Permissions: []string{PermissionKey(auth.MethodGrpc, "/customer.User/GetCustomer")}}},
+	}
+	res, err := s.ImportRbac(context.Background(), doc, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Changes) != 2 {
+		t.Errorf("changes = %+v, want create permission and role", res.Changes)
+	}
+	for _, c := range res.Changes {
+		if c.Action != RbacActionCreate {
+			t.Errorf("%s %s: action = %q, want %q", c.Kind, c.Key, c.Action, RbacActionCreate)
+		}
+	}
+	var count int64
+	if err := db.Model(&models.RoleModel{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("dry run persisted %d roles", count)
+	}
+}
@@ -0,0 +1,119 @@
+package svc
+
+import (
+	"cmp"
+	"slices"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+)
+
+// 角色比较的类别
+const (
+	RoleDiffPermission     = "permission"
+	RoleDiffDenyPermission = "deny_permission"
+	RoleDiffMenu           = "menu"
+	RoleDiffButton         = "button"
+	RoleDiffParent         = "parent"
+	RoleDiffDept           = "dept"
+)
+
+// RoleDiffEntry 角色比较中新增或移除的一项
+type RoleDiffEntry struct {
+	Id   uint32
+	Name string // 权限为请求方法和URL，其余为名称
+}
+
+// RoleDiffCategory 一个类别中新增和移除的项，按ID升序
+type RoleDiffCategory struct {
+	Category string
+	Added    []RoleDiffEntry
+	Removed  []RoleDiffEntry
+}
+
+// RoleFieldChange 角色字段的变化
+type RoleFieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// RoleDiff 两个角色之间的差异，只包含有变化的字段和类别
+type RoleDiff struct {
+	Fields     []RoleFieldChange
+	Categories []RoleDiffCategory
+}
+
+// DiffRoleModels 比较角色from和to，to比from多出的项为新增，少的项为移除
+// 两个角色都需预加载权限、拒绝的权限、菜单、按钮、父级角色和部门
+func DiffRoleModels(from, to models.RoleModel) RoleDiff {
+	diff := RoleDiff{
+		Fields:     make([]RoleFieldChange, 0),
+		Categories: make([]RoleDiffCategory, 0),
+	}
+	for _, f := range []RoleFieldChange{
+		{"name", from.Name, to.Name},
+		{"descr", from.Descr, to.Descr},
+		{"data_scope", from.DataScope, to.DataScope},
+		{"conditions", from.Conditions, to.Conditions},
+	} {
+		if f.From != f.To {
+			diff.Fields = append(diff.Fields, f)
+		}
+	}
+	permEntry := func(m models.PermissionModel) RoleDiffEntry {
+		return RoleDiffEntry{Id: m.Id, Name: m.Method + " " + m.Url}
+	}
+	diff.add(RoleDiffPermission, diffEntries(from.Permissions, to.Permissions, permEntry))
+	diff.add(RoleDiffDenyPermission, diffEntries(from.DenyPermissions, to.DenyPermissions, permEntry))
+	diff.add(RoleDiffMenu, diffEntries(from.Menus, to.Menus, func(m models.MenuModel) RoleDiffEntry {
+		return RoleDiffEntry{Id: m.Id, Name: m.Name}
+	}))
+	diff.add(RoleDiffButton, diffEntries(from.Buttons, to.Buttons, func(m models.ButtonModel) RoleDiffEntry {
+		return RoleDiffEntry{Id: m.Id, Name: m.Name}
+	}))
+	diff.add(RoleDiffParent, diffEntries(from.Parents, to.Parents, func(m models.RoleModel) RoleDiffEntry {
+		return RoleDiffEntry{Id: m.Id, Name: m.Name}
+	}))
+	diff.add(RoleDiffDept, diffEntries(from.Depts, to.Depts, func(m models.DeptModel) RoleDiffEntry {
+		return RoleDiffEntry{Id: m.Id, Name: m.Name}
+	}))
+	return diff
+}
+
+func (d *RoleDiff) add(category string, c RoleDiffCategory) {
+	if len(c.Added) == 0 && len(c.Removed) == 0 {
+		return
+	}
+	c.Category = category
+	d.Categories = append(d.Categories, c)
+}
+
+// diffEntries 按ID比较两组关联数据
+func diffEntries[T any](from, to []T, entry func(T) RoleDiffEntry) RoleDiffCategory {
+	c := RoleDiffCategory{
+		Added:   make([]RoleDiffEntry, 0),
+		Removed: make([]RoleDiffEntry, 0),
+	}
+	fromIds := make(map[uint32]bool, len(from))
+	for _, m := range from {
+		fromIds[entry(m).Id] = true
+	}
+	toIds := make(map[uint32]bool, len(to))
+	for _, m := range to {
+		e := entry(m)
+		if !fromIds[e.Id] && !toIds[e.Id] {
+			c.Added = append(c.Added, e)
+		}
+		toIds[e.Id] = true
+	}
+	for _, m := range from {
+		if e := entry(m); !toIds[e.Id] {
+			c.Removed = append(c.Removed, e)
+			toIds[e.Id] = true
+		}
+	}
+	byId := func(a, b RoleDiffEntry) int { return cmp.Compare(a.Id, b.Id) }
+	slices.SortFunc(c.Added, byId)
+	slices.SortFunc(c.Removed, byId)
+	return c
+}
@@ -0,0 +1,129 @@
+package svc
+
+import (
+	"reflect"
+	"testing"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+)
+
+func TestDiffEntries(t *testing.T) {
+	entry := func(id uint32) RoleDiffEntry { return RoleDiffEntry{Id: id, Name: string(rune('a' + id))} }
+	ids := func(ids ...uint32) []uint32 { return ids }
+	entries := func(ids ...uint32) []RoleDiffEntry {
+		es := make([]RoleDiffEntry, 0, len(ids))
+		for _, id := range ids {
+			es = append(es, entry(id))
+		}
+		return es
+	}
+	cases := []struct {
+		name    string
+		from    []uint32
+		to      []uint32
+		added   []RoleDiffEntry
+		removed []RoleDiffEntry
+	}{
+		{"same", ids(1, 2), ids(2, 1), entries(), entries()},
+		{"added", ids(1), ids(3, 1, 2), entries(2, 3), entries()},
+		{"removed", ids(3, 1, 2), ids(2), entries(), entries(1, 3)},
+		{"added and removed", ids(1, 2), ids(2, 3), entries(3), entries(1)},
+		{"duplicate ids", ids(1, 1, 2, 2), ids(3, 3, 1), entries(3), entries(2)},
+		{"empty", nil, nil, entries(), entries()},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := diffEntries(c.from, c.to, entry)
+			if !reflect.DeepEqual(got.Added, c.added) {
+				t.Errorf("added = %v, want %v", got.Added, c.added)
+			}
+			if !reflect.DeepEqual(got.Removed, c.removed) {
+				t.Errorf("removed = %v, want %v", got.Removed, c.removed)
+			}
+		})
+	}
+}
+
+func TestDiffRoleModels(t *testing.T) {
+	perm := func(id uint32, url string) models.PermissionModel {
+		return models.PermissionModel{StandardModel: standardModel(id), Method: "GET", Url: url}
+	}
+	menu := func(id uint32, name string) models.MenuModel {
+		return models.MenuModel{StandardModel: standardModel(id), Name: name}
+	}
+	from := models.RoleModel{
+		Name:        "a",
+		Descr:       "same",
+		DataScope:   "all",
+		Permissions: []models.PermissionModel{perm(1, "/a"), perm(2, "/b")},
+		Menus:       []models.MenuModel{menu(1, "m1")},
+	}
+	cases := []struct {
+		name       string
+		to         models.RoleModel
+		fields     []RoleFieldChange
+		categories []RoleDiffCategory
+	}{
+		{
+			name:       "identical",
+			to:         from,
+			fields:     []RoleFieldChange{},
+			categories: []RoleDiffCategory{},
+		},
+		{
+			name: "field changes",
+			to: func() models.RoleModel {
+				m := from
+				m.Name = "b"
+				m.DataScope = "self"
+				m.Conditions = `{"ip":["10.0.0.0/8"]}`
+				return m
+			}(),
+			fields: []RoleFieldChange{
+				{"name", "a", "b"},
+				{"data_scope", "all", "self"},
+				{"conditions", "", `{"ip":["10.0.0.0/8"]}`},
+			},
+			categories: []RoleDiffCategory{},
+		},
+		{
+			name: "associations",
+			to: func() models.RoleModel {
+				m := from
+				m.Permissions = []models.PermissionModel{perm(2, "/b"), perm(3, "/c")}
+				m.Menus = nil
+				m.DenyPermissions = []models.PermissionModel{perm(1, "/a")}
+				return m
+			}(),
+			fields: []RoleFieldChange{},
+			categories: []RoleDiffCategory{
+				{
+					Category: RoleDiffPermission,
+					Added:    []RoleDiffEntry{{3, "GET /c"}},
+					Removed:  []RoleDiffEntry{{1, "GET /a"}},
+				},
+				{
+					Category: RoleDiffDenyPermission,
+					Added:    []RoleDiffEntry{{1, "GET /a"}},
+					Removed:  []RoleDiffEntry{},
+				},
+				{
+					Category: RoleDiffMenu,
+					Added:    []RoleDiffEntry{},
+					Removed:  []RoleDiffEntry{{1, "m1"}},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := DiffRoleModels(from, c.to)
+			if !reflect.DeepEqual(got.Fields, c.fields) {
+				t.Errorf("fields = %+v, want %+v", got.Fields, c.fields)
+			}
+			if !reflect.DeepEqual(got.Categories, c.categories) {
+				t.Errorf("categories = %+v, want %+v", got.Categories, c.categories)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package svc
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"slices"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// RoleInUseError 角色仍被用户持有或存在临时授权，不能直接删除
+type RoleInUseError struct {
+	Users  int64 // 持有该角色的用户数量
+	Grants int64 // 该角色的临时授权数量
+}
+
+func (e *RoleInUseError) Error() string {
+	return fmt.Sprintf("role is held by %d users and %d grants", e.Users, e.Grants)
+}
+
+// roleUsage 统计持有角色的用户和角色的临时授权，角色未被使用时返回nil
+func roleUsage(db *gorm.DB, id uint32) (*RoleInUseError, error) {
+	var inUse RoleInUseError
+	if err := db.Table("customer_user_role").Where("role_id = ?", id).Count(&inUse.Users).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Model(&models.UserRoleGrantModel{}).Where("role_id = ?", id).Count(&inUse.Grants).Error; err != nil {
+		return nil, err
+	}
+	if inUse.Users == 0 && inUse.Grants == 0 {
+		return nil, nil
+	}
+	return &inUse, nil
+}
+
+// RoleImpact 删除或修改角色会影响的数据
+type RoleImpact struct {
+	Role       models.RoleModel            // 预加载了权限、拒绝的权限、菜单和按钮
+	Users      []models.UserModel          // 持有该角色的用户
+	UserGrants []models.UserRoleGrantModel // 该角色的临时授权，包括未生效和已失效的授权
+	Children   []models.RoleModel          // 继承该角色的子角色
+}
+
+// Impact 查询角色关联的用户、临时授权、子角色及其授予的菜单、按钮和权限
+func (s *RoleService) Impact(ctx context.Context, id uint32) (*RoleImpact, error) {
+	m, err := s.FindModel(ctx, []string{"Permissions", "DenyPermissions", "Menus", "Buttons"}, id)
+	if err != nil {
+		return nil, err
+	}
+	impact := &RoleImpact{Role: *m}
+	db := s.gormDB.WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.UserModel{}).
+			Where("id IN (?)", tx.Table("customer_user_role").Select("user_id").Where("role_id = ?", id)).
+			Order("id").
+			Find(&impact.Users).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("role_id = ?", id).Order("id").Find(&impact.UserGrants).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.RoleModel{}).
+			Where("id IN (?)", tx.Table("customer_role_parent").Select("role_id").Where("parent_id = ?", id)).
+			Order("id").
+			Find(&impact.Children).Error
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询角色的影响范围失败",
+			logx.Field("id", id),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return impact, nil
+}
+
+// DeleteModelReassign 在一个事务中删除角色，返回原先持有该角色的用户ID
+// reassignTo 不为0时先将用户和临时授权转移到 reassignTo 对应的角色，已持有该角色的用户不重复关联；
+// 为0且force为true时一并删除用户的角色关联和临时授权，否则角色仍被使用时返回 RoleInUseError
+func (s *RoleService) DeleteModelReassign(ctx context.Context, id uint32, reassignTo uint32, force bool) ([]uint32, error) {
+	var userIds []uint32
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("customer_user_role").Where("role_id = ?", id).Pluck("user_id", &userIds).Error; err != nil {
+			return err
+		}
+		var grantUserIds []uint32
+		if err := tx.Model(&models.UserRoleGrantModel{}).Where("role_id = ?", id).Pluck("user_id", &grantUserIds).Error; err != nil {
+			return err
+		}
+		if reassignTo == 0 && !force && len(userIds)+len(grantUserIds) > 0 {
+			return &RoleInUseError{Users: int64(len(userIds)), Grants: int64(len(grantUserIds))}
+		}
+		if reassignTo == 0 {
+			if err := tx.Exec("DELETE FROM customer_user_role WHERE role_id = ?", id).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("role_id = ?", id).Delete(&models.UserRoleGrantModel{}).Error; err != nil {
+				return err
+			}
+			userIds = append(userIds, grantUserIds...)
+			return tx.Delete(&models.RoleModel{}, id).Error
+		}
+		if len(userIds) > 0 {
+			if err := tx.Exec(
+				"INSERT INTO customer_user_role (user_id, role_id) "+
+					"SELECT ur.user_id, ? FROM customer_user_role ur "+
+					"WHERE ur.role_id = ? AND NOT EXISTS ("+
+					"SELECT 1 FROM customer_user_role x WHERE x.user_id = ur.user_id AND x.role_id = ?)",
+				reassignTo, id, reassignTo,
+			).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("DELETE FROM customer_user_role WHERE role_id = ?", id).Error; err != nil {
+				return err
+			}
+		}
+		if len(grantUserIds) > 0 {
+			if err := tx.Model(&models.UserRoleGrantModel{}).
+				Where("role_id = ?", id).
+				Update("role_id", reassignTo).Error; err != nil {
+				return err
+			}
+			userIds = append(userIds, grantUserIds...)
+		}
+		return tx.Delete(&models.RoleModel{}, id).Error
+	})
+	if err != nil {
+		var inUse *RoleInUseError
+		if !stderrors.As(err, &inUse) {
+			logx.WithContext(ctx).Errorw(
+				"删除角色模型失败",
+				logx.Field("id", id),
+				logx.Field("reassign_to", reassignTo),
+				logx.Field(errors.ErrKey, err),
+			)
+		}
+		return nil, err
+	}
+	slices.Sort(userIds)
+	return slices.Compact(userIds), nil
+}
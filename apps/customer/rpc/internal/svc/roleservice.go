@@ -2,7 +2,9 @@ package svc
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"gz-dango/apps/customer/rpc/internal/models"
@@ -12,8 +14,12 @@ import (
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrRoleCycle 角色的继承关系形成环
+var ErrRoleCycle = stderrors.New("role parent cycle")
+
 type RoleService struct {
 	gormDB *gorm.DB
 	cache  *auth.AuthEnforcer
@@ -99,9 +105,25 @@ func (s *RoleService) ListModel(
 	return count, ms, err
 }
 
-func (s *RoleService) LoadPolicies(ctx context.Context) error {
+func (s *RoleService) ListModelByIds(
+	ctx context.Context,
+	ids []uint32,
+) ([]models.RoleModel, error) {
+	if len(ids) == 0 {
+		return []models.RoleModel{}, nil
+	}
+	qp := database.NewPksQueryParams(ids)
+	_, ms, err := s.ListModel(ctx, qp)
+	return ms, err
+}
+
+// RolePolicyPreloads 生成角色策略需要预加载的关联数据
+var RolePolicyPreloads = []string{"Permissions", "Menus", "Buttons", "Parents", "DenyPermissions", "PermissionGrants.Permission"}
+
+// listPolicyModels 查询生成策略所需的全部角色及其关联数据
+func (s *RoleService) listPolicyModels(ctx context.Context) ([]models.RoleModel, error) {
 	qp := database.QueryParams{
-		Preloads: []string{"Permissions", "Menus", "Buttons"},
+		Preloads: RolePolicyPreloads,
 		Query:    nil,
 		OrderBy:  nil,
 		Limit:    0,
@@ -109,15 +131,7 @@ func (s *RoleService) LoadPolicies(ctx context.Context) error {
 		IsCount:  false,
 	}
 	_, ms, err := s.ListModel(ctx, qp)
-	if err != nil {
-		return err
-	}
-	for _, m := range ms {
-		if err := s.AddGroupPolicy(ctx, m); err != nil {
-			return err
-		}
-	}
-	return nil
+	return ms, err
 }
 
 func (s *RoleService) AddGroupPolicy(
@@ -129,52 +143,117 @@ func (s *RoleService) AddGroupPolicy(
 		return ctx.Err()
 	default:
 	}
-	sub := s.RoleModelToSub(m)
+	rules := roleGroupingRules(m)
+	if err := s.cache.AddGroupPolicies(rules); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"添加角色的关联策略失败",
+			logx.Field("role_id", m.Id),
+			logx.Field("rules", rules),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return s.addRolePolicy(ctx, m)
+}
 
-	// 批量处理权限
-	for _, o := range m.Permissions {
-		obj := permissionModelToSub(o)
-		if err := s.cache.AddGroupPolicy(sub, obj); err != nil {
-			logx.WithContext(ctx).Errorw(
-				"添加角色与权限的关联策略失败",
-				logx.Field(auth.SubKey, sub),
-				logx.Field(auth.ObjKey, obj),
-				logx.Field("role_id", m.Id),
-				logx.Field("permission_id", o.Id),
-				logx.Field(errors.ErrKey, err),
-			)
-			return err
-		}
+// addRolePolicy 添加角色自有的 p 规则，m 需预加载 RolePolicyPreloads
+func (s *RoleService) addRolePolicy(ctx context.Context, m models.RoleModel) error {
+	rules := rolePolicyRules(m)
+	if err := s.cache.AddPolicies(rules); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"添加角色的授权策略失败",
+			logx.Field("role_id", m.Id),
+			logx.Field("rules", rules),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
 	}
+	return nil
+}
 
-	// 批量处理菜单
-	for _, o := range m.Menus {
-		obj := menuModelToSub(o)
-		if err := s.cache.AddGroupPolicy(sub, obj); err != nil {
-			logx.WithContext(ctx).Errorw(
-				"添加角色与菜单的关联策略失败",
-				logx.Field(auth.SubKey, sub),
-				logx.Field(auth.ObjKey, obj),
-				logx.Field("role_id", m.Id),
-				logx.Field("menu_id", o.Id),
-				logx.Field(errors.ErrKey, err),
-			)
-			return err
-		}
+// removeRolePolicy 删除角色自有的 p 规则
+func (s *RoleService) removeRolePolicy(ctx context.Context, m models.RoleModel) error {
+	sub := roleModelToSub(m)
+	if err := s.cache.RemoveFilteredPolicy(0, sub); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"删除角色的授权策略失败",
+			logx.Field(auth.SubKey, sub),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
 	}
+	return nil
+}
 
-	// 批量处理按钮
-	for _, o := range m.Buttons {
-		obj := buttonModelToSub(o)
-		if err := s.cache.AddGroupPolicy(sub, obj); err != nil {
-			logx.WithContext(ctx).Errorw(
-				"添加角色与按钮的关联策略失败",
-				logx.Field(auth.SubKey, sub),
-				logx.Field(auth.ObjKey, obj),
-				logx.Field("role_id", m.Id),
-				logx.Field("button_id", o.Id),
-				logx.Field(errors.ErrKey, err),
-			)
+// ListPolicyRoleIds 查询自有 p 规则引用了该权限的角色ID，包括拒绝该权限的角色和设置了附加条件且关联或临时拥有该权限的角色
+func (s *RoleService) ListPolicyRoleIds(ctx context.Context, permissionId uint32) ([]uint32, error) {
+	return listPolicyRoleIds(ctx, s.gormDB, []uint32{permissionId})
+}
+
+// listPolicyRoleIds 查询自有 p 规则引用了任一权限的角色ID，db可以是事务
+func listPolicyRoleIds(ctx context.Context, db *gorm.DB, permissionIds []uint32) ([]uint32, error) {
+	if len(permissionIds) == 0 {
+		return []uint32{}, nil
+	}
+	var denyIds, condIds []uint32
+	if err := db.WithContext(ctx).
+		Table("customer_role_deny_permission").
+		Where("permission_id IN ?", permissionIds).
+		Pluck("role_id", &denyIds).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询拒绝该权限的角色失败",
+			logx.Field("permission_ids", permissionIds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	if err := db.WithContext(ctx).
+		Table("customer_role_permission").
+		Joins("JOIN customer_role ON customer_role.id = customer_role_permission.role_id").
+		Where("customer_role_permission.permission_id IN ? AND customer_role.conditions <> ''", permissionIds).
+		Pluck("customer_role_permission.role_id", &condIds).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询关联该权限的条件角色失败",
+			logx.Field("permission_ids", permissionIds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	var grantIds []uint32
+	if err := db.WithContext(ctx).
+		Model(&models.RolePermissionGrantModel{}).
+		Joins("JOIN customer_role ON customer_role.id = customer_role_permission_grant.role_id").
+		Where("customer_role_permission_grant.permission_id IN ? AND customer_role.conditions <> ''", permissionIds).
+		Pluck("customer_role_permission_grant.role_id", &grantIds).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询临时拥有该权限的条件角色失败",
+			logx.Field("permission_ids", permissionIds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	ids := slices.Concat(denyIds, condIds, grantIds)
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// ResetRolePolicies 按业务表重建角色的 g 规则和自有的 p 规则
+// 用于权限的URL、条件等字段修改、权限被删除，或临时权限生效、失效或被撤销后
+func (s *RoleService) ResetRolePolicies(ctx context.Context, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	qp := database.NewPksQueryParams(ids)
+	qp.Preloads = RolePolicyPreloads
+	_, ms, err := s.ListModel(ctx, qp)
+	if err != nil {
+		return err
+	}
+	for _, m := range ms {
+		if err := s.RemoveGroupPolicy(ctx, m, false); err != nil {
+			return err
+		}
+		if err := s.AddGroupPolicy(ctx, m); err != nil {
 			return err
 		}
 	}
@@ -184,13 +263,14 @@ func (s *RoleService) AddGroupPolicy(
 func (s *RoleService) RemoveGroupPolicy(
 	ctx context.Context,
 	m models.RoleModel,
+	removeInherited bool,
 ) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
-	sub := s.RoleModelToSub(m)
+	sub := roleModelToSub(m)
 	// 删除角色作为子级的策略（从其他菜单或权限继承）
 	if err := s.cache.RemoveGroupPolicy(0, sub); err != nil {
 		logx.WithContext(ctx).Errorw(
@@ -200,9 +280,244 @@ func (s *RoleService) RemoveGroupPolicy(
 		)
 		return err
 	}
+	if err := s.removeRolePolicy(ctx, m); err != nil {
+		return err
+	}
+	if removeInherited {
+		// 删除角色作为父级的策略（被子角色或用户继承）
+		if err := s.cache.RemoveGroupPolicy(1, sub); err != nil {
+			logx.WithContext(ctx).Errorw(
+				"删除角色作为父级策略失败(该策略被其他策略继承)",
+				logx.Field(auth.ObjKey, sub),
+				logx.Field(errors.ErrKey, err),
+			)
+			return err
+		}
+	}
 	return nil
 }
 
-func (s *RoleService) RoleModelToSub(m models.RoleModel) string {
-	return fmt.Sprintf("role_%d", m.Id)
+// listParentEdges 查询所有角色的父级关系，返回角色ID到父级角色ID列表的映射
+func (s *RoleService) listParentEdges(ctx context.Context, db *gorm.DB) (map[uint32][]uint32, error) {
+	var rows []struct {
+		RoleId   uint32
+		ParentId uint32
+	}
+	if err := db.WithContext(ctx).
+		Table("customer_role_parent").
+		Select("role_id, parent_id").
+		Scan(&rows).Error; err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询角色继承关系失败",
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	edges := make(map[uint32][]uint32, len(rows))
+	for _, r := range rows {
+		edges[r.RoleId] = append(edges[r.RoleId], r.ParentId)
+	}
+	return edges, nil
+}
+
+// HasCycle 检查将parentIds设置为角色id的父级后是否会形成继承环
+// 父级中包含角色自身，或任一父级沿继承链能回到该角色时返回true
+// 只用于提前校验请求，写入时由 UpdateModelWithParents 在事务中重新检查
+func (s *RoleService) HasCycle(ctx context.Context, id uint32, parentIds []uint32) (bool, error) {
+	if id == 0 || len(parentIds) == 0 {
+		return false, nil
+	}
+	edges, err := s.listParentEdges(ctx, s.gormDB)
+	if err != nil {
+		return false, err
+	}
+	return roleReaches(edges, parentIds, id), nil
+}
+
+// roleReaches 判断从角色froms沿继承链能否到达角色to，已存在的环不会导致死循环
+func roleReaches(edges map[uint32][]uint32, froms []uint32, to uint32) bool {
+	visited := make(map[uint32]bool)
+	stack := append([]uint32{}, froms...)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if cur == to {
+			return true
+		}
+		if visited[cur] {
+			continue
+		}
+		visited[cur] = true
+		stack = append(stack, edges[cur]...)
+	}
+	return false
+}
+
+// UpdateModelWithParents 在一个事务中检查继承环并更新角色及其关联，parentIds为更新后的父级角色
+// 新增的继承关系是关联表中的新行，锁定已有的关联行无法互斥，因此检查前锁定所有角色行，
+// 避免并发的更新各自通过检查后共同形成环；形成环时返回 ErrRoleCycle
+func (s *RoleService) UpdateModelWithParents(
+	ctx context.Context,
+	id uint32,
+	parentIds []uint32,
+	data map[string]any,
+	upmap map[string]any,
+) error {
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uint32
+		if err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Model(&models.RoleModel{}).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		edges, err := s.listParentEdges(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if roleReaches(edges, parentIds, id) {
+			return ErrRoleCycle
+		}
+		if err := tx.Model(&models.RoleModel{}).Where("id = ?", id).Updates(data).Error; err != nil {
+			return err
+		}
+		var m models.RoleModel
+		if err := tx.First(&m, id).Error; err != nil {
+			return err
+		}
+		return database.DBAssociate(ctx, tx, &m, upmap)
+	})
+	if err != nil && !stderrors.Is(err, ErrRoleCycle) {
+		fields := database.MapToLogFields(data)
+		fields = append(fields, logx.Field("id", id), logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("更新角色模型失败", fields...)
+	}
+	return err
+}
+
+// ListAncestors 查询角色沿继承链的所有祖先角色，并预加载其授权
+func (s *RoleService) ListAncestors(ctx context.Context, id uint32) ([]models.RoleModel, error) {
+	edges, err := s.listParentEdges(ctx, s.gormDB)
+	if err != nil {
+		return nil, err
+	}
+	visited := map[uint32]bool{id: true}
+	ids := make([]uint32, 0)
+	stack := append([]uint32{}, edges[id]...)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[cur] {
+			continue
+		}
+		visited[cur] = true
+		ids = append(ids, cur)
+		stack = append(stack, edges[cur]...)
+	}
+	if len(ids) == 0 {
+		return []models.RoleModel{}, nil
+	}
+	qp := database.NewPksQueryParams(ids)
+	qp.Preloads = []string{"Permissions", "Menus", "Buttons"}
+	_, ms, err := s.ListModel(ctx, qp)
+	return ms, err
+}
+
+// ListLineageIds 返回角色及其沿继承链的所有祖先角色的ID，拥有角色即拥有祖先角色的授权
+func (s *RoleService) ListLineageIds(ctx context.Context, ids []uint32) ([]uint32, error) {
+	if len(ids) == 0 {
+		return []uint32{}, nil
+	}
+	edges, err := s.listParentEdges(ctx, s.gormDB)
+	if err != nil {
+		return nil, err
+	}
+	visited := make(map[uint32]bool)
+	lineage := make([]uint32, 0, len(ids))
+	stack := append([]uint32{}, ids...)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[cur] {
+			continue
+		}
+		visited[cur] = true
+		lineage = append(lineage, cur)
+		stack = append(stack, edges[cur]...)
+	}
+	slices.Sort(lineage)
+	return lineage, nil
+}
+
+// RoleSubject 返回角色在casbin中的主体
+func RoleSubject(m models.RoleModel) string {
+	return roleModelToSub(m)
+}
+
+func roleModelToSub(m models.RoleModel) string {
+	return roleIdToSub(m.Id)
+}
+
+func roleIdToSub(id uint32) string {
+	return fmt.Sprintf("role_%d", id)
+}
+
+// RoleConditionsInherited 判断角色是否在设置附加条件的同时关联了菜单、按钮或父级角色
+// 这些关联通过 g 规则继承权限，无法附加角色的条件，因此设置了附加条件的角色只能直接关联权限
+func RoleConditionsInherited(m models.RoleModel) bool {
+	return m.Conditions != "" && (len(m.Menus) > 0 || len(m.Buttons) > 0 || len(m.Parents) > 0)
+}
+
+// roleGroupingRules 返回角色的 g 规则：角色继承关联的权限、当前有效的临时权限、菜单、按钮和父级角色
+// 设置了附加条件的角色不继承关联的权限，由 rolePolicyRules 生成带条件的 p 规则
+func roleGroupingRules(m models.RoleModel) [][]string {
+	sub := roleModelToSub(m)
+	dom := auth.TenantDomain(m.TenantId)
+	rules := make([][]string, 0, len(m.Permissions)+len(m.PermissionGrants)+len(m.Menus)+len(m.Buttons)+len(m.Parents))
+	if m.Conditions == "" {
+		for _, o := range m.Permissions {
+			rules = append(rules, []string{sub, permissionModelToSub(o), dom})
+		}
+		now := time.Now()
+		for _, o := range m.PermissionGrants {
+			if o.Active(now) {
+				rules = append(rules, []string{sub, permissionIdToSub(o.PermissionId), dom})
+			}
+		}
+	}
+	for _, o := range m.Menus {
+		rules = append(rules, []string{sub, menuModelToSub(o), dom})
+	}
+	for _, o := range m.Buttons {
+		rules = append(rules, []string{sub, buttonModelToSub(o), dom})
+	}
+	// 子角色继承父角色的所有授权
+	for _, o := range m.Parents {
+		rules = append(rules, []string{sub, roleModelToSub(o), dom})
+	}
+	return rules
+}
+
+// rolePolicyRules 返回角色自有的 p 规则
+// 角色拒绝访问所选权限的URL和请求方法；设置了附加条件时，角色关联的权限和当前有效的临时权限在满足权限和角色的条件时才允许访问
+func rolePolicyRules(m models.RoleModel) [][]string {
+	sub := roleModelToSub(m)
+	dom := auth.TenantDomain(m.TenantId)
+	rules := make([][]string, 0, len(m.DenyPermissions)+len(m.Permissions)+len(m.PermissionGrants))
+	for _, o := range m.DenyPermissions {
+		rules = append(rules, auth.PolicyRule(sub, dom, o.Url, o.Method, o.Matcher, auth.EffectDeny, ""))
+	}
+	if m.Conditions != "" {
+		perms := slices.Clone(m.Permissions)
+		now := time.Now()
+		for _, o := range m.PermissionGrants {
+			if o.Active(now) && o.Permission != nil {
+				perms = append(perms, *o.Permission)
+			}
+		}
+		for _, o := range perms {
+			cond := auth.JoinConditions(o.Conditions, m.Conditions)
+			rules = append(rules, auth.PolicyRule(sub, dom, o.Url, o.Method, o.Matcher, o.Effect, cond))
+		}
+	}
+	return rules
 }
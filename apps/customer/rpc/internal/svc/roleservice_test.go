@@ -0,0 +1,228 @@
+package svc
+
+import (
+	"context"
+	stderrors "errors"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/auth"
+	"gz-dango/pkg/database"
+
+	"github.com/casbin/casbin/v2"
+)
+
+// staticLoader 返回固定的策略
+type staticLoader struct {
+	policies, groupings [][]string
+}
+
+func (l staticLoader) LoadRules(ctx context.Context) ([][]string, [][]string, error) {
+	return l.policies, l.groupings, nil
+}
+
+func newTestEnforcer(t *testing.T) *auth.AuthEnforcer {
+	t.Helper()
+	m, err := auth.NewModel("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := casbin.NewEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := auth.NewAuthEnforcer(e, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enforcer
+}
+
+func TestConditionalRoleDeniedWhenConditionFails(t *testing.T) {
+	cond, err := auth.EncodeConditions(auth.Conditions{CIDRs: []string{"10.0.0.0/8"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	perm := models.PermissionModel{
+		StandardModel: database.StandardModel{BaseModel: database.BaseModel{Id: 1}},
+		TenantId:      1,
+		Url:           "/api/v1/user",
+		Method:        "GET",
+		Matcher:       auth.MatchExact,
+		Effect:        auth.EffectAllow,
+	}
+	role := models.RoleModel{
+		StandardModel: database.StandardModel{BaseModel: database.BaseModel{Id: 2}},
+		TenantId:      1,
+		Conditions:    cond,
+		Permissions:   []models.PermissionModel{perm},
+	}
+	user := models.UserModel{TenantId: 1, Roles: []models.RoleModel{role}}
+	user.Id = 3
+
+	groupings := append(roleGroupingRules(role), userGroupingRules(user)...)
+	for _, g := range groupings {
+		if g[1] == permissionModelToSub(perm) {
+			t.Fatalf("conditional role has an unconditional grouping to its permission: %v", g)
+		}
+	}
+	loader := staticLoader{
+		policies:  append([][]string{permissionPolicyRule(perm)}, rolePolicyRules(role)...),
+		groupings: groupings,
+	}
+	enforcer := newTestEnforcer(t)
+	if _, err := enforcer.Reload(context.Background(), loader); err != nil {
+		t.Fatal(err)
+	}
+
+	sub, dom := auth.UserSubject(user.Id), auth.TenantDomain(1)
+	cases := []struct {
+		ip   string
+		want bool
+	}{
+		{"10.1.2.3", true},
+		{"192.168.1.1", false},
+	}
+	for _, c := range cases {
+		env := &auth.RequestEnv{IP: netip.MustParseAddr(c.ip)}
+		ok, aerr := enforcer.Authorization(sub, dom, "/api/v1/user", "GET", env)
+		if aerr != nil {
+			t.Fatal(aerr)
+		}
+		if ok != c.want {
+			t.Errorf("Authorization from %s = %v, want %v", c.ip, ok, c.want)
+		}
+	}
+}
+
+func TestRoleConditionsInherited(t *testing.T) {
+	cond, _ := auth.EncodeConditions(auth.Conditions{RequireStaff: true})
+	cases := []struct {
+		name string
+		role models.RoleModel
+		want bool
+	}{
+		{"no conditions", models.RoleModel{Menus: []models.MenuModel{{}}}, false},
+		{"only permissions", models.RoleModel{Conditions: cond, Permissions: []models.PermissionModel{{}}}, false},
+		{"menus", models.RoleModel{Conditions: cond, Menus: []models.MenuModel{{}}}, true},
+		{"buttons", models.RoleModel{Conditions: cond, Buttons: []models.ButtonModel{{}}}, true},
+		{"parents", models.RoleModel{Conditions: cond, Parents: []models.RoleModel{{}}}, true},
+	}
+	for _, c := range cases {
+		if got := RoleConditionsInherited(c.role); got != c.want {
+			t.Errorf("%s: RoleConditionsInherited = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// createRoleChain 创建角色 a <- b <- c，c继承b，b继承a，返回 a、b、c
+func createRoleChain(t *testing.T, s *RoleService) (models.RoleModel, models.RoleModel, models.RoleModel) {
+	t.Helper()
+	perm := models.PermissionModel{Url: "/api/v1/user", Method: "GET", Matcher: auth.MatchExact, Effect: auth.EffectAllow}
+	if err := s.gormDB.Create(&perm).Error; err != nil {
+		t.Fatal(err)
+	}
+	a := models.RoleModel{Name: "a", Permissions: []models.PermissionModel{perm}}
+	if err := s.gormDB.Create(&a).Error; err != nil {
+		t.Fatal(err)
+	}
+	b := models.RoleModel{Name: "b", Parents: []models.RoleModel{a}}
+	if err := s.gormDB.Create(&b).Error; err != nil {
+		t.Fatal(err)
+	}
+	c := models.RoleModel{Name: "c", Parents: []models.RoleModel{b}}
+	if err := s.gormDB.Create(&c).Error; err != nil {
+		t.Fatal(err)
+	}
+	return a, b, c
+}
+
+func TestRoleHasCycle(t *testing.T) {
+	s := NewRoleService(newTestDB(t), nil)
+	a, b, c := createRoleChain(t, s)
+	cases := []struct {
+		name      string
+		id        uint32
+		parentIds []uint32
+		want      bool
+	}{
+		{"self", a.Id, []uint32{a.Id}, true},
+		{"direct child", a.Id, []uint32{b.Id}, true},
+		{"descendant", a.Id, []uint32{c.Id}, true},
+		{"one of several parents", b.Id, []uint32{a.Id, c.Id}, true},
+		{"existing parent", c.Id, []uint32{b.Id}, false},
+		{"ancestor", c.Id, []uint32{a.Id}, false},
+		{"no parents", a.Id, nil, false},
+		{"new role", 0, []uint32{a.Id}, false},
+	}
+	for _, cs := range cases {
+		got, err := s.HasCycle(context.Background(), cs.id, cs.parentIds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != cs.want {
+			t.Errorf("%s: HasCycle = %v, want %v", cs.name, got, cs.want)
+		}
+	}
+}
+
+func TestRoleListAncestors(t *testing.T) {
+	s := NewRoleService(newTestDB(t), nil)
+	a, b, c := createRoleChain(t, s)
+	ctx := context.Background()
+	ancestors, err := s.ListAncestors(ctx, c.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]uint32, 0, len(ancestors))
+	for _, m := range ancestors {
+		got = append(got, m.Id)
+		if m.Id == a.Id && len(m.Permissions) != 1 {
+			t.Errorf("ancestor %q permissions not preloaded", m.Name)
+		}
+	}
+	slices.Sort(got)
+	if want := []uint32{a.Id, b.Id}; !slices.Equal(got, want) {
+		t.Errorf("ListAncestors(c) = %v, want %v", got, want)
+	}
+	if ancestors, err = s.ListAncestors(ctx, a.Id); err != nil || len(ancestors) != 0 {
+		t.Errorf("ListAncestors(a) = %v, %v, want none", ancestors, err)
+	}
+}
+
+func TestRoleUpdateModelWithParents(t *testing.T) {
+	s := NewRoleService(newTestDB(t), nil)
+	a, b, c := createRoleChain(t, s)
+	ctx := context.Background()
+
+	err := s.UpdateModelWithParents(ctx, a.Id, []uint32{c.Id}, map[string]any{"descr": "cycle"},
+		map[string]any{"Parents": []models.RoleModel{c}})
+	if !stderrors.Is(err, ErrRoleCycle) {
+		t.Fatalf("err = %v, want ErrRoleCycle", err)
+	}
+	m, err := s.FindModel(ctx, []string{"Parents"}, a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Descr != "" || len(m.Parents) != 0 {
+		t.Errorf("rejected update was written: %+v", m)
+	}
+
+	// c 改为直接继承 a
+	if err := s.UpdateModelWithParents(ctx, c.Id, []uint32{a.Id}, map[string]any{"descr": "flat"},
+		map[string]any{"Parents": []models.RoleModel{a}}); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = s.FindModel(ctx, []string{"Parents"}, c.Id); err != nil {
+		t.Fatal(err)
+	}
+	if m.Descr != "flat" || len(m.Parents) != 1 || m.Parents[0].Id != a.Id {
+		t.Errorf("role c after update = %+v", m)
+	}
+	// 更新后 b 不再是 c 的祖先，b 可以继承 c
+	if cycle, err := s.HasCycle(ctx, b.Id, []uint32{c.Id}); err != nil || cycle {
+		t.Errorf("HasCycle(b, [c]) = %v, %v, want false", cycle, err)
+	}
+}
@@ -2,6 +2,8 @@ package svc
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"gz-dango/apps/customer/rpc/internal/config"
@@ -11,32 +13,54 @@ import (
 	"gz-dango/pkg/errors"
 
 	"github.com/casbin/casbin/v2"
-	stringadapter "github.com/casbin/casbin/v2/persist/string-adapter"
 	"github.com/google/uuid"
 	goReids "github.com/redis/go-redis/v9"
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"gorm.io/gorm"
 )
 
-const (
-	DefaultPolicyChangeKey = "/casbin/policy-change-signal"
-)
-
 type ServiceContext struct {
 	Config     config.Config
 	db         *gorm.DB
 	redis      *redis.Redis
 	goredis    *goReids.Client
 	enforcer   *auth.AuthEnforcer
+	adapter    auth.PolicyLoader
+	watcher    auth.PolicyWatcher
+	etcd       *clientv3.Client
 	instanceID string
 
-	Perm   *PermissionService
-	Menu   *MenuService
-	Button *ButtonService
-	Role   *RoleService
-	User   *UserService
-	Recode *RecordService
+	// policyVersion 本实例已应用的策略版本号，-1表示未知
+	policyVersion atomic.Int64
+	// publishMu 保证本实例发布的变更消息的版本号顺序与事件的应用顺序一致
+	publishMu sync.Mutex
+	// syncMu 串行化增量应用变更消息和全量同步
+	syncMu sync.Mutex
+	// stopWatch 停止监听策略变更和定期同步
+	stopWatch context.CancelFunc
+	// stopSweep 停止检查临时授权的有效期
+	stopSweep context.CancelFunc
+	// sweepLease 多实例之间检查临时授权有效期的租约
+	sweepLease leaderLease
+	// syncLock 多实例同时启动时串行化按接口同步权限
+	syncLock leaderLease
+	// endpoints 本服务对外提供的接口，用于同步权限
+	endpoints atomic.Pointer[[]auth.Endpoint]
+
+	Perm      *PermissionService
+	Menu      *MenuService
+	Button    *ButtonService
+	Role      *RoleService
+	User      *UserService
+	UserGrant *UserRoleGrantService
+	RoleGrant *RolePermissionGrantService
+	Tenant    *TenantService
+	Dept      *DeptService
+	Scope     *DataScopeService
+	Recode    *RecordService
+	Captcha   *CaptchaService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -52,24 +76,52 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		logx.Errorw("创建数据库连接失败", logx.Field(errors.ErrKey, err))
 		panic(err)
 	}
+	if err := database.RegisterTenantScope(db, auth.TenantFromContext); err != nil {
+		logx.Errorw("注册租户隔离回调失败", logx.Field(errors.ErrKey, err))
+		panic(err)
+	}
 	if err := db.AutoMigrate(
+		&models.TenantModel{},
+		&models.DeptModel{},
 		&models.PermissionModel{},
 		&models.MenuModel{},
 		&models.ButtonModel{},
 		&models.RoleModel{},
 		&models.UserModel{},
+		&models.UserRoleGrantModel{},
+		&models.RolePermissionGrantModel{},
 		&models.LoginRecordModel{},
 	); err != nil {
 		logx.Errorw("数据库自动迁移失败", logx.Field(errors.ErrKey, err))
 		panic(err)
 	}
+	if err := models.MigrateUserRoles(db); err != nil {
+		logx.Errorw("迁移用户角色数据失败", logx.Field(errors.ErrKey, err))
+		panic(err)
+	}
+	if err := models.MigrateTenantIndexes(db); err != nil {
+		logx.Errorw("迁移租户唯一索引失败", logx.Field(errors.ErrKey, err))
+		panic(err)
+	}
+	if err := models.MigrateRestrictUserRoleKeys(db); err != nil {
+		logx.Errorw("迁移用户角色外键约束失败", logx.Field(errors.ErrKey, err))
+		panic(err)
+	}
 
-	adapter := stringadapter.NewAdapter(`p, admin, *, *`)
-	enf, err := casbin.NewEnforcer("etc/model.conf", adapter)
+	casbinModel, err := auth.NewModel(c.Security.ModelPath)
 	if err != nil {
+		logx.Errorw("加载casbin模型失败", logx.Field("path", c.Security.ModelPath), logx.Field(errors.ErrKey, err))
+		panic(err)
+	}
+	enf, err := casbin.NewEnforcer(casbinModel)
+	if err != nil {
+		panic(err)
+	}
+	enforcer, err := auth.NewAuthEnforcer(enf, c.Security.JwtSecret)
+	if err != nil {
+		logx.Errorw("创建鉴权执行器失败", logx.Field(errors.ErrKey, err))
 		panic(err)
 	}
-	enforcer := auth.NewAuthEnforcer(enf, "")
 	enforcer.SetBlacklist(
 		auth.NewRedisBlacklist(
 			redisClient,
@@ -77,19 +129,39 @@ func NewServiceContext(c config.Config) *ServiceContext {
 			time.Duration(c.Security.TokenExpireMinutes)*time.Minute,
 		),
 	)
+	watcher, etcdClient, err := newPolicyWatcher(c, goredisClient)
+	if err != nil {
+		logx.Errorw("创建策略变更监听器失败", logx.Field("watcher", c.Security.PolicyWatcher), logx.Field(errors.ErrKey, err))
+		panic(err)
+	}
+	permService := NewPermissionService(db, enforcer)
+	menuService := NewMenuService(db, enforcer)
+	buttonService := NewButtonService(db, enforcer)
+	roleService := NewRoleService(db, enforcer)
+	userService := NewUserService(db, enforcer)
+	deptService := NewDeptService(db)
 	return &ServiceContext{
 		Config:     c,
 		db:         db,
 		redis:      redisClient,
 		goredis:    goredisClient,
 		enforcer:   enforcer,
+		adapter:    NewPolicyAdapter(permService, menuService, buttonService, roleService, userService),
+		watcher:    watcher,
+		etcd:       etcdClient,
 		instanceID: uuid.New().String(),
-		Perm:       NewPermissionService(db, enforcer),
-		Menu:       NewMenuService(db, enforcer),
-		Button:     NewButtonService(db, enforcer),
-		Role:       NewRoleService(db, enforcer),
-		User:       NewUserService(db, enforcer),
+		Perm:       permService,
+		Menu:       menuService,
+		Button:     buttonService,
+		Role:       roleService,
+		User:       userService,
+		UserGrant:  NewUserRoleGrantService(db),
+		RoleGrant:  NewRolePermissionGrantService(db),
+		Tenant:     NewTenantService(db),
+		Dept:       deptService,
+		Scope:      NewDataScopeService(userService, roleService, deptService),
 		Recode:     NewRecordService(db),
+		Captcha:    NewCaptchaService(c.Security.Captcha, redisClient),
 	}
 }
 
@@ -110,6 +182,20 @@ func (s *ServiceContext) Enforce() *auth.AuthEnforcer {
 }
 
 func (s *ServiceContext) Close() {
+	// 停止监听策略变更
+	if s.stopWatch != nil {
+		s.stopWatch()
+	}
+	if s.stopSweep != nil {
+		s.stopSweep()
+	}
+	s.watcher.Stop()
+	if s.etcd != nil {
+		if err := s.etcd.Close(); err != nil {
+			logx.Errorw("关闭etcd连接失败", logx.Field(errors.ErrKey, err))
+		}
+	}
+
 	// 关闭数据库连接
 	conn, err := s.db.DB()
 	if err != nil {
@@ -120,82 +206,4 @@ func (s *ServiceContext) Close() {
 	} else {
 		logx.Info("关闭数据库连接成功")
 	}
-
-}
-
-// 提供一个 HTTP 接口用于刷新 Casbin 策略
-func (s *ServiceContext) RefreshPolicies() error {
-	timeout := s.Config.Security.PolicyLoadTimeout
-	if timeout <= 0 {
-		timeout = 30 * time.Second
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-
-	if err := s.Perm.LoadPolicies(ctx); err != nil {
-		return err
-	}
-
-	if err := s.Menu.LoadPolicies(ctx); err != nil {
-		return err
-	}
-
-	if err := s.Button.LoadPolicies(ctx); err != nil {
-		return err
-	}
-
-	if err := s.Role.LoadPolicies(ctx); err != nil {
-		return err
-	}
-	return nil
-}
-
-// WatchCasbinPolicies 监听casbin策略变化信号
-func (s *ServiceContext) WatchCasbinPolicies() {
-	// 获取策略变更key，如果未配置则使用默认值
-	policyChangeKey := s.Config.Security.PolicyChangeKey
-	if policyChangeKey == "" {
-		policyChangeKey = DefaultPolicyChangeKey
-	}
-	go func() {
-		logx.Infow("开始监听casbin策略变更信号", logx.Field("channel", policyChangeKey))
-
-		// 使用Redis的Pub/Sub功能监听键空间事件
-		pubsub := s.goredis.Subscribe(context.Background(), policyChangeKey)
-		defer pubsub.Close()
-
-		ch := pubsub.Channel()
-		for msg := range ch {
-			if msg.Payload == s.instanceID {
-				continue
-			}
-			logx.Infow("收到Redis同步信号: 开始同步策略", logx.Field("message", msg.Payload))
-			if err := s.RefreshPolicies(); err != nil {
-				logx.Errorw("策略同步失败", logx.Field(errors.ErrKey, err))
-			}
-			logx.Infow("策略已同步完成")
-		}
-	}()
-}
-
-// NotifyPolicyChange 发送策略变更通知
-func (s *ServiceContext) NotifyPolicyChange() error {
-	// 获取策略变更key，如果未配置则使用默认值
-	policyChangeKey := s.Config.Security.PolicyChangeKey
-	if policyChangeKey == "" {
-		policyChangeKey = DefaultPolicyChangeKey
-	}
-
-	// 将信号写入etcd
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// 将信号发布到Redis频道
-	if err := s.goredis.Publish(ctx, policyChangeKey, s.instanceID).Err(); err != nil {
-		logx.Errorw("发送Redis同步信号失败", logx.Field(errors.ErrKey, err))
-		return err
-	}
-
-	logx.Info("策略变更信号发送成功")
-	return nil
 }
@@ -0,0 +1,113 @@
+package svc
+
+import (
+	"context"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+	"gz-dango/pkg/database"
+	"gz-dango/pkg/errors"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type TenantService struct {
+	gormDB *gorm.DB
+}
+
+func NewTenantService(gormDB *gorm.DB) *TenantService {
+	return &TenantService{
+		gormDB: gormDB,
+	}
+}
+
+func (s *TenantService) CreateModel(ctx context.Context, m *models.TenantModel) error {
+	now := time.Now()
+	m.CreatedAt = now
+	m.UpdatedAt = now
+	if err := database.DBCreate(ctx, s.gormDB, &models.TenantModel{}, m); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"新增租户模型失败",
+			logx.Field("name", m.Name),
+			logx.Field("is_active", m.IsActive),
+			logx.Field("descr", m.Descr),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *TenantService) UpdateModel(ctx context.Context, data map[string]any, conds ...any) error {
+	if err := database.DBUpdate(ctx, s.gormDB, &models.TenantModel{}, data, nil, conds...); err != nil {
+		fields := database.MapToLogFields(data)
+		fields = append(fields, logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("更新租户模型失败", fields...)
+		return err
+	}
+	return nil
+}
+
+func (s *TenantService) DeleteModel(ctx context.Context, conds ...any) error {
+	if err := database.DBDelete(ctx, s.gormDB, &models.TenantModel{}, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"删除租户模型失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *TenantService) FindModel(
+	ctx context.Context,
+	preloads []string,
+	conds ...any,
+) (*models.TenantModel, error) {
+	var m models.TenantModel
+	if err := database.DBFind(ctx, s.gormDB, preloads, &m, conds...); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"查询租户模型失败",
+			logx.Field(database.CondsKey, conds),
+			logx.Field(errors.ErrKey, err),
+		)
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (s *TenantService) ListModel(
+	ctx context.Context,
+	qp database.QueryParams,
+) (int64, []models.TenantModel, error) {
+	var ms []models.TenantModel
+	count, err := database.DBList(ctx, s.gormDB, &models.TenantModel{}, &ms, qp)
+	if err != nil {
+		fields := database.QPToLogFields(qp)
+		fields = append(fields, logx.Field(errors.ErrKey, err))
+		logx.WithContext(ctx).Errorw("查询租户列表失败", fields...)
+		return 0, nil, err
+	}
+	return count, ms, err
+}
+
+// DeleteModelWithDepts 在一个事务中删除租户及其部门，租户下的其他数据需先删除，见 TenantService.Dependents
+func (s *TenantService) DeleteModelWithDepts(ctx context.Context, id uint32) error {
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(database.TenantKey+" = ?", id).Delete(&models.DeptModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.TenantModel{}, id).Error
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorw(
+			"删除租户模型失败",
+			logx.Field("id", id),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
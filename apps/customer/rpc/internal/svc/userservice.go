@@ -2,6 +2,7 @@ package svc
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"gz-dango/apps/customer/rpc/internal/models"
@@ -38,7 +39,7 @@ func (s *UserService) CreateModel(ctx context.Context, m *models.UserModel) erro
 			logx.Field("username", m.Username),
 			logx.Field("is_active", m.IsActive),
 			logx.Field("is_staff", m.IsStaff),
-			logx.Field("role_id", m.RoleId),
+			logx.Field("role_ids", userModelRoleIds(m)),
 			logx.Field(errors.ErrKey, err),
 		)
 		return err
@@ -46,8 +47,8 @@ func (s *UserService) CreateModel(ctx context.Context, m *models.UserModel) erro
 	return nil
 }
 
-func (s *UserService) UpdateModel(ctx context.Context, data map[string]any, conds ...any) error {
-	if err := database.DBUpdate(ctx, s.gormDB, &models.UserModel{}, data, nil, conds...); err != nil {
+func (s *UserService) UpdateModel(ctx context.Context, data map[string]any, upmap map[string]any, conds ...any) error {
+	if err := database.DBUpdate(ctx, s.gormDB, &models.UserModel{}, data, upmap, conds...); err != nil {
 		fields := database.MapToLogFields(data)
 		fields = append(fields, logx.Field(errors.ErrKey, err))
 		logx.WithContext(ctx).Errorw("更新用户模型失败", fields...)
@@ -56,8 +57,28 @@ func (s *UserService) UpdateModel(ctx context.Context, data map[string]any, cond
 	return nil
 }
 
+// DeleteModel 在一个事务中删除用户及其角色关联，用户角色关联表的外键为限制删除
 func (s *UserService) DeleteModel(ctx context.Context, conds ...any) error {
-	if err := database.DBDelete(ctx, s.gormDB, &models.UserModel{}, conds...); err != nil {
+	if len(conds) == 0 {
+		return gorm.ErrMissingWhereClause
+	}
+	err := s.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ms []models.UserModel
+		if err := tx.Select("id").Find(&ms, conds...).Error; err != nil {
+			return err
+		}
+		ids := make([]uint32, 0, len(ms))
+		for _, m := range ms {
+			ids = append(ids, m.Id)
+		}
+		if len(ids) > 0 {
+			if err := tx.Exec("DELETE FROM customer_user_role WHERE user_id IN ?", ids).Error; err != nil {
+				return err
+			}
+		}
+		return database.DBDelete(ctx, tx, &models.UserModel{}, conds...)
+	})
+	if err != nil {
 		logx.WithContext(ctx).Errorw(
 			"删除用户模型失败",
 			logx.Field(database.CondsKey, conds),
@@ -100,6 +121,82 @@ func (s *UserService) ListModel(
 	return count, ms, err
 }
 
+// UserPolicyPreloads 生成用户策略需要预加载的关联数据
+var UserPolicyPreloads = []string{"Roles", "RoleGrants"}
+
+// ListEffectiveRoleIds 返回用户拥有的角色和在now时有效的临时角色，与用户的 g 规则一致，按ID升序且不重复
+func (s *UserService) ListEffectiveRoleIds(ctx context.Context, id uint32, now time.Time) ([]uint32, error) {
+	m, err := s.FindModel(ctx, UserPolicyPreloads, id)
+	if err != nil {
+		return nil, err
+	}
+	ids := userModelRoleIds(m)
+	for _, o := range m.RoleGrants {
+		if o.Active(now) {
+			ids = append(ids, o.RoleId)
+		}
+	}
+	return slices.Compact(slices.Sorted(slices.Values(ids))), nil
+}
+
+// listPolicyModels 查询生成策略所需的全部用户及其关联数据
+func (s *UserService) listPolicyModels(ctx context.Context) ([]models.UserModel, error) {
+	qp := database.QueryParams{
+		Preloads: UserPolicyPreloads,
+		Query:    nil,
+		OrderBy:  nil,
+		Limit:    0,
+		Offset:   0,
+		IsCount:  false,
+	}
+	_, ms, err := s.ListModel(ctx, qp)
+	return ms, err
+}
+
+func (s *UserService) AddGroupPolicy(
+	ctx context.Context,
+	m models.UserModel,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	rules := userGroupingRules(m)
+	if err := s.cache.AddGroupPolicies(rules); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"添加用户的关联策略失败",
+			logx.Field("user_id", m.Id),
+			logx.Field("rules", rules),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *UserService) RemoveGroupPolicy(
+	ctx context.Context,
+	m models.UserModel,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	sub := userModelToSub(m)
+	// 删除用户作为子级的策略（从角色继承）
+	if err := s.cache.RemoveGroupPolicy(0, sub); err != nil {
+		logx.WithContext(ctx).Errorw(
+			"删除用户作为子级策略失败(该策略继承自其他策略)",
+			logx.Field(auth.ObjKey, sub),
+			logx.Field(errors.ErrKey, err),
+		)
+		return err
+	}
+	return nil
+}
+
 func (s *UserService) AddToBlacklist(ctx context.Context, token string, seconds int) error {
 	if err := s.cache.AddToBlacklist(ctx, token, seconds); err != nil {
 		logx.WithContext(ctx).Errorw(
@@ -112,3 +209,54 @@ func (s *UserService) AddToBlacklist(ctx context.Context, token string, seconds
 	}
 	return nil
 }
+
+// ResetGroupPolicy 按业务表重建用户的 g 规则，用于临时角色生效、失效或被撤销后
+func (s *UserService) ResetGroupPolicy(ctx context.Context, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	qp := database.NewPksQueryParams(ids)
+	qp.Preloads = UserPolicyPreloads
+	_, ms, err := s.ListModel(ctx, qp)
+	if err != nil {
+		return err
+	}
+	for _, m := range ms {
+		if err := s.RemoveGroupPolicy(ctx, m); err != nil {
+			return err
+		}
+		if err := s.AddGroupPolicy(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func userModelToSub(m models.UserModel) string {
+	return auth.UserSubject(m.Id)
+}
+
+func userModelRoleIds(m *models.UserModel) []uint32 {
+	ids := make([]uint32, 0, len(m.Roles))
+	for _, r := range m.Roles {
+		ids = append(ids, r.Id)
+	}
+	return ids
+}
+
+// userGroupingRules 返回用户的 g 规则：用户继承其拥有的所有角色和当前有效的临时角色
+func userGroupingRules(m models.UserModel) [][]string {
+	sub := userModelToSub(m)
+	dom := auth.TenantDomain(m.TenantId)
+	rules := make([][]string, 0, len(m.Roles)+len(m.RoleGrants))
+	for _, o := range m.Roles {
+		rules = append(rules, []string{sub, roleModelToSub(o), dom})
+	}
+	now := time.Now()
+	for _, o := range m.RoleGrants {
+		if o.Active(now) {
+			rules = append(rules, []string{sub, roleIdToSub(o.RoleId), dom})
+		}
+	}
+	return rules
+}
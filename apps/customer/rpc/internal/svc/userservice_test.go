@@ -0,0 +1,45 @@
+package svc
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gz-dango/apps/customer/rpc/internal/models"
+)
+
+func TestListEffectiveRoleIds(t *testing.T) {
+	db := newTestDB(t)
+	now := time.Now()
+	roles := make([]models.RoleModel, 5)
+	for i := range roles {
+		roles[i] = models.RoleModel{Name: string(rune('a' + i))}
+	}
+	if err := db.Create(&roles).Error; err != nil {
+		t.Fatal(err)
+	}
+	user := models.UserModel{Username: "u", Password: "x", Roles: roles[:2]}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	grants := []models.UserRoleGrantModel{
+		{UserId: user.Id, RoleId: roles[2].Id, StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour)},
+		{UserId: user.Id, RoleId: roles[3].Id, StartAt: now.Add(-2 * time.Hour), EndAt: now.Add(-time.Hour)},
+		{UserId: user.Id, RoleId: roles[4].Id, StartAt: now.Add(time.Hour), EndAt: now.Add(2 * time.Hour)},
+		// 与直接拥有的角色重复
+		{UserId: user.Id, RoleId: roles[0].Id, StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour)},
+	}
+	if err := db.Create(&grants).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewUserService(db, nil).ListEffectiveRoleIds(context.Background(), user.Id, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint32{roles[0].Id, roles[1].Id, roles[2].Id}
+	if !slices.Equal(got, want) {
+		t.Fatalf("role ids = %v, want %v (direct roles and active grants only)", got, want)
+	}
+}
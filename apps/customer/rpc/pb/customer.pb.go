@@ -146,6 +146,213 @@ func (*NilOut) Descriptor() ([]byte, []int) {
 	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{2}
 }
 
+// 删除操作受影响的一类依赖项
+type DeleteDependent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // 依赖项类型：role、menu、button、user、dept、grant、permission
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Ids           []uint32               `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDependent) Reset() {
+	*x = DeleteDependent{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDependent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDependent) ProtoMessage() {}
+
+func (x *DeleteDependent) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDependent.ProtoReflect.Descriptor instead.
+func (*DeleteDependent) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteDependent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DeleteDependent) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DeleteDependent) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// 删除操作的结果，试运行时只返回依赖项，不删除数据
+type DeleteOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Dependents    []*DeleteDependent     `protobuf:"bytes,3,rep,name=dependents,proto3" json:"dependents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOut) Reset() {
+	*x = DeleteOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOut) ProtoMessage() {}
+
+func (x *DeleteOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOut.ProtoReflect.Descriptor instead.
+func (*DeleteOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteOut) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteOut) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DeleteOut) GetDependents() []*DeleteDependent {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
+// 策略的附加条件，所有已设置的条件都满足时策略才生效
+type PolicyConditions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidrs         []string               `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs,omitempty"`                                    // 允许的客户端网段，如 10.0.0.0/8
+	Weekdays      []int32                `protobuf:"varint,2,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`                      // 允许的星期，0为星期日
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`           // 每日开始时间 HH:MM
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                 // 每日结束时间 HH:MM，小于开始时间表示跨越零点
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                              // 时间条件所在时区，如 Asia/Shanghai，为空时使用服务器时区
+	RequireStaff  bool                   `protobuf:"varint,6,opt,name=require_staff,json=requireStaff,proto3" json:"require_staff,omitempty"` // 仅工作人员可用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyConditions) Reset() {
+	*x = PolicyConditions{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyConditions) ProtoMessage() {}
+
+func (x *PolicyConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyConditions.ProtoReflect.Descriptor instead.
+func (*PolicyConditions) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PolicyConditions) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *PolicyConditions) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *PolicyConditions) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *PolicyConditions) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *PolicyConditions) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PolicyConditions) GetRequireStaff() bool {
+	if x != nil {
+		return x.RequireStaff
+	}
+	return false
+}
+
 type CreatePermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -153,13 +360,17 @@ type CreatePermissionRequest struct {
 	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
 	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
 	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Matcher       string                 `protobuf:"bytes,7,opt,name=matcher,proto3" json:"matcher,omitempty"`       // URL匹配方式: exact / keyMatch2 / keyMatch5 / regex，默认 exact
+	Effect        string                 `protobuf:"bytes,8,opt,name=effect,proto3" json:"effect,omitempty"`         // 效果: allow / deny，默认 allow，拒绝优先于允许
+	Conditions    *PolicyConditions      `protobuf:"bytes,9,opt,name=conditions,proto3" json:"conditions,omitempty"` // 附加条件，为空时不限制
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePermissionRequest) Reset() {
 	*x = CreatePermissionRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[3]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -171,7 +382,7 @@ func (x *CreatePermissionRequest) String() string {
 func (*CreatePermissionRequest) ProtoMessage() {}
 
 func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[3]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,7 +395,7 @@ func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePermissionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{3}
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreatePermissionRequest) GetId() uint32 {
@@ -222,6 +433,34 @@ func (x *CreatePermissionRequest) GetDescr() string {
 	return ""
 }
 
+func (x *CreatePermissionRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *CreatePermissionRequest) GetMatcher() string {
+	if x != nil {
+		return x.Matcher
+	}
+	return ""
+}
+
+func (x *CreatePermissionRequest) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *CreatePermissionRequest) GetConditions() *PolicyConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 type UpdatePermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
@@ -229,13 +468,16 @@ type UpdatePermissionRequest struct {
 	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
 	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
 	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
+	Matcher       string                 `protobuf:"bytes,6,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	Effect        string                 `protobuf:"bytes,7,opt,name=effect,proto3" json:"effect,omitempty"`
+	Conditions    *PolicyConditions      `protobuf:"bytes,8,opt,name=conditions,proto3" json:"conditions,omitempty"` // 不传时保持原有条件，传空对象时清除条件
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePermissionRequest) Reset() {
 	*x = UpdatePermissionRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[4]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +489,7 @@ func (x *UpdatePermissionRequest) String() string {
 func (*UpdatePermissionRequest) ProtoMessage() {}
 
 func (x *UpdatePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[4]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +502,7 @@ func (x *UpdatePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePermissionRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{4}
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdatePermissionRequest) GetPk() uint32 {
@@ -298,6 +540,27 @@ func (x *UpdatePermissionRequest) GetDescr() string {
 	return ""
 }
 
+func (x *UpdatePermissionRequest) GetMatcher() string {
+	if x != nil {
+		return x.Matcher
+	}
+	return ""
+}
+
+func (x *UpdatePermissionRequest) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *UpdatePermissionRequest) GetConditions() *PolicyConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 type GetPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
@@ -307,7 +570,7 @@ type GetPermissionRequest struct {
 
 func (x *GetPermissionRequest) Reset() {
 	*x = GetPermissionRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[5]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +582,7 @@ func (x *GetPermissionRequest) String() string {
 func (*GetPermissionRequest) ProtoMessage() {}
 
 func (x *GetPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[5]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +595,7 @@ func (x *GetPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{5}
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPermissionRequest) GetPk() uint32 {
@@ -345,13 +608,15 @@ func (x *GetPermissionRequest) GetPk() uint32 {
 type DeletePermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回依赖项，不删除
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // 存在依赖项时仍然删除
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeletePermissionRequest) Reset() {
 	*x = DeletePermissionRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[6]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -363,7 +628,7 @@ func (x *DeletePermissionRequest) String() string {
 func (*DeletePermissionRequest) ProtoMessage() {}
 
 func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[6]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -376,7 +641,7 @@ func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePermissionRequest.ProtoReflect.Descriptor instead.
 func (*DeletePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{6}
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeletePermissionRequest) GetPk() uint32 {
@@ -386,6 +651,20 @@ func (x *DeletePermissionRequest) GetPk() uint32 {
 	return 0
 }
 
+func (x *DeletePermissionRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeletePermissionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type ListPermissionRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
@@ -400,13 +679,16 @@ type ListPermissionRequest struct {
 	Method          string                 `protobuf:"bytes,10,opt,name=method,proto3" json:"method,omitempty"`
 	Label           string                 `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
 	Descr           string                 `protobuf:"bytes,12,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId        *UInt32Value           `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Matcher         string                 `protobuf:"bytes,14,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	Effect          string                 `protobuf:"bytes,15,opt,name=effect,proto3" json:"effect,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListPermissionRequest) Reset() {
 	*x = ListPermissionRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[7]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +700,7 @@ func (x *ListPermissionRequest) String() string {
 func (*ListPermissionRequest) ProtoMessage() {}
 
 func (x *ListPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[7]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +713,7 @@ func (x *ListPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{7}
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListPermissionRequest) GetPage() int64 {
@@ -518,6 +800,27 @@ func (x *ListPermissionRequest) GetDescr() string {
 	return ""
 }
 
+func (x *ListPermissionRequest) GetTenantId() *UInt32Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+func (x *ListPermissionRequest) GetMatcher() string {
+	if x != nil {
+		return x.Matcher
+	}
+	return ""
+}
+
+func (x *ListPermissionRequest) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
 type PermissionOutBase struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -527,13 +830,17 @@ type PermissionOutBase struct {
 	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
 	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
 	Descr         string                 `protobuf:"bytes,7,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Matcher       string                 `protobuf:"bytes,9,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	Effect        string                 `protobuf:"bytes,10,opt,name=effect,proto3" json:"effect,omitempty"`
+	Conditions    *PolicyConditions      `protobuf:"bytes,11,opt,name=conditions,proto3" json:"conditions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermissionOutBase) Reset() {
 	*x = PermissionOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[8]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -545,7 +852,7 @@ func (x *PermissionOutBase) String() string {
 func (*PermissionOutBase) ProtoMessage() {}
 
 func (x *PermissionOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[8]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,7 +865,7 @@ func (x *PermissionOutBase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionOutBase.ProtoReflect.Descriptor instead.
 func (*PermissionOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{8}
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PermissionOutBase) GetId() uint32 {
@@ -610,21 +917,49 @@ func (x *PermissionOutBase) GetDescr() string {
 	return ""
 }
 
-type PagPermissionOutBase struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
-	Items         []*PermissionOutBase   `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PermissionOutBase) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
 }
 
-func (x *PagPermissionOutBase) Reset() {
-	*x = PagPermissionOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *PermissionOutBase) GetMatcher() string {
+	if x != nil {
+		return x.Matcher
+	}
+	return ""
+}
+
+func (x *PermissionOutBase) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *PermissionOutBase) GetConditions() *PolicyConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type PagPermissionOutBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	Items         []*PermissionOutBase   `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagPermissionOutBase) Reset() {
+	*x = PagPermissionOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
@@ -635,7 +970,7 @@ func (x *PagPermissionOutBase) String() string {
 func (*PagPermissionOutBase) ProtoMessage() {}
 
 func (x *PagPermissionOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[9]
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,7 +983,7 @@ func (x *PagPermissionOutBase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PagPermissionOutBase.ProtoReflect.Descriptor instead.
 func (*PagPermissionOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{9}
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PagPermissionOutBase) GetPage() int64 {
@@ -686,38 +1021,35 @@ func (x *PagPermissionOutBase) GetItems() []*PermissionOutBase {
 	return nil
 }
 
-type CreateMenuRequest struct {
+// 模拟用户或角色访问接口，返回鉴权结果及其依据
+type ExplainAccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Component     string                 `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Meta          *MetaSchemas           `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
-	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
-	ArrangeOrder  uint32                 `protobuf:"varint,7,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
-	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Descr         string                 `protobuf:"bytes,9,opt,name=descr,proto3" json:"descr,omitempty"`
-	ParentId      uint32                 `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	PermissionIds []uint32               `protobuf:"varint,11,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户和角色二选一
+	RoleId        uint32                 `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`                          // 模拟的客户端IP，为空时不满足网段条件
+	Time          string                 `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`                      // 模拟的请求时间 RFC3339，为空时使用当前时间
+	IsStaff       *BoolValue             `protobuf:"bytes,7,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"` // 模拟是否为工作人员，为空时使用用户的设置
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMenuRequest) Reset() {
-	*x = CreateMenuRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[10]
+func (x *ExplainAccessRequest) Reset() {
+	*x = ExplainAccessRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMenuRequest) String() string {
+func (x *ExplainAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMenuRequest) ProtoMessage() {}
+func (*ExplainAccessRequest) ProtoMessage() {}
 
-func (x *CreateMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[10]
+func (x *ExplainAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,120 +1060,163 @@ func (x *CreateMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMenuRequest.ProtoReflect.Descriptor instead.
-func (*CreateMenuRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExplainAccessRequest.ProtoReflect.Descriptor instead.
+func (*ExplainAccessRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateMenuRequest) GetId() uint32 {
+func (x *ExplainAccessRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *CreateMenuRequest) GetPath() string {
+func (x *ExplainAccessRequest) GetRoleId() uint32 {
 	if x != nil {
-		return x.Path
+		return x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateMenuRequest) GetComponent() string {
+func (x *ExplainAccessRequest) GetUrl() string {
 	if x != nil {
-		return x.Component
+		return x.Url
 	}
 	return ""
 }
 
-func (x *CreateMenuRequest) GetName() string {
+func (x *ExplainAccessRequest) GetMethod() string {
 	if x != nil {
-		return x.Name
+		return x.Method
 	}
 	return ""
 }
 
-func (x *CreateMenuRequest) GetMeta() *MetaSchemas {
+func (x *ExplainAccessRequest) GetIp() string {
 	if x != nil {
-		return x.Meta
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateMenuRequest) GetLabel() string {
+func (x *ExplainAccessRequest) GetTime() string {
 	if x != nil {
-		return x.Label
+		return x.Time
 	}
 	return ""
 }
 
-func (x *CreateMenuRequest) GetArrangeOrder() uint32 {
+func (x *ExplainAccessRequest) GetIsStaff() *BoolValue {
 	if x != nil {
-		return x.ArrangeOrder
+		return x.IsStaff
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateMenuRequest) GetIsActive() bool {
+// 继承链上的一个casbin主体
+type ExplainSubject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // user / role / menu / button / permission
+	Id            uint32                 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainSubject) Reset() {
+	*x = ExplainSubject{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainSubject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSubject) ProtoMessage() {}
+
+func (x *ExplainSubject) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[14]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateMenuRequest) GetDescr() string {
+// Deprecated: Use ExplainSubject.ProtoReflect.Descriptor instead.
+func (*ExplainSubject) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExplainSubject) GetSubject() string {
 	if x != nil {
-		return x.Descr
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *CreateMenuRequest) GetParentId() uint32 {
+func (x *ExplainSubject) GetKind() string {
 	if x != nil {
-		return x.ParentId
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateMenuRequest) GetPermissionIds() []uint32 {
+func (x *ExplainSubject) GetId() uint32 {
 	if x != nil {
-		return x.PermissionIds
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type UpdateMenuRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Component     string                 `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Meta          *MetaSchemas           `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
-	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
-	ArrangeOrder  uint32                 `protobuf:"varint,7,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
-	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Descr         string                 `protobuf:"bytes,9,opt,name=descr,proto3" json:"descr,omitempty"`
-	ParentId      uint32                 `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	PermissionIds []uint32               `protobuf:"varint,11,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ExplainSubject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *UpdateMenuRequest) Reset() {
-	*x = UpdateMenuRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[11]
+// 一条 p 规则对请求的各项匹配结果
+type ExplainRule struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Subject           *ExplainSubject        `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Domain            string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Url               string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Method            string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Matcher           string                 `protobuf:"bytes,5,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	Effect            string                 `protobuf:"bytes,6,opt,name=effect,proto3" json:"effect,omitempty"`
+	Conditions        string                 `protobuf:"bytes,7,opt,name=conditions,proto3" json:"conditions,omitempty"` // 规则的附加条件，JSON数组
+	Chain             []*ExplainSubject      `protobuf:"bytes,8,rep,name=chain,proto3" json:"chain,omitempty"`           // 从请求主体到规则主体的继承链，为空表示未继承该主体
+	DomainMatched     bool                   `protobuf:"varint,9,opt,name=domain_matched,json=domainMatched,proto3" json:"domain_matched,omitempty"`
+	PathMatched       bool                   `protobuf:"varint,10,opt,name=path_matched,json=pathMatched,proto3" json:"path_matched,omitempty"`
+	MethodMatched     bool                   `protobuf:"varint,11,opt,name=method_matched,json=methodMatched,proto3" json:"method_matched,omitempty"`
+	ConditionsMatched bool                   `protobuf:"varint,12,opt,name=conditions_matched,json=conditionsMatched,proto3" json:"conditions_matched,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExplainRule) Reset() {
+	*x = ExplainRule{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMenuRequest) String() string {
+func (x *ExplainRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMenuRequest) ProtoMessage() {}
+func (*ExplainRule) ProtoMessage() {}
 
-func (x *UpdateMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[11]
+func (x *ExplainRule) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,110 +1227,121 @@ func (x *UpdateMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMenuRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMenuRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ExplainRule.ProtoReflect.Descriptor instead.
+func (*ExplainRule) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateMenuRequest) GetPk() uint32 {
+func (x *ExplainRule) GetSubject() *ExplainSubject {
 	if x != nil {
-		return x.Pk
+		return x.Subject
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateMenuRequest) GetPath() string {
+func (x *ExplainRule) GetDomain() string {
 	if x != nil {
-		return x.Path
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *UpdateMenuRequest) GetComponent() string {
+func (x *ExplainRule) GetUrl() string {
 	if x != nil {
-		return x.Component
+		return x.Url
 	}
 	return ""
 }
 
-func (x *UpdateMenuRequest) GetName() string {
+func (x *ExplainRule) GetMethod() string {
 	if x != nil {
-		return x.Name
+		return x.Method
 	}
 	return ""
 }
 
-func (x *UpdateMenuRequest) GetMeta() *MetaSchemas {
+func (x *ExplainRule) GetMatcher() string {
 	if x != nil {
-		return x.Meta
+		return x.Matcher
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateMenuRequest) GetLabel() string {
+func (x *ExplainRule) GetEffect() string {
 	if x != nil {
-		return x.Label
+		return x.Effect
 	}
 	return ""
 }
 
-func (x *UpdateMenuRequest) GetArrangeOrder() uint32 {
+func (x *ExplainRule) GetConditions() string {
 	if x != nil {
-		return x.ArrangeOrder
+		return x.Conditions
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateMenuRequest) GetIsActive() bool {
+func (x *ExplainRule) GetChain() []*ExplainSubject {
 	if x != nil {
-		return x.IsActive
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *ExplainRule) GetDomainMatched() bool {
+	if x != nil {
+		return x.DomainMatched
 	}
 	return false
 }
 
-func (x *UpdateMenuRequest) GetDescr() string {
+func (x *ExplainRule) GetPathMatched() bool {
 	if x != nil {
-		return x.Descr
+		return x.PathMatched
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateMenuRequest) GetParentId() uint32 {
+func (x *ExplainRule) GetMethodMatched() bool {
 	if x != nil {
-		return x.ParentId
+		return x.MethodMatched
 	}
-	return 0
+	return false
 }
 
-func (x *UpdateMenuRequest) GetPermissionIds() []uint32 {
+func (x *ExplainRule) GetConditionsMatched() bool {
 	if x != nil {
-		return x.PermissionIds
+		return x.ConditionsMatched
 	}
-	return nil
+	return false
 }
 
-type DeleteMenuRequest struct {
+type ExplainAccessOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Matched       []*ExplainRule         `protobuf:"bytes,4,rep,name=matched,proto3" json:"matched,omitempty"`                         // 命中的规则，存在拒绝规则时拒绝优先
+	NearMisses    []*ExplainRule         `protobuf:"bytes,5,rep,name=near_misses,json=nearMisses,proto3" json:"near_misses,omitempty"` // 拒绝访问时只有一两项不满足的规则
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMenuRequest) Reset() {
-	*x = DeleteMenuRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[12]
+func (x *ExplainAccessOut) Reset() {
+	*x = ExplainAccessOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMenuRequest) String() string {
+func (x *ExplainAccessOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMenuRequest) ProtoMessage() {}
+func (*ExplainAccessOut) ProtoMessage() {}
 
-func (x *DeleteMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[12]
+func (x *ExplainAccessOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,40 +1352,68 @@ func (x *DeleteMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMenuRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMenuRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExplainAccessOut.ProtoReflect.Descriptor instead.
+func (*ExplainAccessOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteMenuRequest) GetPk() uint32 {
+func (x *ExplainAccessOut) GetAllowed() bool {
 	if x != nil {
-		return x.Pk
+		return x.Allowed
 	}
-	return 0
+	return false
 }
 
-type GetMenuRequest struct {
+func (x *ExplainAccessOut) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ExplainAccessOut) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ExplainAccessOut) GetMatched() []*ExplainRule {
+	if x != nil {
+		return x.Matched
+	}
+	return nil
+}
+
+func (x *ExplainAccessOut) GetNearMisses() []*ExplainRule {
+	if x != nil {
+		return x.NearMisses
+	}
+	return nil
+}
+
+type SyncPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回差异，不写入数据
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMenuRequest) Reset() {
-	*x = GetMenuRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[13]
+func (x *SyncPermissionsRequest) Reset() {
+	*x = SyncPermissionsRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMenuRequest) String() string {
+func (x *SyncPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuRequest) ProtoMessage() {}
+func (*SyncPermissionsRequest) ProtoMessage() {}
 
-func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[13]
+func (x *SyncPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,54 +1424,43 @@ func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMenuRequest.ProtoReflect.Descriptor instead.
-func (*GetMenuRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SyncPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SyncPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetMenuRequest) GetPk() uint32 {
+func (x *SyncPermissionsRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Pk
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type ListMenuRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
-	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
-	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
-	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
-	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
-	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
-	Path            string                 `protobuf:"bytes,9,opt,name=path,proto3" json:"path,omitempty"`
-	Component       string                 `protobuf:"bytes,10,opt,name=component,proto3" json:"component,omitempty"`
-	Name            string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
-	Label           string                 `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
-	IsActive        *BoolValue             `protobuf:"bytes,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Descr           string                 `protobuf:"bytes,14,opt,name=descr,proto3" json:"descr,omitempty"`
-	ParentId        *UInt32Value           `protobuf:"bytes,15,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SyncPermissionsOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Created       []*PermissionOutBase   `protobuf:"bytes,2,rep,name=created,proto3" json:"created,omitempty"` // 新增的权限，试运行时ID为0
+	Updated       []*PermissionOutBase   `protobuf:"bytes,3,rep,name=updated,proto3" json:"updated,omitempty"` // 补充了标签或描述的权限
+	Stale         []*PermissionOutBase   `protobuf:"bytes,4,rep,name=stale,proto3" json:"stale,omitempty"`     // 接口已不存在的权限，需人工确认后删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMenuRequest) Reset() {
-	*x = ListMenuRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[14]
+func (x *SyncPermissionsOut) Reset() {
+	*x = SyncPermissionsOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMenuRequest) String() string {
+func (x *SyncPermissionsOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMenuRequest) ProtoMessage() {}
+func (*SyncPermissionsOut) ProtoMessage() {}
 
-func (x *ListMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[14]
+func (x *SyncPermissionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,139 +1471,203 @@ func (x *ListMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMenuRequest.ProtoReflect.Descriptor instead.
-func (*ListMenuRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SyncPermissionsOut.ProtoReflect.Descriptor instead.
+func (*SyncPermissionsOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListMenuRequest) GetPage() int64 {
+func (x *SyncPermissionsOut) GetApplied() bool {
 	if x != nil {
-		return x.Page
+		return x.Applied
 	}
-	return 0
+	return false
 }
 
-func (x *ListMenuRequest) GetSize() int64 {
+func (x *SyncPermissionsOut) GetCreated() []*PermissionOutBase {
 	if x != nil {
-		return x.Size
+		return x.Created
 	}
-	return 0
+	return nil
 }
 
-func (x *ListMenuRequest) GetPk() uint32 {
+func (x *SyncPermissionsOut) GetUpdated() []*PermissionOutBase {
 	if x != nil {
-		return x.Pk
+		return x.Updated
 	}
-	return 0
+	return nil
 }
 
-func (x *ListMenuRequest) GetPks() string {
+func (x *SyncPermissionsOut) GetStale() []*PermissionOutBase {
 	if x != nil {
-		return x.Pks
+		return x.Stale
 	}
-	return ""
+	return nil
 }
 
-func (x *ListMenuRequest) GetBeforeCreatedAt() string {
-	if x != nil {
-		return x.BeforeCreatedAt
-	}
-	return ""
+type CreateMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Component     string                 `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Meta          *MetaSchemas           `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,7,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,9,opt,name=descr,proto3" json:"descr,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	PermissionIds []uint32               `protobuf:"varint,11,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,12,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMenuRequest) GetAfterCreatedAt() string {
-	if x != nil {
-		return x.AfterCreatedAt
-	}
-	return ""
+func (x *CreateMenuRequest) Reset() {
+	*x = CreateMenuRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMenuRequest) GetBeforeUpdatedAt() string {
+func (x *CreateMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuRequest) ProtoMessage() {}
+
+func (x *CreateMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[19]
 	if x != nil {
-		return x.BeforeUpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListMenuRequest) GetAfterUpdatedAt() string {
+// Deprecated: Use CreateMenuRequest.ProtoReflect.Descriptor instead.
+func (*CreateMenuRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateMenuRequest) GetId() uint32 {
 	if x != nil {
-		return x.AfterUpdatedAt
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMenuRequest) GetPath() string {
+func (x *CreateMenuRequest) GetPath() string {
 	if x != nil {
 		return x.Path
 	}
 	return ""
 }
 
-func (x *ListMenuRequest) GetComponent() string {
+func (x *CreateMenuRequest) GetComponent() string {
 	if x != nil {
 		return x.Component
 	}
 	return ""
 }
 
-func (x *ListMenuRequest) GetName() string {
+func (x *CreateMenuRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ListMenuRequest) GetLabel() string {
+func (x *CreateMenuRequest) GetMeta() *MetaSchemas {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateMenuRequest) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *ListMenuRequest) GetIsActive() *BoolValue {
+func (x *CreateMenuRequest) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *CreateMenuRequest) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
 	}
-	return nil
+	return false
 }
 
-func (x *ListMenuRequest) GetDescr() string {
+func (x *CreateMenuRequest) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-func (x *ListMenuRequest) GetParentId() *UInt32Value {
+func (x *CreateMenuRequest) GetParentId() uint32 {
 	if x != nil {
 		return x.ParentId
 	}
+	return 0
+}
+
+func (x *CreateMenuRequest) GetPermissionIds() []uint32 {
+	if x != nil {
+		return x.PermissionIds
+	}
 	return nil
 }
 
-type MetaSchemas struct {
+func (x *CreateMenuRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type UpdateMenuRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Icon          string                 `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Component     string                 `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Meta          *MetaSchemas           `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,7,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,9,opt,name=descr,proto3" json:"descr,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	PermissionIds []uint32               `protobuf:"varint,11,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MetaSchemas) Reset() {
-	*x = MetaSchemas{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[15]
+func (x *UpdateMenuRequest) Reset() {
+	*x = UpdateMenuRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MetaSchemas) String() string {
+func (x *UpdateMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetaSchemas) ProtoMessage() {}
+func (*UpdateMenuRequest) ProtoMessage() {}
 
-func (x *MetaSchemas) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[15]
+func (x *UpdateMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,57 +1678,4894 @@ func (x *MetaSchemas) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetaSchemas.ProtoReflect.Descriptor instead.
-func (*MetaSchemas) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateMenuRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMenuRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MetaSchemas) GetTitle() string {
+func (x *UpdateMenuRequest) GetPk() uint32 {
 	if x != nil {
-		return x.Title
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *UpdateMenuRequest) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-func (x *MetaSchemas) GetIcon() string {
+func (x *UpdateMenuRequest) GetComponent() string {
 	if x != nil {
-		return x.Icon
+		return x.Component
 	}
 	return ""
 }
 
-type MenuOutBase struct {
+func (x *UpdateMenuRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMenuRequest) GetMeta() *MetaSchemas {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateMenuRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpdateMenuRequest) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *UpdateMenuRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateMenuRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *UpdateMenuRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateMenuRequest) GetPermissionIds() []uint32 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+type DeleteMenuRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回依赖项，不删除
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // 存在依赖项时仍然删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuRequest) Reset() {
+	*x = DeleteMenuRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuRequest) ProtoMessage() {}
+
+func (x *DeleteMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMenuRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteMenuRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *DeleteMenuRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteMenuRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuRequest) Reset() {
+	*x = GetMenuRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuRequest) ProtoMessage() {}
+
+func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetMenuRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+type ListMenuRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
+	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
+	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
+	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
+	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
+	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
+	Path            string                 `protobuf:"bytes,9,opt,name=path,proto3" json:"path,omitempty"`
+	Component       string                 `protobuf:"bytes,10,opt,name=component,proto3" json:"component,omitempty"`
+	Name            string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	Label           string                 `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
+	IsActive        *BoolValue             `protobuf:"bytes,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr           string                 `protobuf:"bytes,14,opt,name=descr,proto3" json:"descr,omitempty"`
+	ParentId        *UInt32Value           `protobuf:"bytes,15,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	TenantId        *UInt32Value           `protobuf:"bytes,16,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMenuRequest) Reset() {
+	*x = ListMenuRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMenuRequest) ProtoMessage() {}
+
+func (x *ListMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMenuRequest.ProtoReflect.Descriptor instead.
+func (*ListMenuRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMenuRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMenuRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListMenuRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *ListMenuRequest) GetPks() string {
+	if x != nil {
+		return x.Pks
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetBeforeCreatedAt() string {
+	if x != nil {
+		return x.BeforeCreatedAt
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetAfterCreatedAt() string {
+	if x != nil {
+		return x.AfterCreatedAt
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetBeforeUpdatedAt() string {
+	if x != nil {
+		return x.BeforeUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetAfterUpdatedAt() string {
+	if x != nil {
+		return x.AfterUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetIsActive() *BoolValue {
+	if x != nil {
+		return x.IsActive
+	}
+	return nil
+}
+
+func (x *ListMenuRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ListMenuRequest) GetParentId() *UInt32Value {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *ListMenuRequest) GetTenantId() *UInt32Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type ExportMenuRoutesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        uint32                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"` // 导出授予该角色及其祖先角色的菜单
+	Mine          bool                   `protobuf:"varint,2,opt,name=mine,proto3" json:"mine,omitempty"`                   // 导出授予调用方角色的菜单，不能与role_id同时指定
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMenuRoutesRequest) Reset() {
+	*x = ExportMenuRoutesRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMenuRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMenuRoutesRequest) ProtoMessage() {}
+
+func (x *ExportMenuRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMenuRoutesRequest.ProtoReflect.Descriptor instead.
+func (*ExportMenuRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportMenuRoutesRequest) GetRoleId() uint32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *ExportMenuRoutesRequest) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+type MenuRoutesOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // 前端路由的JSON数组
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuRoutesOut) Reset() {
+	*x = MenuRoutesOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuRoutesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuRoutesOut) ProtoMessage() {}
+
+func (x *MenuRoutesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuRoutesOut.ProtoReflect.Descriptor instead.
+func (*MenuRoutesOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MenuRoutesOut) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type MoveMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`             // 为0时移动到顶层
+	ArrangeOrder  uint32                 `protobuf:"varint,3,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"` // 新位置的排序值，之后的同级菜单依次后移
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveMenuRequest) Reset() {
+	*x = MoveMenuRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveMenuRequest) ProtoMessage() {}
+
+func (x *MoveMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveMenuRequest.ProtoReflect.Descriptor instead.
+func (*MoveMenuRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MoveMenuRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *MoveMenuRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *MoveMenuRequest) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+type MenuOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,2,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuOrder) Reset() {
+	*x = MenuOrder{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuOrder) ProtoMessage() {}
+
+func (x *MenuOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuOrder.ProtoReflect.Descriptor instead.
+func (*MenuOrder) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MenuOrder) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MenuOrder) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+type ReorderMenusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      uint32                 `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 同级菜单的父级，为0时为顶层菜单
+	Items         []*MenuOrder           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderMenusRequest) Reset() {
+	*x = ReorderMenusRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderMenusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderMenusRequest) ProtoMessage() {}
+
+func (x *ReorderMenusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderMenusRequest.ProtoReflect.Descriptor instead.
+func (*ReorderMenusRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReorderMenusRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ReorderMenusRequest) GetItems() []*MenuOrder {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetMenuTreeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WithButtons     bool                   `protobuf:"varint,1,opt,name=with_buttons,json=withButtons,proto3" json:"with_buttons,omitempty"`             // 返回菜单下的按钮
+	WithPermissions bool                   `protobuf:"varint,2,opt,name=with_permissions,json=withPermissions,proto3" json:"with_permissions,omitempty"` // 返回菜单和按钮关联的权限
+	ActiveOnly      bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`                // 只返回激活的菜单和按钮
+	RoleId          uint32                 `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`                            // 只返回授予该角色及其祖先角色的菜单和按钮
+	Mine            bool                   `protobuf:"varint,5,opt,name=mine,proto3" json:"mine,omitempty"`                                              // 只返回授予调用方角色的菜单和按钮，不能与role_id同时指定
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMenuTreeRequest) Reset() {
+	*x = GetMenuTreeRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuTreeRequest) ProtoMessage() {}
+
+func (x *GetMenuTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuTreeRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMenuTreeRequest) GetWithButtons() bool {
+	if x != nil {
+		return x.WithButtons
+	}
+	return false
+}
+
+func (x *GetMenuTreeRequest) GetWithPermissions() bool {
+	if x != nil {
+		return x.WithPermissions
+	}
+	return false
+}
+
+func (x *GetMenuTreeRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *GetMenuTreeRequest) GetRoleId() uint32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *GetMenuTreeRequest) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+type MetaSchemas struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // 未设置时为菜单名称
+	Icon          string                 `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+	Hidden        bool                   `protobuf:"varint,3,opt,name=hidden,proto3" json:"hidden,omitempty"`                                                                           // 不在菜单中显示
+	KeepAlive     bool                   `protobuf:"varint,4,opt,name=keep_alive,json=keepAlive,proto3" json:"keep_alive,omitempty"`                                                    // 缓存页面组件
+	Redirect      string                 `protobuf:"bytes,5,opt,name=redirect,proto3" json:"redirect,omitempty"`                                                                        // 访问时重定向的路径，以 / 开头
+	Affix         bool                   `protobuf:"varint,6,opt,name=affix,proto3" json:"affix,omitempty"`                                                                             // 固定在标签栏
+	ExternalLink  string                 `protobuf:"bytes,7,opt,name=external_link,json=externalLink,proto3" json:"external_link,omitempty"`                                            // 外部链接，http 或 https 地址
+	Badge         string                 `protobuf:"bytes,8,opt,name=badge,proto3" json:"badge,omitempty"`                                                                              // 菜单上显示的徽标
+	ActiveMenu    string                 `protobuf:"bytes,9,opt,name=active_menu,json=activeMenu,proto3" json:"active_menu,omitempty"`                                                  // 访问时高亮的菜单路径，以 / 开头
+	Titles        map[string]string      `protobuf:"bytes,10,rep,name=titles,proto3" json:"titles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 各语言的标题，键为语言标签
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetaSchemas) Reset() {
+	*x = MetaSchemas{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetaSchemas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetaSchemas) ProtoMessage() {}
+
+func (x *MetaSchemas) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetaSchemas.ProtoReflect.Descriptor instead.
+func (*MetaSchemas) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MetaSchemas) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MetaSchemas) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *MetaSchemas) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *MetaSchemas) GetKeepAlive() bool {
+	if x != nil {
+		return x.KeepAlive
+	}
+	return false
+}
+
+func (x *MetaSchemas) GetRedirect() string {
+	if x != nil {
+		return x.Redirect
+	}
+	return ""
+}
+
+func (x *MetaSchemas) GetAffix() bool {
+	if x != nil {
+		return x.Affix
+	}
+	return false
+}
+
+func (x *MetaSchemas) GetExternalLink() string {
+	if x != nil {
+		return x.ExternalLink
+	}
+	return ""
+}
+
+func (x *MetaSchemas) GetBadge() string {
+	if x != nil {
+		return x.Badge
+	}
+	return ""
+}
+
+func (x *MetaSchemas) GetActiveMenu() string {
+	if x != nil {
+		return x.ActiveMenu
+	}
+	return ""
+}
+
+func (x *MetaSchemas) GetTitles() map[string]string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+type MenuOutBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Meta          *MetaSchemas           `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	Label         string                 `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,9,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,11,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,12,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuOutBase) Reset() {
+	*x = MenuOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuOutBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuOutBase) ProtoMessage() {}
+
+func (x *MenuOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuOutBase.ProtoReflect.Descriptor instead.
+func (*MenuOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MenuOutBase) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MenuOutBase) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MenuOutBase) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *MenuOutBase) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MenuOutBase) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *MenuOutBase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuOutBase) GetMeta() *MetaSchemas {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *MenuOutBase) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MenuOutBase) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *MenuOutBase) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *MenuOutBase) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *MenuOutBase) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type MenuTreeButton struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Button        *ButtonOutBase         `protobuf:"bytes,1,opt,name=button,proto3" json:"button,omitempty"`
+	Permissions   []*PermissionOutBase   `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuTreeButton) Reset() {
+	*x = MenuTreeButton{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuTreeButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuTreeButton) ProtoMessage() {}
+
+func (x *MenuTreeButton) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuTreeButton.ProtoReflect.Descriptor instead.
+func (*MenuTreeButton) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MenuTreeButton) GetButton() *ButtonOutBase {
+	if x != nil {
+		return x.Button
+	}
+	return nil
+}
+
+func (x *MenuTreeButton) GetPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type MenuTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Menu          *MenuOutBase           `protobuf:"bytes,1,opt,name=menu,proto3" json:"menu,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 根节点为0
+	Permissions   []*PermissionOutBase   `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Buttons       []*MenuTreeButton      `protobuf:"bytes,4,rep,name=buttons,proto3" json:"buttons,omitempty"`
+	Children      []*MenuTreeNode        `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuTreeNode) Reset() {
+	*x = MenuTreeNode{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuTreeNode) ProtoMessage() {}
+
+func (x *MenuTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuTreeNode.ProtoReflect.Descriptor instead.
+func (*MenuTreeNode) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MenuTreeNode) GetMenu() *MenuOutBase {
+	if x != nil {
+		return x.Menu
+	}
+	return nil
+}
+
+func (x *MenuTreeNode) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *MenuTreeNode) GetPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *MenuTreeNode) GetButtons() []*MenuTreeButton {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+func (x *MenuTreeNode) GetChildren() []*MenuTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type MenuTreeOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MenuTreeNode        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuTreeOut) Reset() {
+	*x = MenuTreeOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuTreeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuTreeOut) ProtoMessage() {}
+
+func (x *MenuTreeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuTreeOut.ProtoReflect.Descriptor instead.
+func (*MenuTreeOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MenuTreeOut) GetItems() []*MenuTreeNode {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MenuOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Meta          *MetaSchemas           `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	Label         string                 `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,9,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,11,opt,name=descr,proto3" json:"descr,omitempty"`
+	Parent        *MenuOutBase           `protobuf:"bytes,12,opt,name=parent,proto3" json:"parent,omitempty"`
+	Permissions   []*PermissionOutBase   `protobuf:"bytes,13,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,14,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuOut) Reset() {
+	*x = MenuOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuOut) ProtoMessage() {}
+
+func (x *MenuOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuOut.ProtoReflect.Descriptor instead.
+func (*MenuOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MenuOut) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MenuOut) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MenuOut) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *MenuOut) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MenuOut) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *MenuOut) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuOut) GetMeta() *MetaSchemas {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *MenuOut) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MenuOut) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *MenuOut) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *MenuOut) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *MenuOut) GetParent() *MenuOutBase {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *MenuOut) GetPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *MenuOut) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type PagMenuOutBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	Items         []*MenuOutBase         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagMenuOutBase) Reset() {
+	*x = PagMenuOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PagMenuOutBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagMenuOutBase) ProtoMessage() {}
+
+func (x *PagMenuOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagMenuOutBase.ProtoReflect.Descriptor instead.
+func (*PagMenuOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PagMenuOutBase) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PagMenuOutBase) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PagMenuOutBase) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PagMenuOutBase) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *PagMenuOutBase) GetItems() []*MenuOutBase {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateButtonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,3,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
+	MenuId        uint32                 `protobuf:"varint,6,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	PermissionIds []uint32               `protobuf:"varint,7,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Code          string                 `protobuf:"bytes,9,opt,name=code,proto3" json:"code,omitempty"` // 权限标识，如 user:create，同一菜单下唯一
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateButtonRequest) Reset() {
+	*x = CreateButtonRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateButtonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateButtonRequest) ProtoMessage() {}
+
+func (x *CreateButtonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateButtonRequest.ProtoReflect.Descriptor instead.
+func (*CreateButtonRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateButtonRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateButtonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateButtonRequest) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *CreateButtonRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateButtonRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *CreateButtonRequest) GetMenuId() uint32 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *CreateButtonRequest) GetPermissionIds() []uint32 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+func (x *CreateButtonRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *CreateButtonRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type UpdateButtonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,3,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
+	MenuId        uint32                 `protobuf:"varint,6,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	PermissionIds []uint32               `protobuf:"varint,7,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	Code          string                 `protobuf:"bytes,8,opt,name=code,proto3" json:"code,omitempty"` // 权限标识，如 user:create，同一菜单下唯一
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateButtonRequest) Reset() {
+	*x = UpdateButtonRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateButtonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateButtonRequest) ProtoMessage() {}
+
+func (x *UpdateButtonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateButtonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateButtonRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateButtonRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *UpdateButtonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateButtonRequest) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *UpdateButtonRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateButtonRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *UpdateButtonRequest) GetMenuId() uint32 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *UpdateButtonRequest) GetPermissionIds() []uint32 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+func (x *UpdateButtonRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteButtonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回依赖项，不删除
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // 存在依赖项时仍然删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteButtonRequest) Reset() {
+	*x = DeleteButtonRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteButtonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteButtonRequest) ProtoMessage() {}
+
+func (x *DeleteButtonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteButtonRequest.ProtoReflect.Descriptor instead.
+func (*DeleteButtonRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteButtonRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *DeleteButtonRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteButtonRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetButtonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetButtonRequest) Reset() {
+	*x = GetButtonRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetButtonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetButtonRequest) ProtoMessage() {}
+
+func (x *GetButtonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetButtonRequest.ProtoReflect.Descriptor instead.
+func (*GetButtonRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetButtonRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+type ListButtonRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
+	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
+	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
+	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
+	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
+	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
+	Name            string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive        *BoolValue             `protobuf:"bytes,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr           string                 `protobuf:"bytes,11,opt,name=descr,proto3" json:"descr,omitempty"`
+	MenuId          uint32                 `protobuf:"varint,12,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	TenantId        *UInt32Value           `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Code            string                 `protobuf:"bytes,14,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListButtonRequest) Reset() {
+	*x = ListButtonRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListButtonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListButtonRequest) ProtoMessage() {}
+
+func (x *ListButtonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListButtonRequest.ProtoReflect.Descriptor instead.
+func (*ListButtonRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListButtonRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListButtonRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListButtonRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *ListButtonRequest) GetPks() string {
+	if x != nil {
+		return x.Pks
+	}
+	return ""
+}
+
+func (x *ListButtonRequest) GetBeforeCreatedAt() string {
+	if x != nil {
+		return x.BeforeCreatedAt
+	}
+	return ""
+}
+
+func (x *ListButtonRequest) GetAfterCreatedAt() string {
+	if x != nil {
+		return x.AfterCreatedAt
+	}
+	return ""
+}
+
+func (x *ListButtonRequest) GetBeforeUpdatedAt() string {
+	if x != nil {
+		return x.BeforeUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListButtonRequest) GetAfterUpdatedAt() string {
+	if x != nil {
+		return x.AfterUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListButtonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListButtonRequest) GetIsActive() *BoolValue {
+	if x != nil {
+		return x.IsActive
+	}
+	return nil
+}
+
+func (x *ListButtonRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ListButtonRequest) GetMenuId() uint32 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *ListButtonRequest) GetTenantId() *UInt32Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+func (x *ListButtonRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ButtonOutBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,5,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,7,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Code          string                 `protobuf:"bytes,9,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ButtonOutBase) Reset() {
+	*x = ButtonOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ButtonOutBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ButtonOutBase) ProtoMessage() {}
+
+func (x *ButtonOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ButtonOutBase.ProtoReflect.Descriptor instead.
+func (*ButtonOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ButtonOutBase) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ButtonOutBase) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ButtonOutBase) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ButtonOutBase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ButtonOutBase) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *ButtonOutBase) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ButtonOutBase) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ButtonOutBase) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ButtonOutBase) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ButtonOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,5,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,7,opt,name=descr,proto3" json:"descr,omitempty"`
+	Menu          *MenuOutBase           `protobuf:"bytes,8,opt,name=menu,proto3" json:"menu,omitempty"`
+	Permissions   []*PermissionOutBase   `protobuf:"bytes,9,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,10,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Code          string                 `protobuf:"bytes,11,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ButtonOut) Reset() {
+	*x = ButtonOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ButtonOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ButtonOut) ProtoMessage() {}
+
+func (x *ButtonOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ButtonOut.ProtoReflect.Descriptor instead.
+func (*ButtonOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ButtonOut) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ButtonOut) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ButtonOut) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ButtonOut) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ButtonOut) GetArrangeOrder() uint32 {
+	if x != nil {
+		return x.ArrangeOrder
+	}
+	return 0
+}
+
+func (x *ButtonOut) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ButtonOut) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ButtonOut) GetMenu() *MenuOutBase {
+	if x != nil {
+		return x.Menu
+	}
+	return nil
+}
+
+func (x *ButtonOut) GetPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ButtonOut) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ButtonOut) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListAllowedButtonCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllowedButtonCodesRequest) Reset() {
+	*x = ListAllowedButtonCodesRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllowedButtonCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllowedButtonCodesRequest) ProtoMessage() {}
+
+func (x *ListAllowedButtonCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllowedButtonCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllowedButtonCodesRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{44}
+}
+
+type MenuButtonCodes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuId        uint32                 `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	MenuName      string                 `protobuf:"bytes,2,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	MenuPath      string                 `protobuf:"bytes,3,opt,name=menu_path,json=menuPath,proto3" json:"menu_path,omitempty"`
+	Codes         []string               `protobuf:"bytes,4,rep,name=codes,proto3" json:"codes,omitempty"` // 按按钮的排序值升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuButtonCodes) Reset() {
+	*x = MenuButtonCodes{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuButtonCodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuButtonCodes) ProtoMessage() {}
+
+func (x *MenuButtonCodes) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuButtonCodes.ProtoReflect.Descriptor instead.
+func (*MenuButtonCodes) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MenuButtonCodes) GetMenuId() uint32 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *MenuButtonCodes) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *MenuButtonCodes) GetMenuPath() string {
+	if x != nil {
+		return x.MenuPath
+	}
+	return ""
+}
+
+func (x *MenuButtonCodes) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type AllowedButtonCodesOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MenuButtonCodes     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // 按菜单的排序值升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllowedButtonCodesOut) Reset() {
+	*x = AllowedButtonCodesOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowedButtonCodesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedButtonCodesOut) ProtoMessage() {}
+
+func (x *AllowedButtonCodesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedButtonCodesOut.ProtoReflect.Descriptor instead.
+func (*AllowedButtonCodesOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AllowedButtonCodesOut) GetItems() []*MenuButtonCodes {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PagButtonOutBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	Items         []*ButtonOutBase       `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagButtonOutBase) Reset() {
+	*x = PagButtonOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PagButtonOutBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagButtonOutBase) ProtoMessage() {}
+
+func (x *PagButtonOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagButtonOutBase.ProtoReflect.Descriptor instead.
+func (*PagButtonOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PagButtonOutBase) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PagButtonOutBase) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PagButtonOutBase) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PagButtonOutBase) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *PagButtonOutBase) GetItems() []*ButtonOutBase {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateRoleRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr             string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	PermissionIds     []uint32               `protobuf:"varint,3,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	MenuIds           []uint32               `protobuf:"varint,4,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids,omitempty"`
+	ButtonIds         []uint32               `protobuf:"varint,5,rep,packed,name=button_ids,json=buttonIds,proto3" json:"button_ids,omitempty"`
+	ParentIds         []uint32               `protobuf:"varint,6,rep,packed,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	TenantId          uint32                 `protobuf:"varint,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DataScope         string                 `protobuf:"bytes,8,opt,name=data_scope,json=dataScope,proto3" json:"data_scope,omitempty"`
+	DeptIds           []uint32               `protobuf:"varint,9,rep,packed,name=dept_ids,json=deptIds,proto3" json:"dept_ids,omitempty"`
+	DenyPermissionIds []uint32               `protobuf:"varint,10,rep,packed,name=deny_permission_ids,json=denyPermissionIds,proto3" json:"deny_permission_ids,omitempty"` // 角色级别拒绝访问的权限，优先于任何允许
+	Conditions        *PolicyConditions      `protobuf:"bytes,11,opt,name=conditions,proto3" json:"conditions,omitempty"`                                                  // 角色关联的权限仅在满足条件时生效，为空时不限制
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetPermissionIds() []uint32 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetMenuIds() []uint32 {
+	if x != nil {
+		return x.MenuIds
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetButtonIds() []uint32 {
+	if x != nil {
+		return x.ButtonIds
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetParentIds() []uint32 {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *CreateRoleRequest) GetDataScope() string {
+	if x != nil {
+		return x.DataScope
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetDeptIds() []uint32 {
+	if x != nil {
+		return x.DeptIds
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetDenyPermissionIds() []uint32 {
+	if x != nil {
+		return x.DenyPermissionIds
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetConditions() *PolicyConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr             string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	PermissionIds     []uint32               `protobuf:"varint,3,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	MenuIds           []uint32               `protobuf:"varint,4,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids,omitempty"`
+	ButtonIds         []uint32               `protobuf:"varint,5,rep,packed,name=button_ids,json=buttonIds,proto3" json:"button_ids,omitempty"`
+	Pk                uint32                 `protobuf:"varint,6,opt,name=pk,proto3" json:"pk,omitempty"`
+	ParentIds         []uint32               `protobuf:"varint,7,rep,packed,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	DataScope         string                 `protobuf:"bytes,8,opt,name=data_scope,json=dataScope,proto3" json:"data_scope,omitempty"`
+	DeptIds           []uint32               `protobuf:"varint,9,rep,packed,name=dept_ids,json=deptIds,proto3" json:"dept_ids,omitempty"`
+	DenyPermissionIds []uint32               `protobuf:"varint,10,rep,packed,name=deny_permission_ids,json=denyPermissionIds,proto3" json:"deny_permission_ids,omitempty"`
+	Conditions        *PolicyConditions      `protobuf:"bytes,11,opt,name=conditions,proto3" json:"conditions,omitempty"` // 不传时保持原有条件，传空对象时清除条件
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetPermissionIds() []uint32 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetMenuIds() []uint32 {
+	if x != nil {
+		return x.MenuIds
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetButtonIds() []uint32 {
+	if x != nil {
+		return x.ButtonIds
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *UpdateRoleRequest) GetParentIds() []uint32 {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetDataScope() string {
+	if x != nil {
+		return x.DataScope
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetDeptIds() []uint32 {
+	if x != nil {
+		return x.DeptIds
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetDenyPermissionIds() []uint32 {
+	if x != nil {
+		return x.DenyPermissionIds
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetConditions() *PolicyConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Pk               uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	ReassignToRoleId uint32                 `protobuf:"varint,2,opt,name=reassign_to_role_id,json=reassignToRoleId,proto3" json:"reassign_to_role_id,omitempty"` // 将持有该角色的用户和临时授权转移到该角色后删除，为0且未强制删除时角色仍被使用则拒绝删除
+	DryRun           bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                   // 只返回依赖项，不删除
+	Force            bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`                                                   // 存在依赖项时仍然删除，未转移的用户和临时授权一并删除
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteRoleRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *DeleteRoleRequest) GetReassignToRoleId() uint32 {
+	if x != nil {
+		return x.ReassignToRoleId
+	}
+	return 0
+}
+
+func (x *DeleteRoleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteRoleRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleRequest) Reset() {
+	*x = GetRoleRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleRequest) ProtoMessage() {}
+
+func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetRoleRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+// 复制角色的权限、拒绝的权限、菜单、按钮、父级角色、数据范围和附加条件，不复制持有该角色的用户和临时授权
+type CloneRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"` // 被复制的角色
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Descr         string                 `protobuf:"bytes,3,opt,name=descr,proto3" json:"descr,omitempty"` // 为空时沿用原角色的描述
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneRoleRequest) Reset() {
+	*x = CloneRoleRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneRoleRequest) ProtoMessage() {}
+
+func (x *CloneRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneRoleRequest.ProtoReflect.Descriptor instead.
+func (*CloneRoleRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CloneRoleRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *CloneRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneRoleRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+// other_pk 和 proposed 二选一，proposed 按 UpdateRole 的语义应用到 pk 对应的角色上，其中的 pk 不使用
+type DiffRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`                          // 作为比较基准的角色
+	OtherPk       uint32                 `protobuf:"varint,2,opt,name=other_pk,json=otherPk,proto3" json:"other_pk,omitempty"` // 与基准比较的角色
+	Proposed      *UpdateRoleRequest     `protobuf:"bytes,3,opt,name=proposed,proto3" json:"proposed,omitempty"`               // 拟对基准角色执行的更新
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRolesRequest) Reset() {
+	*x = DiffRolesRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRolesRequest) ProtoMessage() {}
+
+func (x *DiffRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRolesRequest.ProtoReflect.Descriptor instead.
+func (*DiffRolesRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DiffRolesRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *DiffRolesRequest) GetOtherPk() uint32 {
+	if x != nil {
+		return x.OtherPk
+	}
+	return 0
+}
+
+func (x *DiffRolesRequest) GetProposed() *UpdateRoleRequest {
+	if x != nil {
+		return x.Proposed
+	}
+	return nil
+}
+
+type RoleDiffEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 权限为请求方法和URL，其余为名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleDiffEntry) Reset() {
+	*x = RoleDiffEntry{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDiffEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDiffEntry) ProtoMessage() {}
+
+func (x *RoleDiffEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDiffEntry.ProtoReflect.Descriptor instead.
+func (*RoleDiffEntry) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RoleDiffEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoleDiffEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RoleDiffCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // permission、deny_permission、menu、button、parent、dept
+	Added         []*RoleDiffEntry       `protobuf:"bytes,2,rep,name=added,proto3" json:"added,omitempty"`       // 比较对象有而基准没有
+	Removed       []*RoleDiffEntry       `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`   // 基准有而比较对象没有
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleDiffCategory) Reset() {
+	*x = RoleDiffCategory{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDiffCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDiffCategory) ProtoMessage() {}
+
+func (x *RoleDiffCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDiffCategory.ProtoReflect.Descriptor instead.
+func (*RoleDiffCategory) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RoleDiffCategory) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *RoleDiffCategory) GetAdded() []*RoleDiffEntry {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *RoleDiffCategory) GetRemoved() []*RoleDiffEntry {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type RoleFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // name、descr、data_scope、conditions
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleFieldChange) Reset() {
+	*x = RoleFieldChange{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleFieldChange) ProtoMessage() {}
+
+func (x *RoleFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleFieldChange.ProtoReflect.Descriptor instead.
+func (*RoleFieldChange) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RoleFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *RoleFieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RoleFieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type RoleDiffOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *RoleOutBase           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Fields        []*RoleFieldChange     `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`         // 只包含有变化的字段
+	Categories    []*RoleDiffCategory    `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"` // 只包含有变化的类别
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleDiffOut) Reset() {
+	*x = RoleDiffOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDiffOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDiffOut) ProtoMessage() {}
+
+func (x *RoleDiffOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDiffOut.ProtoReflect.Descriptor instead.
+func (*RoleDiffOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RoleDiffOut) GetBase() *RoleOutBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RoleDiffOut) GetFields() []*RoleFieldChange {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RoleDiffOut) GetCategories() []*RoleDiffCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ListRoleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
+	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
+	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
+	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
+	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
+	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
+	Name            string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	Descr           string                 `protobuf:"bytes,10,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId        *UInt32Value           `protobuf:"bytes,11,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListRoleRequest) Reset() {
+	*x = ListRoleRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleRequest) ProtoMessage() {}
+
+func (x *ListRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListRoleRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRoleRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListRoleRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *ListRoleRequest) GetPks() string {
+	if x != nil {
+		return x.Pks
+	}
+	return ""
+}
+
+func (x *ListRoleRequest) GetBeforeCreatedAt() string {
+	if x != nil {
+		return x.BeforeCreatedAt
+	}
+	return ""
+}
+
+func (x *ListRoleRequest) GetAfterCreatedAt() string {
+	if x != nil {
+		return x.AfterCreatedAt
+	}
+	return ""
+}
+
+func (x *ListRoleRequest) GetBeforeUpdatedAt() string {
+	if x != nil {
+		return x.BeforeUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListRoleRequest) GetAfterUpdatedAt() string {
+	if x != nil {
+		return x.AfterUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListRoleRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ListRoleRequest) GetTenantId() *UInt32Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type RoleOutBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DataScope     string                 `protobuf:"bytes,7,opt,name=data_scope,json=dataScope,proto3" json:"data_scope,omitempty"`
+	Conditions    *PolicyConditions      `protobuf:"bytes,8,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleOutBase) Reset() {
+	*x = RoleOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleOutBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleOutBase) ProtoMessage() {}
+
+func (x *RoleOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleOutBase.ProtoReflect.Descriptor instead.
+func (*RoleOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RoleOutBase) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoleOutBase) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RoleOutBase) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *RoleOutBase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleOutBase) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *RoleOutBase) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *RoleOutBase) GetDataScope() string {
+	if x != nil {
+		return x.DataScope
+	}
+	return ""
+}
+
+func (x *RoleOutBase) GetConditions() *PolicyConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type RoleImpactUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleImpactUser) Reset() {
+	*x = RoleImpactUser{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleImpactUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleImpactUser) ProtoMessage() {}
+
+func (x *RoleImpactUser) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleImpactUser.ProtoReflect.Descriptor instead.
+func (*RoleImpactUser) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RoleImpactUser) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoleImpactUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RoleImpactUser) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *RoleImpactUser) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type RoleImpactGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartAt       string                 `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         string                 `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleImpactGrant) Reset() {
+	*x = RoleImpactGrant{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleImpactGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleImpactGrant) ProtoMessage() {}
+
+func (x *RoleImpactGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleImpactGrant.ProtoReflect.Descriptor instead.
+func (*RoleImpactGrant) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RoleImpactGrant) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoleImpactGrant) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RoleImpactGrant) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *RoleImpactGrant) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *RoleImpactGrant) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type RoleImpactOut struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Role            *RoleOutBase           `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Users           []*RoleImpactUser      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`       // 持有该角色的用户
+	Grants          []*RoleImpactGrant     `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`     // 该角色的临时授权
+	Children        []*RoleOutBase         `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"` // 继承该角色的子角色，删除后将失去其授权
+	Menus           []*MenuOutBase         `protobuf:"bytes,5,rep,name=menus,proto3" json:"menus,omitempty"`
+	Buttons         []*ButtonOutBase       `protobuf:"bytes,6,rep,name=buttons,proto3" json:"buttons,omitempty"`
+	Permissions     []*PermissionOutBase   `protobuf:"bytes,7,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	DenyPermissions []*PermissionOutBase   `protobuf:"bytes,8,rep,name=deny_permissions,json=denyPermissions,proto3" json:"deny_permissions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoleImpactOut) Reset() {
+	*x = RoleImpactOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleImpactOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleImpactOut) ProtoMessage() {}
+
+func (x *RoleImpactOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleImpactOut.ProtoReflect.Descriptor instead.
+func (*RoleImpactOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RoleImpactOut) GetRole() *RoleOutBase {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *RoleImpactOut) GetUsers() []*RoleImpactUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *RoleImpactOut) GetGrants() []*RoleImpactGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *RoleImpactOut) GetChildren() []*RoleOutBase {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *RoleImpactOut) GetMenus() []*MenuOutBase {
+	if x != nil {
+		return x.Menus
+	}
+	return nil
+}
+
+func (x *RoleImpactOut) GetButtons() []*ButtonOutBase {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+func (x *RoleImpactOut) GetPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *RoleImpactOut) GetDenyPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.DenyPermissions
+	}
+	return nil
+}
+
+type RoleOut struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name                 string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Descr                string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
+	Permissions          []*PermissionOutBase   `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Menus                []*MenuOutBase         `protobuf:"bytes,7,rep,name=menus,proto3" json:"menus,omitempty"`
+	Buttons              []*ButtonOutBase       `protobuf:"bytes,8,rep,name=buttons,proto3" json:"buttons,omitempty"`
+	Parents              []*RoleOutBase         `protobuf:"bytes,9,rep,name=parents,proto3" json:"parents,omitempty"`
+	InheritedPermissions []*PermissionOutBase   `protobuf:"bytes,10,rep,name=inherited_permissions,json=inheritedPermissions,proto3" json:"inherited_permissions,omitempty"`
+	InheritedMenus       []*MenuOutBase         `protobuf:"bytes,11,rep,name=inherited_menus,json=inheritedMenus,proto3" json:"inherited_menus,omitempty"`
+	InheritedButtons     []*ButtonOutBase       `protobuf:"bytes,12,rep,name=inherited_buttons,json=inheritedButtons,proto3" json:"inherited_buttons,omitempty"`
+	TenantId             uint32                 `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DataScope            string                 `protobuf:"bytes,14,opt,name=data_scope,json=dataScope,proto3" json:"data_scope,omitempty"`
+	Depts                []*DeptOutBase         `protobuf:"bytes,15,rep,name=depts,proto3" json:"depts,omitempty"`
+	DenyPermissions      []*PermissionOutBase   `protobuf:"bytes,16,rep,name=deny_permissions,json=denyPermissions,proto3" json:"deny_permissions,omitempty"`
+	Conditions           *PolicyConditions      `protobuf:"bytes,17,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RoleOut) Reset() {
+	*x = RoleOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleOut) ProtoMessage() {}
+
+func (x *RoleOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleOut.ProtoReflect.Descriptor instead.
+func (*RoleOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RoleOut) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoleOut) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RoleOut) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *RoleOut) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleOut) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *RoleOut) GetPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *RoleOut) GetMenus() []*MenuOutBase {
+	if x != nil {
+		return x.Menus
+	}
+	return nil
+}
+
+func (x *RoleOut) GetButtons() []*ButtonOutBase {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+func (x *RoleOut) GetParents() []*RoleOutBase {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+func (x *RoleOut) GetInheritedPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.InheritedPermissions
+	}
+	return nil
+}
+
+func (x *RoleOut) GetInheritedMenus() []*MenuOutBase {
+	if x != nil {
+		return x.InheritedMenus
+	}
+	return nil
+}
+
+func (x *RoleOut) GetInheritedButtons() []*ButtonOutBase {
+	if x != nil {
+		return x.InheritedButtons
+	}
+	return nil
+}
+
+func (x *RoleOut) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *RoleOut) GetDataScope() string {
+	if x != nil {
+		return x.DataScope
+	}
+	return ""
+}
+
+func (x *RoleOut) GetDepts() []*DeptOutBase {
+	if x != nil {
+		return x.Depts
+	}
+	return nil
+}
+
+func (x *RoleOut) GetDenyPermissions() []*PermissionOutBase {
+	if x != nil {
+		return x.DenyPermissions
+	}
+	return nil
+}
+
+func (x *RoleOut) GetConditions() *PolicyConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type PagRoleOutBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	Items         []*RoleOutBase         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagRoleOutBase) Reset() {
+	*x = PagRoleOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PagRoleOutBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagRoleOutBase) ProtoMessage() {}
+
+func (x *PagRoleOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagRoleOutBase.ProtoReflect.Descriptor instead.
+func (*PagRoleOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PagRoleOutBase) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PagRoleOutBase) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PagRoleOutBase) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PagRoleOutBase) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *PagRoleOutBase) GetItems() []*RoleOutBase {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsStaff       bool                   `protobuf:"varint,4,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	RoleIds       []uint32               `protobuf:"varint,5,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DeptId        uint32                 `protobuf:"varint,7,opt,name=dept_id,json=deptId,proto3" json:"dept_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateUserRequest) GetIsStaff() bool {
+	if x != nil {
+		return x.IsStaff
+	}
+	return false
+}
+
+func (x *CreateUserRequest) GetRoleIds() []uint32 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetDeptId() uint32 {
+	if x != nil {
+		return x.DeptId
+	}
+	return 0
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsStaff       bool                   `protobuf:"varint,4,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	RoleIds       []uint32               `protobuf:"varint,5,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	Pk            uint32                 `protobuf:"varint,6,opt,name=pk,proto3" json:"pk,omitempty"`
+	DeptId        uint32                 `protobuf:"varint,7,opt,name=dept_id,json=deptId,proto3" json:"dept_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateUserRequest) GetIsStaff() bool {
+	if x != nil {
+		return x.IsStaff
+	}
+	return false
+}
+
+func (x *UpdateUserRequest) GetRoleIds() []uint32 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetDeptId() uint32 {
+	if x != nil {
+		return x.DeptId
+	}
+	return 0
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回依赖项，不删除
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // 存在依赖项时仍然删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteUserRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *DeleteUserRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteUserRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetUserRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+type ListUserRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
+	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
+	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
+	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
+	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
+	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
+	Username        string                 `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive        *BoolValue             `protobuf:"bytes,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsStaff         *BoolValue             `protobuf:"bytes,11,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	RoleId          uint32                 `protobuf:"varint,12,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	TenantId        *UInt32Value           `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DeptId          uint32                 `protobuf:"varint,14,opt,name=dept_id,json=deptId,proto3" json:"dept_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListUserRequest) Reset() {
+	*x = ListUserRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRequest) ProtoMessage() {}
+
+func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListUserRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetPks() string {
+	if x != nil {
+		return x.Pks
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetBeforeCreatedAt() string {
+	if x != nil {
+		return x.BeforeCreatedAt
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetAfterCreatedAt() string {
+	if x != nil {
+		return x.AfterCreatedAt
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetBeforeUpdatedAt() string {
+	if x != nil {
+		return x.BeforeUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetAfterUpdatedAt() string {
+	if x != nil {
+		return x.AfterUpdatedAt
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetIsActive() *BoolValue {
+	if x != nil {
+		return x.IsActive
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetIsStaff() *BoolValue {
+	if x != nil {
+		return x.IsStaff
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetRoleId() uint32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetTenantId() *UInt32Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetDeptId() uint32 {
+	if x != nil {
+		return x.DeptId
+	}
+	return 0
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	CaptchaId     string                 `protobuf:"bytes,3,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`
+	Captcha       string                 `protobuf:"bytes,4,opt,name=captcha,proto3" json:"captcha,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetCaptcha() string {
+	if x != nil {
+		return x.Captcha
+	}
+	return ""
+}
+
+type UserOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
-	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Meta          *MetaSchemas           `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
-	Label         string                 `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
-	ArrangeOrder  uint32                 `protobuf:"varint,9,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
-	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Descr         string                 `protobuf:"bytes,11,opt,name=descr,proto3" json:"descr,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsStaff       bool                   `protobuf:"varint,6,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	Roles         []*RoleOutBase         `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Dept          *DeptOutBase           `protobuf:"bytes,9,opt,name=dept,proto3" json:"dept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOut) Reset() {
+	*x = UserOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOut) ProtoMessage() {}
+
+func (x *UserOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOut.ProtoReflect.Descriptor instead.
+func (*UserOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UserOut) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserOut) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserOut) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *UserOut) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserOut) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UserOut) GetIsStaff() bool {
+	if x != nil {
+		return x.IsStaff
+	}
+	return false
+}
+
+func (x *UserOut) GetRoles() []*RoleOutBase {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UserOut) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *UserOut) GetDept() *DeptOutBase {
+	if x != nil {
+		return x.Dept
+	}
+	return nil
+}
+
+type PagUserOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	Items         []*UserOut             `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagUserOut) Reset() {
+	*x = PagUserOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PagUserOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagUserOut) ProtoMessage() {}
+
+func (x *PagUserOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagUserOut.ProtoReflect.Descriptor instead.
+func (*PagUserOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PagUserOut) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PagUserOut) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PagUserOut) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PagUserOut) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *PagUserOut) GetItems() []*UserOut {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ResetPasswordRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword     string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	ConfirmPassword string                 `protobuf:"bytes,3,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+type LoginOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginOut) Reset() {
+	*x = LoginOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginOut) ProtoMessage() {}
+
+func (x *LoginOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginOut.ProtoReflect.Descriptor instead.
+func (*LoginOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *LoginOut) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GenerateCaptchaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateCaptchaRequest) Reset() {
+	*x = GenerateCaptchaRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateCaptchaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateCaptchaRequest) ProtoMessage() {}
+
+func (x *GenerateCaptchaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateCaptchaRequest.ProtoReflect.Descriptor instead.
+func (*GenerateCaptchaRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GenerateCaptchaRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CaptchaOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Expire        int64                  `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptchaOut) Reset() {
+	*x = CaptchaOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptchaOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptchaOut) ProtoMessage() {}
+
+func (x *CaptchaOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptchaOut.ProtoReflect.Descriptor instead.
+func (*CaptchaOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CaptchaOut) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *CaptchaOut) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CaptchaOut) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CaptchaOut) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type CreateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,3,opt,name=descr,proto3" json:"descr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateTenantRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+type UpdateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,4,opt,name=descr,proto3" json:"descr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateTenantRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *UpdateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateTenantRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回依赖项，不删除
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // 只有部门时仍然删除，租户下仍有用户或角色时不能删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteTenantRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+func (x *DeleteTenantRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteTenantRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuOutBase) Reset() {
-	*x = MenuOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[16]
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetTenantRequest) GetPk() uint32 {
+	if x != nil {
+		return x.Pk
+	}
+	return 0
+}
+
+type ListTenantRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
+	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
+	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
+	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
+	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
+	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
+	Name            string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive        *BoolValue             `protobuf:"bytes,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr           string                 `protobuf:"bytes,11,opt,name=descr,proto3" json:"descr,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTenantRequest) Reset() {
+	*x = ListTenantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuOutBase) String() string {
+func (x *ListTenantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuOutBase) ProtoMessage() {}
+func (*ListTenantRequest) ProtoMessage() {}
 
-func (x *MenuOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[16]
+func (x *ListTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,122 +6576,115 @@ func (x *MenuOutBase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuOutBase.ProtoReflect.Descriptor instead.
-func (*MenuOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListTenantRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *MenuOutBase) GetId() uint32 {
+func (x *ListTenantRequest) GetPage() int64 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-func (x *MenuOutBase) GetCreatedAt() string {
+func (x *ListTenantRequest) GetSize() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *MenuOutBase) GetUpdatedAt() string {
+func (x *ListTenantRequest) GetPk() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Pk
 	}
-	return ""
+	return 0
 }
 
-func (x *MenuOutBase) GetPath() string {
+func (x *ListTenantRequest) GetPks() string {
 	if x != nil {
-		return x.Path
+		return x.Pks
 	}
 	return ""
 }
 
-func (x *MenuOutBase) GetComponent() string {
+func (x *ListTenantRequest) GetBeforeCreatedAt() string {
 	if x != nil {
-		return x.Component
+		return x.BeforeCreatedAt
 	}
 	return ""
 }
 
-func (x *MenuOutBase) GetName() string {
+func (x *ListTenantRequest) GetAfterCreatedAt() string {
 	if x != nil {
-		return x.Name
+		return x.AfterCreatedAt
 	}
 	return ""
 }
 
-func (x *MenuOutBase) GetMeta() *MetaSchemas {
+func (x *ListTenantRequest) GetBeforeUpdatedAt() string {
 	if x != nil {
-		return x.Meta
+		return x.BeforeUpdatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *MenuOutBase) GetLabel() string {
+func (x *ListTenantRequest) GetAfterUpdatedAt() string {
 	if x != nil {
-		return x.Label
+		return x.AfterUpdatedAt
 	}
 	return ""
 }
 
-func (x *MenuOutBase) GetArrangeOrder() uint32 {
+func (x *ListTenantRequest) GetName() string {
 	if x != nil {
-		return x.ArrangeOrder
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *MenuOutBase) GetIsActive() bool {
+func (x *ListTenantRequest) GetIsActive() *BoolValue {
 	if x != nil {
 		return x.IsActive
 	}
-	return false
+	return nil
 }
 
-func (x *MenuOutBase) GetDescr() string {
+func (x *ListTenantRequest) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-type MenuOut struct {
+type TenantOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
-	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Meta          *MetaSchemas           `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
-	Label         string                 `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
-	ArrangeOrder  uint32                 `protobuf:"varint,9,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
-	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Descr         string                 `protobuf:"bytes,11,opt,name=descr,proto3" json:"descr,omitempty"`
-	Parent        *MenuOutBase           `protobuf:"bytes,12,opt,name=parent,proto3" json:"parent,omitempty"`
-	Permissions   []*PermissionOutBase   `protobuf:"bytes,13,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,6,opt,name=descr,proto3" json:"descr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuOut) Reset() {
-	*x = MenuOut{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[17]
+func (x *TenantOut) Reset() {
+	*x = TenantOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuOut) String() string {
+func (x *TenantOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuOut) ProtoMessage() {}
+func (*TenantOut) ProtoMessage() {}
 
-func (x *MenuOut) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[17]
+func (x *TenantOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,128 +6695,79 @@ func (x *MenuOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuOut.ProtoReflect.Descriptor instead.
-func (*MenuOut) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TenantOut.ProtoReflect.Descriptor instead.
+func (*TenantOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *MenuOut) GetId() uint32 {
+func (x *TenantOut) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MenuOut) GetCreatedAt() string {
+func (x *TenantOut) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *MenuOut) GetUpdatedAt() string {
+func (x *TenantOut) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *MenuOut) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *MenuOut) GetComponent() string {
-	if x != nil {
-		return x.Component
-	}
-	return ""
-}
-
-func (x *MenuOut) GetName() string {
+func (x *TenantOut) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MenuOut) GetMeta() *MetaSchemas {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *MenuOut) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *MenuOut) GetArrangeOrder() uint32 {
-	if x != nil {
-		return x.ArrangeOrder
-	}
-	return 0
-}
-
-func (x *MenuOut) GetIsActive() bool {
+func (x *TenantOut) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
 	}
 	return false
 }
 
-func (x *MenuOut) GetDescr() string {
+func (x *TenantOut) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-func (x *MenuOut) GetParent() *MenuOutBase {
-	if x != nil {
-		return x.Parent
-	}
-	return nil
-}
-
-func (x *MenuOut) GetPermissions() []*PermissionOutBase {
-	if x != nil {
-		return x.Permissions
-	}
-	return nil
-}
-
-type PagMenuOutBase struct {
+type PagTenantOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
-	Items         []*MenuOutBase         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*TenantOut           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PagMenuOutBase) Reset() {
-	*x = PagMenuOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[18]
+func (x *PagTenantOut) Reset() {
+	*x = PagTenantOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PagMenuOutBase) String() string {
+func (x *PagTenantOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PagMenuOutBase) ProtoMessage() {}
+func (*PagTenantOut) ProtoMessage() {}
 
-func (x *PagMenuOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[18]
+func (x *PagTenantOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,74 +6778,73 @@ func (x *PagMenuOutBase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PagMenuOutBase.ProtoReflect.Descriptor instead.
-func (*PagMenuOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PagTenantOut.ProtoReflect.Descriptor instead.
+func (*PagTenantOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *PagMenuOutBase) GetPage() int64 {
+func (x *PagTenantOut) GetPage() int64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *PagMenuOutBase) GetSize() int64 {
+func (x *PagTenantOut) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *PagMenuOutBase) GetTotal() int64 {
+func (x *PagTenantOut) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *PagMenuOutBase) GetPages() int64 {
+func (x *PagTenantOut) GetPages() int64 {
 	if x != nil {
 		return x.Pages
 	}
 	return 0
 }
 
-func (x *PagMenuOutBase) GetItems() []*MenuOutBase {
+func (x *PagTenantOut) GetItems() []*TenantOut {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type CreateButtonRequest struct {
+type CreateDeptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ArrangeOrder  uint32                 `protobuf:"varint,3,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
-	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
-	MenuId        uint32                 `protobuf:"varint,6,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
-	PermissionIds []uint32               `protobuf:"varint,7,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ArrangeOrder  uint32                 `protobuf:"varint,2,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Descr         string                 `protobuf:"bytes,4,opt,name=descr,proto3" json:"descr,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateButtonRequest) Reset() {
-	*x = CreateButtonRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[19]
+func (x *CreateDeptRequest) Reset() {
+	*x = CreateDeptRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateButtonRequest) String() string {
+func (x *CreateDeptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateButtonRequest) ProtoMessage() {}
+func (*CreateDeptRequest) ProtoMessage() {}
 
-func (x *CreateButtonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[19]
+func (x *CreateDeptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,88 +6855,80 @@ func (x *CreateButtonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateButtonRequest.ProtoReflect.Descriptor instead.
-func (*CreateButtonRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *CreateButtonRequest) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use CreateDeptRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeptRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateButtonRequest) GetName() string {
+func (x *CreateDeptRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateButtonRequest) GetArrangeOrder() uint32 {
+func (x *CreateDeptRequest) GetArrangeOrder() uint32 {
 	if x != nil {
 		return x.ArrangeOrder
 	}
 	return 0
 }
 
-func (x *CreateButtonRequest) GetIsActive() bool {
+func (x *CreateDeptRequest) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
 	}
 	return false
 }
 
-func (x *CreateButtonRequest) GetDescr() string {
+func (x *CreateDeptRequest) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-func (x *CreateButtonRequest) GetMenuId() uint32 {
+func (x *CreateDeptRequest) GetParentId() uint32 {
 	if x != nil {
-		return x.MenuId
+		return x.ParentId
 	}
 	return 0
 }
 
-func (x *CreateButtonRequest) GetPermissionIds() []uint32 {
+func (x *CreateDeptRequest) GetTenantId() uint32 {
 	if x != nil {
-		return x.PermissionIds
+		return x.TenantId
 	}
-	return nil
+	return 0
 }
 
-type UpdateButtonRequest struct {
+type UpdateDeptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	ArrangeOrder  uint32                 `protobuf:"varint,3,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
 	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
-	MenuId        uint32                 `protobuf:"varint,6,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
-	PermissionIds []uint32               `protobuf:"varint,7,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateButtonRequest) Reset() {
-	*x = UpdateButtonRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[20]
+func (x *UpdateDeptRequest) Reset() {
+	*x = UpdateDeptRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateButtonRequest) String() string {
+func (x *UpdateDeptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateButtonRequest) ProtoMessage() {}
+func (*UpdateDeptRequest) ProtoMessage() {}
 
-func (x *UpdateButtonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[20]
+func (x *UpdateDeptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,82 +6939,77 @@ func (x *UpdateButtonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateButtonRequest.ProtoReflect.Descriptor instead.
-func (*UpdateButtonRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateDeptRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeptRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *UpdateButtonRequest) GetPk() uint32 {
+func (x *UpdateDeptRequest) GetPk() uint32 {
 	if x != nil {
 		return x.Pk
 	}
 	return 0
 }
 
-func (x *UpdateButtonRequest) GetName() string {
+func (x *UpdateDeptRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateButtonRequest) GetArrangeOrder() uint32 {
+func (x *UpdateDeptRequest) GetArrangeOrder() uint32 {
 	if x != nil {
 		return x.ArrangeOrder
 	}
 	return 0
 }
 
-func (x *UpdateButtonRequest) GetIsActive() bool {
+func (x *UpdateDeptRequest) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
 	}
 	return false
 }
 
-func (x *UpdateButtonRequest) GetDescr() string {
+func (x *UpdateDeptRequest) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-func (x *UpdateButtonRequest) GetMenuId() uint32 {
+func (x *UpdateDeptRequest) GetParentId() uint32 {
 	if x != nil {
-		return x.MenuId
+		return x.ParentId
 	}
 	return 0
 }
 
-func (x *UpdateButtonRequest) GetPermissionIds() []uint32 {
-	if x != nil {
-		return x.PermissionIds
-	}
-	return nil
-}
-
-type DeleteButtonRequest struct {
+type DeleteDeptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回依赖项，不删除
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // 存在依赖项时仍然删除
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteButtonRequest) Reset() {
-	*x = DeleteButtonRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[21]
+func (x *DeleteDeptRequest) Reset() {
+	*x = DeleteDeptRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteButtonRequest) String() string {
+func (x *DeleteDeptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteButtonRequest) ProtoMessage() {}
+func (*DeleteDeptRequest) ProtoMessage() {}
 
-func (x *DeleteButtonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[21]
+func (x *DeleteDeptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,40 +7020,54 @@ func (x *DeleteButtonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteButtonRequest.ProtoReflect.Descriptor instead.
-func (*DeleteButtonRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeleteDeptRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeptRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *DeleteButtonRequest) GetPk() uint32 {
+func (x *DeleteDeptRequest) GetPk() uint32 {
 	if x != nil {
 		return x.Pk
 	}
 	return 0
 }
 
-type GetButtonRequest struct {
+func (x *DeleteDeptRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteDeptRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetDeptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetButtonRequest) Reset() {
-	*x = GetButtonRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[22]
+func (x *GetDeptRequest) Reset() {
+	*x = GetDeptRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetButtonRequest) String() string {
+func (x *GetDeptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetButtonRequest) ProtoMessage() {}
+func (*GetDeptRequest) ProtoMessage() {}
 
-func (x *GetButtonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[22]
+func (x *GetDeptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,20 +7077,20 @@ func (x *GetButtonRequest) ProtoReflect() protoreflect.Message {
 	}
 	return mi.MessageOf(x)
 }
-
-// Deprecated: Use GetButtonRequest.ProtoReflect.Descriptor instead.
-func (*GetButtonRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{22}
+
+// Deprecated: Use GetDeptRequest.ProtoReflect.Descriptor instead.
+func (*GetDeptRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetButtonRequest) GetPk() uint32 {
+func (x *GetDeptRequest) GetPk() uint32 {
 	if x != nil {
 		return x.Pk
 	}
 	return 0
 }
 
-type ListButtonRequest struct {
+type ListDeptRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
@@ -1855,26 +7103,27 @@ type ListButtonRequest struct {
 	Name            string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
 	IsActive        *BoolValue             `protobuf:"bytes,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	Descr           string                 `protobuf:"bytes,11,opt,name=descr,proto3" json:"descr,omitempty"`
-	MenuId          uint32                 `protobuf:"varint,12,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	ParentId        *UInt32Value           `protobuf:"bytes,12,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	TenantId        *UInt32Value           `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListButtonRequest) Reset() {
-	*x = ListButtonRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[23]
+func (x *ListDeptRequest) Reset() {
+	*x = ListDeptRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListButtonRequest) String() string {
+func (x *ListDeptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListButtonRequest) ProtoMessage() {}
+func (*ListDeptRequest) ProtoMessage() {}
 
-func (x *ListButtonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[23]
+func (x *ListDeptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,96 +7134,103 @@ func (x *ListButtonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListButtonRequest.ProtoReflect.Descriptor instead.
-func (*ListButtonRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListDeptRequest.ProtoReflect.Descriptor instead.
+func (*ListDeptRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *ListButtonRequest) GetPage() int64 {
+func (x *ListDeptRequest) GetPage() int64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListButtonRequest) GetSize() int64 {
+func (x *ListDeptRequest) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *ListButtonRequest) GetPk() uint32 {
+func (x *ListDeptRequest) GetPk() uint32 {
 	if x != nil {
 		return x.Pk
 	}
 	return 0
 }
 
-func (x *ListButtonRequest) GetPks() string {
+func (x *ListDeptRequest) GetPks() string {
 	if x != nil {
 		return x.Pks
 	}
 	return ""
 }
 
-func (x *ListButtonRequest) GetBeforeCreatedAt() string {
+func (x *ListDeptRequest) GetBeforeCreatedAt() string {
 	if x != nil {
 		return x.BeforeCreatedAt
 	}
 	return ""
 }
 
-func (x *ListButtonRequest) GetAfterCreatedAt() string {
+func (x *ListDeptRequest) GetAfterCreatedAt() string {
 	if x != nil {
 		return x.AfterCreatedAt
 	}
 	return ""
 }
 
-func (x *ListButtonRequest) GetBeforeUpdatedAt() string {
+func (x *ListDeptRequest) GetBeforeUpdatedAt() string {
 	if x != nil {
 		return x.BeforeUpdatedAt
 	}
 	return ""
 }
 
-func (x *ListButtonRequest) GetAfterUpdatedAt() string {
+func (x *ListDeptRequest) GetAfterUpdatedAt() string {
 	if x != nil {
 		return x.AfterUpdatedAt
 	}
 	return ""
 }
 
-func (x *ListButtonRequest) GetName() string {
+func (x *ListDeptRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ListButtonRequest) GetIsActive() *BoolValue {
+func (x *ListDeptRequest) GetIsActive() *BoolValue {
 	if x != nil {
 		return x.IsActive
 	}
 	return nil
 }
 
-func (x *ListButtonRequest) GetDescr() string {
+func (x *ListDeptRequest) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-func (x *ListButtonRequest) GetMenuId() uint32 {
+func (x *ListDeptRequest) GetParentId() *UInt32Value {
 	if x != nil {
-		return x.MenuId
+		return x.ParentId
 	}
-	return 0
+	return nil
 }
 
-type ButtonOutBase struct {
+func (x *ListDeptRequest) GetTenantId() *UInt32Value {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type DeptOutBase struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -1983,25 +7239,26 @@ type ButtonOutBase struct {
 	ArrangeOrder  uint32                 `protobuf:"varint,5,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
 	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	Descr         string                 `protobuf:"bytes,7,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ButtonOutBase) Reset() {
-	*x = ButtonOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[24]
+func (x *DeptOutBase) Reset() {
+	*x = DeptOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ButtonOutBase) String() string {
+func (x *DeptOutBase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ButtonOutBase) ProtoMessage() {}
+func (*DeptOutBase) ProtoMessage() {}
 
-func (x *ButtonOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[24]
+func (x *DeptOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,61 +7269,68 @@ func (x *ButtonOutBase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ButtonOutBase.ProtoReflect.Descriptor instead.
-func (*ButtonOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeptOutBase.ProtoReflect.Descriptor instead.
+func (*DeptOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *ButtonOutBase) GetId() uint32 {
+func (x *DeptOutBase) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ButtonOutBase) GetCreatedAt() string {
+func (x *DeptOutBase) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *ButtonOutBase) GetUpdatedAt() string {
+func (x *DeptOutBase) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *ButtonOutBase) GetName() string {
+func (x *DeptOutBase) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ButtonOutBase) GetArrangeOrder() uint32 {
+func (x *DeptOutBase) GetArrangeOrder() uint32 {
 	if x != nil {
 		return x.ArrangeOrder
 	}
 	return 0
 }
 
-func (x *ButtonOutBase) GetIsActive() bool {
+func (x *DeptOutBase) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
 	}
 	return false
 }
 
-func (x *ButtonOutBase) GetDescr() string {
+func (x *DeptOutBase) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-type ButtonOut struct {
+func (x *DeptOutBase) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type DeptOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -2075,27 +7339,27 @@ type ButtonOut struct {
 	ArrangeOrder  uint32                 `protobuf:"varint,5,opt,name=arrange_order,json=arrangeOrder,proto3" json:"arrange_order,omitempty"`
 	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	Descr         string                 `protobuf:"bytes,7,opt,name=descr,proto3" json:"descr,omitempty"`
-	Menu          *MenuOutBase           `protobuf:"bytes,8,opt,name=menu,proto3" json:"menu,omitempty"`
-	Permissions   []*PermissionOutBase   `protobuf:"bytes,9,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Parent        *DeptOutBase           `protobuf:"bytes,9,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ButtonOut) Reset() {
-	*x = ButtonOut{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[25]
+func (x *DeptOut) Reset() {
+	*x = DeptOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ButtonOut) String() string {
+func (x *DeptOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ButtonOut) ProtoMessage() {}
+func (*DeptOut) ProtoMessage() {}
 
-func (x *ButtonOut) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[25]
+func (x *DeptOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2106,100 +7370,100 @@ func (x *ButtonOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ButtonOut.ProtoReflect.Descriptor instead.
-func (*ButtonOut) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DeptOut.ProtoReflect.Descriptor instead.
+func (*DeptOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *ButtonOut) GetId() uint32 {
+func (x *DeptOut) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ButtonOut) GetCreatedAt() string {
+func (x *DeptOut) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *ButtonOut) GetUpdatedAt() string {
+func (x *DeptOut) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *ButtonOut) GetName() string {
+func (x *DeptOut) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ButtonOut) GetArrangeOrder() uint32 {
+func (x *DeptOut) GetArrangeOrder() uint32 {
 	if x != nil {
 		return x.ArrangeOrder
 	}
 	return 0
 }
 
-func (x *ButtonOut) GetIsActive() bool {
+func (x *DeptOut) GetIsActive() bool {
 	if x != nil {
 		return x.IsActive
 	}
 	return false
 }
 
-func (x *ButtonOut) GetDescr() string {
+func (x *DeptOut) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-func (x *ButtonOut) GetMenu() *MenuOutBase {
+func (x *DeptOut) GetTenantId() uint32 {
 	if x != nil {
-		return x.Menu
+		return x.TenantId
 	}
-	return nil
+	return 0
 }
 
-func (x *ButtonOut) GetPermissions() []*PermissionOutBase {
+func (x *DeptOut) GetParent() *DeptOutBase {
 	if x != nil {
-		return x.Permissions
+		return x.Parent
 	}
 	return nil
 }
 
-type PagButtonOutBase struct {
+type PagDeptOutBase struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
-	Items         []*ButtonOutBase       `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*DeptOutBase         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PagButtonOutBase) Reset() {
-	*x = PagButtonOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[26]
+func (x *PagDeptOutBase) Reset() {
+	*x = PagDeptOutBase{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PagButtonOutBase) String() string {
+func (x *PagDeptOutBase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PagButtonOutBase) ProtoMessage() {}
+func (*PagDeptOutBase) ProtoMessage() {}
 
-func (x *PagButtonOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[26]
+func (x *PagDeptOutBase) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,72 +7474,73 @@ func (x *PagButtonOutBase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PagButtonOutBase.ProtoReflect.Descriptor instead.
-func (*PagButtonOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PagDeptOutBase.ProtoReflect.Descriptor instead.
+func (*PagDeptOutBase) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *PagButtonOutBase) GetPage() int64 {
+func (x *PagDeptOutBase) GetPage() int64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *PagButtonOutBase) GetSize() int64 {
+func (x *PagDeptOutBase) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *PagButtonOutBase) GetTotal() int64 {
+func (x *PagDeptOutBase) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *PagButtonOutBase) GetPages() int64 {
+func (x *PagDeptOutBase) GetPages() int64 {
 	if x != nil {
 		return x.Pages
 	}
 	return 0
 }
 
-func (x *PagButtonOutBase) GetItems() []*ButtonOutBase {
+func (x *PagDeptOutBase) GetItems() []*DeptOutBase {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type CreateRoleRequest struct {
+// 用户在有效期内临时拥有角色，到期后自动失效
+type CreateUserRoleGrantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Descr         string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
-	PermissionIds []uint32               `protobuf:"varint,3,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
-	MenuIds       []uint32               `protobuf:"varint,4,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids,omitempty"`
-	ButtonIds     []uint32               `protobuf:"varint,5,rep,packed,name=button_ids,json=buttonIds,proto3" json:"button_ids,omitempty"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        uint32                 `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	StartAt       string                 `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // 生效时间 RFC3339，为空时立即生效
+	EndAt         string                 `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`       // 失效时间 RFC3339
+	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoleRequest) Reset() {
-	*x = CreateRoleRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[27]
+func (x *CreateUserRoleGrantRequest) Reset() {
+	*x = CreateUserRoleGrantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoleRequest) String() string {
+func (x *CreateUserRoleGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleRequest) ProtoMessage() {}
+func (*CreateUserRoleGrantRequest) ProtoMessage() {}
 
-func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[27]
+func (x *CreateUserRoleGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,73 +7551,73 @@ func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateUserRoleGrantRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRoleGrantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *CreateRoleRequest) GetName() string {
+func (x *CreateUserRoleGrantRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateRoleRequest) GetDescr() string {
+func (x *CreateUserRoleGrantRequest) GetRoleId() uint32 {
 	if x != nil {
-		return x.Descr
+		return x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateRoleRequest) GetPermissionIds() []uint32 {
+func (x *CreateUserRoleGrantRequest) GetStartAt() string {
 	if x != nil {
-		return x.PermissionIds
+		return x.StartAt
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateRoleRequest) GetMenuIds() []uint32 {
+func (x *CreateUserRoleGrantRequest) GetEndAt() string {
 	if x != nil {
-		return x.MenuIds
+		return x.EndAt
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateRoleRequest) GetButtonIds() []uint32 {
+func (x *CreateUserRoleGrantRequest) GetDescr() string {
 	if x != nil {
-		return x.ButtonIds
+		return x.Descr
 	}
-	return nil
+	return ""
 }
 
-type UpdateRoleRequest struct {
+// 角色在有效期内临时拥有权限，到期后自动失效
+type CreateRolePermissionGrantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Descr         string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
-	PermissionIds []uint32               `protobuf:"varint,3,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
-	MenuIds       []uint32               `protobuf:"varint,4,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids,omitempty"`
-	ButtonIds     []uint32               `protobuf:"varint,5,rep,packed,name=button_ids,json=buttonIds,proto3" json:"button_ids,omitempty"`
-	Pk            uint32                 `protobuf:"varint,6,opt,name=pk,proto3" json:"pk,omitempty"`
+	RoleId        uint32                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	PermissionId  uint32                 `protobuf:"varint,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	StartAt       string                 `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // 生效时间 RFC3339，为空时立即生效
+	EndAt         string                 `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`       // 失效时间 RFC3339
+	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoleRequest) Reset() {
-	*x = UpdateRoleRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[28]
+func (x *CreateRolePermissionGrantRequest) Reset() {
+	*x = CreateRolePermissionGrantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleRequest) String() string {
+func (x *CreateRolePermissionGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleRequest) ProtoMessage() {}
+func (*CreateRolePermissionGrantRequest) ProtoMessage() {}
 
-func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[28]
+func (x *CreateRolePermissionGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,75 +7628,70 @@ func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *UpdateRoleRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use CreateRolePermissionGrantRequest.ProtoReflect.Descriptor instead.
+func (*CreateRolePermissionGrantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpdateRoleRequest) GetDescr() string {
+func (x *CreateRolePermissionGrantRequest) GetRoleId() uint32 {
 	if x != nil {
-		return x.Descr
+		return x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateRoleRequest) GetPermissionIds() []uint32 {
+func (x *CreateRolePermissionGrantRequest) GetPermissionId() uint32 {
 	if x != nil {
-		return x.PermissionIds
+		return x.PermissionId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateRoleRequest) GetMenuIds() []uint32 {
+func (x *CreateRolePermissionGrantRequest) GetStartAt() string {
 	if x != nil {
-		return x.MenuIds
+		return x.StartAt
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateRoleRequest) GetButtonIds() []uint32 {
+func (x *CreateRolePermissionGrantRequest) GetEndAt() string {
 	if x != nil {
-		return x.ButtonIds
+		return x.EndAt
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateRoleRequest) GetPk() uint32 {
+func (x *CreateRolePermissionGrantRequest) GetDescr() string {
 	if x != nil {
-		return x.Pk
+		return x.Descr
 	}
-	return 0
+	return ""
 }
 
-type DeleteRoleRequest struct {
+type DeleteGrantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回依赖项，不删除
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                 // 存在依赖项时仍然删除
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoleRequest) Reset() {
-	*x = DeleteRoleRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[29]
+func (x *DeleteGrantRequest) Reset() {
+	*x = DeleteGrantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoleRequest) String() string {
+func (x *DeleteGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoleRequest) ProtoMessage() {}
+func (*DeleteGrantRequest) ProtoMessage() {}
 
-func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[29]
+func (x *DeleteGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2442,93 +7702,58 @@ func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DeleteGrantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGrantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *DeleteRoleRequest) GetPk() uint32 {
+func (x *DeleteGrantRequest) GetPk() uint32 {
 	if x != nil {
 		return x.Pk
 	}
 	return 0
 }
 
-type GetRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetRoleRequest) Reset() {
-	*x = GetRoleRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetRoleRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetRoleRequest) ProtoMessage() {}
-
-func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[30]
+func (x *DeleteGrantRequest) GetDryRun() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DryRun
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
-func (*GetRoleRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{30}
+	return false
 }
 
-func (x *GetRoleRequest) GetPk() uint32 {
+func (x *DeleteGrantRequest) GetForce() bool {
 	if x != nil {
-		return x.Pk
+		return x.Force
 	}
-	return 0
+	return false
 }
 
-type ListRoleRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
-	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
-	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
-	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
-	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
-	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
-	Name            string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
-	Descr           string                 `protobuf:"bytes,10,opt,name=descr,proto3" json:"descr,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListUserRoleGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        uint32                 `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"` // 仅查询当前有效的授权
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoleRequest) Reset() {
-	*x = ListRoleRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[31]
+func (x *ListUserRoleGrantRequest) Reset() {
+	*x = ListUserRoleGrantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoleRequest) String() string {
+func (x *ListUserRoleGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleRequest) ProtoMessage() {}
+func (*ListUserRoleGrantRequest) ProtoMessage() {}
 
-func (x *ListRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[31]
+func (x *ListUserRoleGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,107 +7764,153 @@ func (x *ListRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleRequest.ProtoReflect.Descriptor instead.
-func (*ListRoleRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListUserRoleGrantRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRoleGrantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *ListRoleRequest) GetPage() int64 {
+func (x *ListUserRoleGrantRequest) GetPage() int64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListRoleRequest) GetSize() int64 {
+func (x *ListUserRoleGrantRequest) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *ListRoleRequest) GetPk() uint32 {
+func (x *ListUserRoleGrantRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Pk
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListRoleRequest) GetPks() string {
+func (x *ListUserRoleGrantRequest) GetRoleId() uint32 {
 	if x != nil {
-		return x.Pks
+		return x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListRoleRequest) GetBeforeCreatedAt() string {
+func (x *ListUserRoleGrantRequest) GetActive() bool {
 	if x != nil {
-		return x.BeforeCreatedAt
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-func (x *ListRoleRequest) GetAfterCreatedAt() string {
+type ListRolePermissionGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	RoleId        uint32                 `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	PermissionId  uint32                 `protobuf:"varint,4,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"` // 仅查询当前有效的授权
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolePermissionGrantRequest) Reset() {
+	*x = ListRolePermissionGrantRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolePermissionGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolePermissionGrantRequest) ProtoMessage() {}
+
+func (x *ListRolePermissionGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[97]
 	if x != nil {
-		return x.AfterCreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListRoleRequest) GetBeforeUpdatedAt() string {
+// Deprecated: Use ListRolePermissionGrantRequest.ProtoReflect.Descriptor instead.
+func (*ListRolePermissionGrantRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListRolePermissionGrantRequest) GetPage() int64 {
 	if x != nil {
-		return x.BeforeUpdatedAt
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *ListRoleRequest) GetAfterUpdatedAt() string {
+func (x *ListRolePermissionGrantRequest) GetSize() int64 {
 	if x != nil {
-		return x.AfterUpdatedAt
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *ListRoleRequest) GetName() string {
+func (x *ListRolePermissionGrantRequest) GetRoleId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListRoleRequest) GetDescr() string {
+func (x *ListRolePermissionGrantRequest) GetPermissionId() uint32 {
 	if x != nil {
-		return x.Descr
+		return x.PermissionId
 	}
-	return ""
+	return 0
 }
 
-type RoleOutBase struct {
+func (x *ListRolePermissionGrantRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type UserRoleGrantOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          *RoleOutBase           `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	StartAt       string                 `protobuf:"bytes,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         string                 `protobuf:"bytes,8,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Descr         string                 `protobuf:"bytes,9,opt,name=descr,proto3" json:"descr,omitempty"`
+	Active        bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleOutBase) Reset() {
-	*x = RoleOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[32]
+func (x *UserRoleGrantOut) Reset() {
+	*x = UserRoleGrantOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleOutBase) String() string {
+func (x *UserRoleGrantOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleOutBase) ProtoMessage() {}
+func (*UserRoleGrantOut) ProtoMessage() {}
 
-func (x *RoleOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[32]
+func (x *UserRoleGrantOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2650,75 +7921,112 @@ func (x *RoleOutBase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleOutBase.ProtoReflect.Descriptor instead.
-func (*RoleOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UserRoleGrantOut.ProtoReflect.Descriptor instead.
+func (*UserRoleGrantOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *RoleOutBase) GetId() uint32 {
+func (x *UserRoleGrantOut) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *RoleOutBase) GetCreatedAt() string {
+func (x *UserRoleGrantOut) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *RoleOutBase) GetUpdatedAt() string {
+func (x *UserRoleGrantOut) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *RoleOutBase) GetName() string {
+func (x *UserRoleGrantOut) GetTenantId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *UserRoleGrantOut) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserRoleGrantOut) GetRole() *RoleOutBase {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *UserRoleGrantOut) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
 	}
 	return ""
 }
 
-func (x *RoleOutBase) GetDescr() string {
+func (x *UserRoleGrantOut) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *UserRoleGrantOut) GetDescr() string {
 	if x != nil {
 		return x.Descr
 	}
 	return ""
 }
 
-type RoleOut struct {
+func (x *UserRoleGrantOut) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type RolePermissionGrantOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Descr         string                 `protobuf:"bytes,5,opt,name=descr,proto3" json:"descr,omitempty"`
-	Permissions   []*PermissionOutBase   `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Menus         []*MenuOutBase         `protobuf:"bytes,7,rep,name=menus,proto3" json:"menus,omitempty"`
-	Buttons       []*ButtonOutBase       `protobuf:"bytes,8,rep,name=buttons,proto3" json:"buttons,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RoleId        uint32                 `protobuf:"varint,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Permission    *PermissionOutBase     `protobuf:"bytes,6,opt,name=permission,proto3" json:"permission,omitempty"`
+	StartAt       string                 `protobuf:"bytes,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         string                 `protobuf:"bytes,8,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Descr         string                 `protobuf:"bytes,9,opt,name=descr,proto3" json:"descr,omitempty"`
+	Active        bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleOut) Reset() {
-	*x = RoleOut{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[33]
+func (x *RolePermissionGrantOut) Reset() {
+	*x = RolePermissionGrantOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleOut) String() string {
+func (x *RolePermissionGrantOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleOut) ProtoMessage() {}
+func (*RolePermissionGrantOut) ProtoMessage() {}
 
-func (x *RoleOut) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[33]
+func (x *RolePermissionGrantOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,93 +8037,107 @@ func (x *RoleOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleOut.ProtoReflect.Descriptor instead.
-func (*RoleOut) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use RolePermissionGrantOut.ProtoReflect.Descriptor instead.
+func (*RolePermissionGrantOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *RoleOut) GetId() uint32 {
+func (x *RolePermissionGrantOut) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *RoleOut) GetCreatedAt() string {
+func (x *RolePermissionGrantOut) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *RoleOut) GetUpdatedAt() string {
+func (x *RolePermissionGrantOut) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *RoleOut) GetName() string {
+func (x *RolePermissionGrantOut) GetTenantId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.TenantId
 	}
-	return ""
+	return 0
 }
 
-func (x *RoleOut) GetDescr() string {
+func (x *RolePermissionGrantOut) GetRoleId() uint32 {
 	if x != nil {
-		return x.Descr
+		return x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *RoleOut) GetPermissions() []*PermissionOutBase {
+func (x *RolePermissionGrantOut) GetPermission() *PermissionOutBase {
 	if x != nil {
-		return x.Permissions
+		return x.Permission
 	}
 	return nil
 }
 
-func (x *RoleOut) GetMenus() []*MenuOutBase {
+func (x *RolePermissionGrantOut) GetStartAt() string {
 	if x != nil {
-		return x.Menus
+		return x.StartAt
 	}
-	return nil
+	return ""
 }
 
-func (x *RoleOut) GetButtons() []*ButtonOutBase {
+func (x *RolePermissionGrantOut) GetEndAt() string {
 	if x != nil {
-		return x.Buttons
+		return x.EndAt
 	}
-	return nil
+	return ""
 }
 
-type PagRoleOutBase struct {
+func (x *RolePermissionGrantOut) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *RolePermissionGrantOut) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type PagUserRoleGrantOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
-	Items         []*RoleOutBase         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*UserRoleGrantOut    `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PagRoleOutBase) Reset() {
-	*x = PagRoleOutBase{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[34]
+func (x *PagUserRoleGrantOut) Reset() {
+	*x = PagUserRoleGrantOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PagRoleOutBase) String() string {
+func (x *PagUserRoleGrantOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PagRoleOutBase) ProtoMessage() {}
+func (*PagUserRoleGrantOut) ProtoMessage() {}
 
-func (x *PagRoleOutBase) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[34]
+func (x *PagUserRoleGrantOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2826,72 +8148,72 @@ func (x *PagRoleOutBase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PagRoleOutBase.ProtoReflect.Descriptor instead.
-func (*PagRoleOutBase) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use PagUserRoleGrantOut.ProtoReflect.Descriptor instead.
+func (*PagUserRoleGrantOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *PagRoleOutBase) GetPage() int64 {
+func (x *PagUserRoleGrantOut) GetPage() int64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *PagRoleOutBase) GetSize() int64 {
+func (x *PagUserRoleGrantOut) GetSize() int64 {
 	if x != nil {
 		return x.Size
 	}
 	return 0
 }
 
-func (x *PagRoleOutBase) GetTotal() int64 {
+func (x *PagUserRoleGrantOut) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *PagRoleOutBase) GetPages() int64 {
+func (x *PagUserRoleGrantOut) GetPages() int64 {
 	if x != nil {
 		return x.Pages
 	}
 	return 0
 }
 
-func (x *PagRoleOutBase) GetItems() []*RoleOutBase {
+func (x *PagUserRoleGrantOut) GetItems() []*UserRoleGrantOut {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsStaff       bool                   `protobuf:"varint,4,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
-	RoleId        uint32                 `protobuf:"varint,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+type PagRolePermissionGrantOut struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Page          int64                     `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                     `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total         int64                     `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Pages         int64                     `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	Items         []*RolePermissionGrantOut `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[35]
+func (x *PagRolePermissionGrantOut) Reset() {
+	*x = PagRolePermissionGrantOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *PagRolePermissionGrantOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*PagRolePermissionGrantOut) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[35]
+func (x *PagRolePermissionGrantOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2902,72 +8224,119 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use PagRolePermissionGrantOut.ProtoReflect.Descriptor instead.
+func (*PagRolePermissionGrantOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *CreateUserRequest) GetUsername() string {
+func (x *PagRolePermissionGrantOut) GetPage() int64 {
 	if x != nil {
-		return x.Username
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateUserRequest) GetPassword() string {
+func (x *PagRolePermissionGrantOut) GetSize() int64 {
 	if x != nil {
-		return x.Password
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateUserRequest) GetIsActive() bool {
+func (x *PagRolePermissionGrantOut) GetTotal() int64 {
 	if x != nil {
-		return x.IsActive
+		return x.Total
 	}
-	return false
+	return 0
 }
 
-func (x *CreateUserRequest) GetIsStaff() bool {
+func (x *PagRolePermissionGrantOut) GetPages() int64 {
 	if x != nil {
-		return x.IsStaff
+		return x.Pages
 	}
-	return false
+	return 0
 }
 
-func (x *CreateUserRequest) GetRoleId() uint32 {
+func (x *PagRolePermissionGrantOut) GetItems() []*RolePermissionGrantOut {
 	if x != nil {
-		return x.RoleId
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-type UpdateUserRequest struct {
+type IntrospectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsStaff       bool                   `protobuf:"varint,4,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
-	RoleId        uint32                 `protobuf:"varint,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	Pk            uint32                 `protobuf:"varint,6,opt,name=pk,proto3" json:"pk,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectRequest) Reset() {
+	*x = IntrospectRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectRequest) ProtoMessage() {}
+
+func (x *IntrospectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *IntrospectRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type TokenClaims struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DeptId        uint32                 `protobuf:"varint,3,opt,name=dept_id,json=deptId,proto3" json:"dept_id,omitempty"`
+	IsStaff       bool                   `protobuf:"varint,4,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
+	Roles         []string               `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	TokenId       string                 `protobuf:"bytes,6,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	IssuedAt      int64                  `protobuf:"varint,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`    // 签发时间，Unix秒
+	ExpiresAt     int64                  `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 过期时间，Unix秒
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[36]
+func (x *TokenClaims) Reset() {
+	*x = TokenClaims{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *TokenClaims) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*TokenClaims) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[36]
+func (x *TokenClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2978,68 +8347,91 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use TokenClaims.ProtoReflect.Descriptor instead.
+func (*TokenClaims) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UpdateUserRequest) GetUsername() string {
+func (x *TokenClaims) GetUserId() uint32 {
 	if x != nil {
-		return x.Username
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateUserRequest) GetIsActive() bool {
+func (x *TokenClaims) GetTenantId() uint32 {
 	if x != nil {
-		return x.IsActive
+		return x.TenantId
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateUserRequest) GetIsStaff() bool {
+func (x *TokenClaims) GetDeptId() uint32 {
+	if x != nil {
+		return x.DeptId
+	}
+	return 0
+}
+
+func (x *TokenClaims) GetIsStaff() bool {
 	if x != nil {
 		return x.IsStaff
 	}
 	return false
 }
 
-func (x *UpdateUserRequest) GetRoleId() uint32 {
+func (x *TokenClaims) GetRoles() []string {
 	if x != nil {
-		return x.RoleId
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *TokenClaims) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *TokenClaims) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
 	}
 	return 0
 }
 
-func (x *UpdateUserRequest) GetPk() uint32 {
+func (x *TokenClaims) GetExpiresAt() int64 {
 	if x != nil {
-		return x.Pk
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-type DeleteUserRequest struct {
+type IntrospectOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"` // 令牌是否有效
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`  // 令牌无效的原因: revoked / expired / invalid
+	Claims        *TokenClaims           `protobuf:"bytes,3,opt,name=claims,proto3" json:"claims,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[37]
+func (x *IntrospectOut) Reset() {
+	*x = IntrospectOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *IntrospectOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*IntrospectOut) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[37]
+func (x *IntrospectOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3050,40 +8442,57 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use IntrospectOut.ProtoReflect.Descriptor instead.
+func (*IntrospectOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *DeleteUserRequest) GetPk() uint32 {
+func (x *IntrospectOut) GetActive() bool {
 	if x != nil {
-		return x.Pk
+		return x.Active
 	}
-	return 0
+	return false
 }
 
-type GetUserRequest struct {
+func (x *IntrospectOut) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IntrospectOut) GetClaims() *TokenClaims {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+type CheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Obj           string                 `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj,omitempty"` // 访问的URL
+	Act           string                 `protobuf:"bytes,3,opt,name=act,proto3" json:"act,omitempty"` // 请求方法
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`   // 客户端IP，用于判断策略的网段条件
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[38]
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *CheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*CheckRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[38]
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3094,51 +8503,64 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetUserRequest) GetPk() uint32 {
+func (x *CheckRequest) GetToken() string {
 	if x != nil {
-		return x.Pk
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type ListUserRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Page            int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size            int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Pk              uint32                 `protobuf:"varint,3,opt,name=pk,proto3" json:"pk,omitempty"`
-	Pks             string                 `protobuf:"bytes,4,opt,name=pks,proto3" json:"pks,omitempty"`
-	BeforeCreatedAt string                 `protobuf:"bytes,5,opt,name=before_created_at,json=beforeCreatedAt,proto3" json:"before_created_at,omitempty"`
-	AfterCreatedAt  string                 `protobuf:"bytes,6,opt,name=after_created_at,json=afterCreatedAt,proto3" json:"after_created_at,omitempty"`
-	BeforeUpdatedAt string                 `protobuf:"bytes,7,opt,name=before_updated_at,json=beforeUpdatedAt,proto3" json:"before_updated_at,omitempty"`
-	AfterUpdatedAt  string                 `protobuf:"bytes,8,opt,name=after_updated_at,json=afterUpdatedAt,proto3" json:"after_updated_at,omitempty"`
-	Username        string                 `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
-	IsActive        *BoolValue             `protobuf:"bytes,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsStaff         *BoolValue             `protobuf:"bytes,11,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
-	RoleId          uint32                 `protobuf:"varint,12,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CheckRequest) GetObj() string {
+	if x != nil {
+		return x.Obj
+	}
+	return ""
 }
 
-func (x *ListUserRequest) Reset() {
-	*x = ListUserRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[39]
+func (x *CheckRequest) GetAct() string {
+	if x != nil {
+		return x.Act
+	}
+	return ""
+}
+
+func (x *CheckRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type CheckOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Claims        *TokenClaims           `protobuf:"bytes,3,opt,name=claims,proto3" json:"claims,omitempty"`
+	Allowed       bool                   `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOut) Reset() {
+	*x = CheckOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserRequest) String() string {
+func (x *CheckOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRequest) ProtoMessage() {}
+func (*CheckOut) ProtoMessage() {}
 
-func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[39]
+func (x *CheckOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3149,118 +8571,115 @@ func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
-func (*ListUserRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CheckOut.ProtoReflect.Descriptor instead.
+func (*CheckOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ListUserRequest) GetPage() int64 {
+func (x *CheckOut) GetActive() bool {
 	if x != nil {
-		return x.Page
+		return x.Active
 	}
-	return 0
+	return false
 }
 
-func (x *ListUserRequest) GetSize() int64 {
+func (x *CheckOut) GetReason() string {
 	if x != nil {
-		return x.Size
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUserRequest) GetPk() uint32 {
+func (x *CheckOut) GetClaims() *TokenClaims {
 	if x != nil {
-		return x.Pk
+		return x.Claims
 	}
-	return 0
+	return nil
 }
 
-func (x *ListUserRequest) GetPks() string {
+func (x *CheckOut) GetAllowed() bool {
 	if x != nil {
-		return x.Pks
+		return x.Allowed
 	}
-	return ""
+	return false
 }
 
-func (x *ListUserRequest) GetBeforeCreatedAt() string {
-	if x != nil {
-		return x.BeforeCreatedAt
-	}
-	return ""
+type CheckItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Obj           string                 `protobuf:"bytes,1,opt,name=obj,proto3" json:"obj,omitempty"`
+	Act           string                 `protobuf:"bytes,2,opt,name=act,proto3" json:"act,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserRequest) GetAfterCreatedAt() string {
-	if x != nil {
-		return x.AfterCreatedAt
-	}
-	return ""
+func (x *CheckItem) Reset() {
+	*x = CheckItem{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserRequest) GetBeforeUpdatedAt() string {
-	if x != nil {
-		return x.BeforeUpdatedAt
-	}
-	return ""
+func (x *CheckItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListUserRequest) GetAfterUpdatedAt() string {
-	if x != nil {
-		return x.AfterUpdatedAt
-	}
-	return ""
-}
+func (*CheckItem) ProtoMessage() {}
 
-func (x *ListUserRequest) GetUsername() string {
+func (x *CheckItem) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[107]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListUserRequest) GetIsActive() *BoolValue {
-	if x != nil {
-		return x.IsActive
-	}
-	return nil
+// Deprecated: Use CheckItem.ProtoReflect.Descriptor instead.
+func (*CheckItem) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ListUserRequest) GetIsStaff() *BoolValue {
+func (x *CheckItem) GetObj() string {
 	if x != nil {
-		return x.IsStaff
+		return x.Obj
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUserRequest) GetRoleId() uint32 {
+func (x *CheckItem) GetAct() string {
 	if x != nil {
-		return x.RoleId
+		return x.Act
 	}
-	return 0
+	return ""
 }
 
-type LoginRequest struct {
+type CheckManyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Items         []*CheckItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[40]
+func (x *CheckManyRequest) Reset() {
+	*x = CheckManyRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *CheckManyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*CheckManyRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[40]
+func (x *CheckManyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3271,53 +8690,57 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CheckManyRequest.ProtoReflect.Descriptor instead.
+func (*CheckManyRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *LoginRequest) GetUsername() string {
+func (x *CheckManyRequest) GetToken() string {
 	if x != nil {
-		return x.Username
+		return x.Token
 	}
 	return ""
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *CheckManyRequest) GetItems() []*CheckItem {
 	if x != nil {
-		return x.Password
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CheckManyRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
 	}
 	return ""
 }
 
-type UserOut struct {
+type CheckManyOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsStaff       bool                   `protobuf:"varint,6,opt,name=is_staff,json=isStaff,proto3" json:"is_staff,omitempty"`
-	Role          *RoleOutBase           `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Claims        *TokenClaims           `protobuf:"bytes,3,opt,name=claims,proto3" json:"claims,omitempty"`
+	Allowed       []bool                 `protobuf:"varint,4,rep,packed,name=allowed,proto3" json:"allowed,omitempty"` // 与请求中的 items 一一对应
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserOut) Reset() {
-	*x = UserOut{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[41]
+func (x *CheckManyOut) Reset() {
+	*x = CheckManyOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserOut) String() string {
+func (x *CheckManyOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserOut) ProtoMessage() {}
+func (*CheckManyOut) ProtoMessage() {}
 
-func (x *UserOut) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[41]
+func (x *CheckManyOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3328,86 +8751,61 @@ func (x *UserOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserOut.ProtoReflect.Descriptor instead.
-func (*UserOut) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *UserOut) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UserOut) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *UserOut) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
+// Deprecated: Use CheckManyOut.ProtoReflect.Descriptor instead.
+func (*CheckManyOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *UserOut) GetUsername() string {
+func (x *CheckManyOut) GetActive() bool {
 	if x != nil {
-		return x.Username
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-func (x *UserOut) GetIsActive() bool {
+func (x *CheckManyOut) GetReason() string {
 	if x != nil {
-		return x.IsActive
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-func (x *UserOut) GetIsStaff() bool {
+func (x *CheckManyOut) GetClaims() *TokenClaims {
 	if x != nil {
-		return x.IsStaff
+		return x.Claims
 	}
-	return false
+	return nil
 }
 
-func (x *UserOut) GetRole() *RoleOutBase {
+func (x *CheckManyOut) GetAllowed() []bool {
 	if x != nil {
-		return x.Role
+		return x.Allowed
 	}
 	return nil
 }
 
-type PagUserOut struct {
+type ExportRbacRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	Pages         int64                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
-	Items         []*UserOut             `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // yaml / json，为空时为 yaml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PagUserOut) Reset() {
-	*x = PagUserOut{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[42]
+func (x *ExportRbacRequest) Reset() {
+	*x = ExportRbacRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PagUserOut) String() string {
+func (x *ExportRbacRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PagUserOut) ProtoMessage() {}
+func (*ExportRbacRequest) ProtoMessage() {}
 
-func (x *PagUserOut) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[42]
+func (x *ExportRbacRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,69 +8816,95 @@ func (x *PagUserOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PagUserOut.ProtoReflect.Descriptor instead.
-func (*PagUserOut) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ExportRbacRequest.ProtoReflect.Descriptor instead.
+func (*ExportRbacRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *PagUserOut) GetPage() int64 {
+func (x *ExportRbacRequest) GetFormat() string {
 	if x != nil {
-		return x.Page
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *PagUserOut) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+type RbacDocumentOut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PagUserOut) GetTotal() int64 {
+func (x *RbacDocumentOut) Reset() {
+	*x = RbacDocumentOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RbacDocumentOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RbacDocumentOut) ProtoMessage() {}
+
+func (x *RbacDocumentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[111]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PagUserOut) GetPages() int64 {
+// Deprecated: Use RbacDocumentOut.ProtoReflect.Descriptor instead.
+func (*RbacDocumentOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RbacDocumentOut) GetFormat() string {
 	if x != nil {
-		return x.Pages
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *PagUserOut) GetItems() []*UserOut {
+func (x *RbacDocumentOut) GetContent() string {
 	if x != nil {
-		return x.Items
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-type ResetPasswordRequest struct {
+type ImportRbacRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pk            uint32                 `protobuf:"varint,1,opt,name=pk,proto3" json:"pk,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // yaml / json，为空时为 yaml
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回将产生的变更，不写入数据
+	Prune         bool                   `protobuf:"varint,4,opt,name=prune,proto3" json:"prune,omitempty"`                 // 删除文档中未描述的数据
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordRequest) Reset() {
-	*x = ResetPasswordRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[43]
+func (x *ImportRbacRequest) Reset() {
+	*x = ImportRbacRequest{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordRequest) String() string {
+func (x *ImportRbacRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordRequest) ProtoMessage() {}
+func (*ImportRbacRequest) ProtoMessage() {}
 
-func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[43]
+func (x *ImportRbacRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3491,49 +8915,65 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ImportRbacRequest.ProtoReflect.Descriptor instead.
+func (*ImportRbacRequest) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ResetPasswordRequest) GetPk() uint32 {
+func (x *ImportRbacRequest) GetFormat() string {
 	if x != nil {
-		return x.Pk
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *ResetPasswordRequest) GetPassword() string {
+func (x *ImportRbacRequest) GetContent() string {
 	if x != nil {
-		return x.Password
+		return x.Content
 	}
 	return ""
 }
 
-type ChangePasswordRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OldPassword     string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
-	ConfirmPassword string                 `protobuf:"bytes,3,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ImportRbacRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-func (x *ChangePasswordRequest) Reset() {
-	*x = ChangePasswordRequest{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[44]
+func (x *ImportRbacRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type RbacChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`     // permission / menu / button / role
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`       // 自然键
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // create / update / delete / skip
+	Fields        []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"` // 更新时发生变化的字段
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // 跳过的原因，如角色仍被用户持有
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RbacChange) Reset() {
+	*x = RbacChange{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordRequest) String() string {
+func (x *RbacChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordRequest) ProtoMessage() {}
+func (*RbacChange) ProtoMessage() {}
 
-func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[44]
+func (x *RbacChange) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3544,54 +8984,69 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RbacChange.ProtoReflect.Descriptor instead.
+func (*RbacChange) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ChangePasswordRequest) GetOldPassword() string {
+func (x *RbacChange) GetKind() string {
 	if x != nil {
-		return x.OldPassword
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *ChangePasswordRequest) GetNewPassword() string {
+func (x *RbacChange) GetKey() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Key
 	}
 	return ""
 }
 
-func (x *ChangePasswordRequest) GetConfirmPassword() string {
+func (x *RbacChange) GetAction() string {
 	if x != nil {
-		return x.ConfirmPassword
+		return x.Action
 	}
 	return ""
 }
 
-type LoginOut struct {
+func (x *RbacChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RbacChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportRbacOut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"` // 变更是否已写入，试运行时为false
+	Changes       []*RbacChange          `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginOut) Reset() {
-	*x = LoginOut{}
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[45]
+func (x *ImportRbacOut) Reset() {
+	*x = ImportRbacOut{}
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginOut) String() string {
+func (x *ImportRbacOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginOut) ProtoMessage() {}
+func (*ImportRbacOut) ProtoMessage() {}
 
-func (x *LoginOut) ProtoReflect() protoreflect.Message {
-	mi := &file_apps_customer_rpc_customer_proto_msgTypes[45]
+func (x *ImportRbacOut) ProtoReflect() protoreflect.Message {
+	mi := &file_apps_customer_rpc_customer_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3602,16 +9057,23 @@ func (x *LoginOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginOut.ProtoReflect.Descriptor instead.
-func (*LoginOut) Descriptor() ([]byte, []int) {
-	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ImportRbacOut.ProtoReflect.Descriptor instead.
+func (*ImportRbacOut) Descriptor() ([]byte, []int) {
+	return file_apps_customer_rpc_customer_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *LoginOut) GetToken() string {
+func (x *ImportRbacOut) GetApplied() bool {
 	if x != nil {
-		return x.Token
+		return x.Applied
 	}
-	return ""
+	return false
+}
+
+func (x *ImportRbacOut) GetChanges() []*RbacChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
 }
 
 var File_apps_customer_rpc_customer_proto protoreflect.FileDescriptor
@@ -3623,23 +9085,54 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x05value\x18\x01 \x01(\rR\x05value\"!\n" +
 	"\tBoolValue\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\bR\x05value\"\b\n" +
-	"\x06NilOut\"\x7f\n" +
+	"\x06NilOut\"M\n" +
+	"\x0fDeleteDependent\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12\x10\n" +
+	"\x03ids\x18\x03 \x03(\rR\x03ids\"y\n" +
+	"\tDeleteOut\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\x129\n" +
+	"\n" +
+	"dependents\x18\x03 \x03(\v2\x19.customer.DeleteDependentR\n" +
+	"dependents\"\xbf\x01\n" +
+	"\x10PolicyConditions\x12\x14\n" +
+	"\x05cidrs\x18\x01 \x03(\tR\x05cidrs\x12\x1a\n" +
+	"\bweekdays\x18\x02 \x03(\x05R\bweekdays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12#\n" +
+	"\rrequire_staff\x18\x06 \x01(\bR\frequireStaff\"\x8a\x02\n" +
 	"\x17CreatePermissionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
 	"\x06method\x18\x03 \x01(\tR\x06method\x12\x14\n" +
 	"\x05label\x18\x04 \x01(\tR\x05label\x12\x14\n" +
-	"\x05descr\x18\x05 \x01(\tR\x05descr\"\x7f\n" +
+	"\x05descr\x18\x05 \x01(\tR\x05descr\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\rR\btenantId\x12\x18\n" +
+	"\amatcher\x18\a \x01(\tR\amatcher\x12\x16\n" +
+	"\x06effect\x18\b \x01(\tR\x06effect\x12:\n" +
+	"\n" +
+	"conditions\x18\t \x01(\v2\x1a.customer.PolicyConditionsR\n" +
+	"conditions\"\xed\x01\n" +
 	"\x17UpdatePermissionRequest\x12\x0e\n" +
 	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
 	"\x06method\x18\x03 \x01(\tR\x06method\x12\x14\n" +
 	"\x05label\x18\x04 \x01(\tR\x05label\x12\x14\n" +
-	"\x05descr\x18\x05 \x01(\tR\x05descr\"&\n" +
+	"\x05descr\x18\x05 \x01(\tR\x05descr\x12\x18\n" +
+	"\amatcher\x18\x06 \x01(\tR\amatcher\x12\x16\n" +
+	"\x06effect\x18\a \x01(\tR\x06effect\x12:\n" +
+	"\n" +
+	"conditions\x18\b \x01(\v2\x1a.customer.PolicyConditionsR\n" +
+	"conditions\"&\n" +
 	"\x14GetPermissionRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\")\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\"X\n" +
 	"\x17DeletePermissionRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xe3\x02\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\xc9\x03\n" +
 	"\x15ListPermissionRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x0e\n" +
@@ -3653,7 +9146,10 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x06method\x18\n" +
 	" \x01(\tR\x06method\x12\x14\n" +
 	"\x05label\x18\v \x01(\tR\x05label\x12\x14\n" +
-	"\x05descr\x18\f \x01(\tR\x05descr\"\xb7\x01\n" +
+	"\x05descr\x18\f \x01(\tR\x05descr\x122\n" +
+	"\ttenant_id\x18\r \x01(\v2\x15.customer.UInt32ValueR\btenantId\x12\x18\n" +
+	"\amatcher\x18\x0e \x01(\tR\amatcher\x12\x16\n" +
+	"\x06effect\x18\x0f \x01(\tR\x06effect\"\xc2\x02\n" +
 	"\x11PermissionOutBase\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3663,13 +9159,63 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x03url\x18\x04 \x01(\tR\x03url\x12\x16\n" +
 	"\x06method\x18\x05 \x01(\tR\x06method\x12\x14\n" +
 	"\x05label\x18\x06 \x01(\tR\x05label\x12\x14\n" +
-	"\x05descr\x18\a \x01(\tR\x05descr\"\x9d\x01\n" +
+	"\x05descr\x18\a \x01(\tR\x05descr\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\rR\btenantId\x12\x18\n" +
+	"\amatcher\x18\t \x01(\tR\amatcher\x12\x16\n" +
+	"\x06effect\x18\n" +
+	" \x01(\tR\x06effect\x12:\n" +
+	"\n" +
+	"conditions\x18\v \x01(\v2\x1a.customer.PolicyConditionsR\n" +
+	"conditions\"\x9d\x01\n" +
 	"\x14PagPermissionOutBase\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
 	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
 	"\x05pages\x18\x04 \x01(\x03R\x05pages\x121\n" +
-	"\x05items\x18\x05 \x03(\v2\x1b.customer.PermissionOutBaseR\x05items\"\xc6\x02\n" +
+	"\x05items\x18\x05 \x03(\v2\x1b.customer.PermissionOutBaseR\x05items\"\xc6\x01\n" +
+	"\x14ExplainAccessRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\rR\x06roleId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\tR\x04time\x12.\n" +
+	"\bis_staff\x18\a \x01(\v2\x13.customer.BoolValueR\aisStaff\"b\n" +
+	"\x0eExplainSubject\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"\xa5\x03\n" +
+	"\vExplainRule\x122\n" +
+	"\asubject\x18\x01 \x01(\v2\x18.customer.ExplainSubjectR\asubject\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x18\n" +
+	"\amatcher\x18\x05 \x01(\tR\amatcher\x12\x16\n" +
+	"\x06effect\x18\x06 \x01(\tR\x06effect\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\a \x01(\tR\n" +
+	"conditions\x12.\n" +
+	"\x05chain\x18\b \x03(\v2\x18.customer.ExplainSubjectR\x05chain\x12%\n" +
+	"\x0edomain_matched\x18\t \x01(\bR\rdomainMatched\x12!\n" +
+	"\fpath_matched\x18\n" +
+	" \x01(\bR\vpathMatched\x12%\n" +
+	"\x0emethod_matched\x18\v \x01(\bR\rmethodMatched\x12-\n" +
+	"\x12conditions_matched\x18\f \x01(\bR\x11conditionsMatched\"\xc7\x01\n" +
+	"\x10ExplainAccessOut\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12/\n" +
+	"\amatched\x18\x04 \x03(\v2\x15.customer.ExplainRuleR\amatched\x126\n" +
+	"\vnear_misses\x18\x05 \x03(\v2\x15.customer.ExplainRuleR\n" +
+	"nearMisses\"1\n" +
+	"\x16SyncPermissionsRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\xcf\x01\n" +
+	"\x12SyncPermissionsOut\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x125\n" +
+	"\acreated\x18\x02 \x03(\v2\x1b.customer.PermissionOutBaseR\acreated\x125\n" +
+	"\aupdated\x18\x03 \x03(\v2\x1b.customer.PermissionOutBaseR\aupdated\x121\n" +
+	"\x05stale\x18\x04 \x03(\v2\x1b.customer.PermissionOutBaseR\x05stale\"\xe3\x02\n" +
 	"\x11CreateMenuRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1c\n" +
@@ -3682,7 +9228,8 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x05descr\x18\t \x01(\tR\x05descr\x12\x1b\n" +
 	"\tparent_id\x18\n" +
 	" \x01(\rR\bparentId\x12%\n" +
-	"\x0epermission_ids\x18\v \x03(\rR\rpermissionIds\"\xc6\x02\n" +
+	"\x0epermission_ids\x18\v \x03(\rR\rpermissionIds\x12\x1b\n" +
+	"\ttenant_id\x18\f \x01(\rR\btenantId\"\xc6\x02\n" +
 	"\x11UpdateMenuRequest\x12\x0e\n" +
 	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1c\n" +
@@ -3695,11 +9242,13 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x05descr\x18\t \x01(\tR\x05descr\x12\x1b\n" +
 	"\tparent_id\x18\n" +
 	" \x01(\rR\bparentId\x12%\n" +
-	"\x0epermission_ids\x18\v \x03(\rR\rpermissionIds\"#\n" +
+	"\x0epermission_ids\x18\v \x03(\rR\rpermissionIds\"R\n" +
 	"\x11DeleteMenuRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\" \n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\" \n" +
 	"\x0eGetMenuRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xdf\x03\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\"\x93\x04\n" +
 	"\x0fListMenuRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x0e\n" +
@@ -3716,10 +9265,47 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x05label\x18\f \x01(\tR\x05label\x120\n" +
 	"\tis_active\x18\r \x01(\v2\x13.customer.BoolValueR\bisActive\x12\x14\n" +
 	"\x05descr\x18\x0e \x01(\tR\x05descr\x122\n" +
-	"\tparent_id\x18\x0f \x01(\v2\x15.customer.UInt32ValueR\bparentId\"7\n" +
+	"\tparent_id\x18\x0f \x01(\v2\x15.customer.UInt32ValueR\bparentId\x122\n" +
+	"\ttenant_id\x18\x10 \x01(\v2\x15.customer.UInt32ValueR\btenantId\"F\n" +
+	"\x17ExportMenuRoutesRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\rR\x06roleId\x12\x12\n" +
+	"\x04mine\x18\x02 \x01(\bR\x04mine\")\n" +
+	"\rMenuRoutesOut\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"c\n" +
+	"\x0fMoveMenuRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12#\n" +
+	"\rarrange_order\x18\x03 \x01(\rR\farrangeOrder\"@\n" +
+	"\tMenuOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12#\n" +
+	"\rarrange_order\x18\x02 \x01(\rR\farrangeOrder\"]\n" +
+	"\x13ReorderMenusRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\rR\bparentId\x12)\n" +
+	"\x05items\x18\x02 \x03(\v2\x13.customer.MenuOrderR\x05items\"\xb0\x01\n" +
+	"\x12GetMenuTreeRequest\x12!\n" +
+	"\fwith_buttons\x18\x01 \x01(\bR\vwithButtons\x12)\n" +
+	"\x10with_permissions\x18\x02 \x01(\bR\x0fwithPermissions\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\x12\x17\n" +
+	"\arole_id\x18\x04 \x01(\rR\x06roleId\x12\x12\n" +
+	"\x04mine\x18\x05 \x01(\bR\x04mine\"\xf2\x02\n" +
 	"\vMetaSchemas\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
-	"\x04icon\x18\x02 \x01(\tR\x04icon\"\xba\x02\n" +
+	"\x04icon\x18\x02 \x01(\tR\x04icon\x12\x16\n" +
+	"\x06hidden\x18\x03 \x01(\bR\x06hidden\x12\x1d\n" +
+	"\n" +
+	"keep_alive\x18\x04 \x01(\bR\tkeepAlive\x12\x1a\n" +
+	"\bredirect\x18\x05 \x01(\tR\bredirect\x12\x14\n" +
+	"\x05affix\x18\x06 \x01(\bR\x05affix\x12#\n" +
+	"\rexternal_link\x18\a \x01(\tR\fexternalLink\x12\x14\n" +
+	"\x05badge\x18\b \x01(\tR\x05badge\x12\x1f\n" +
+	"\vactive_menu\x18\t \x01(\tR\n" +
+	"activeMenu\x129\n" +
+	"\x06titles\x18\n" +
+	" \x03(\v2!.customer.MetaSchemas.TitlesEntryR\x06titles\x1a9\n" +
+	"\vTitlesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd7\x02\n" +
 	"\vMenuOutBase\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3734,7 +9320,19 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\rarrange_order\x18\t \x01(\rR\farrangeOrder\x12\x1b\n" +
 	"\tis_active\x18\n" +
 	" \x01(\bR\bisActive\x12\x14\n" +
-	"\x05descr\x18\v \x01(\tR\x05descr\"\xa4\x03\n" +
+	"\x05descr\x18\v \x01(\tR\x05descr\x12\x1b\n" +
+	"\ttenant_id\x18\f \x01(\rR\btenantId\"\x80\x01\n" +
+	"\x0eMenuTreeButton\x12/\n" +
+	"\x06button\x18\x01 \x01(\v2\x17.customer.ButtonOutBaseR\x06button\x12=\n" +
+	"\vpermissions\x18\x02 \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\"\xfd\x01\n" +
+	"\fMenuTreeNode\x12)\n" +
+	"\x04menu\x18\x01 \x01(\v2\x15.customer.MenuOutBaseR\x04menu\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12=\n" +
+	"\vpermissions\x18\x03 \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\x122\n" +
+	"\abuttons\x18\x04 \x03(\v2\x18.customer.MenuTreeButtonR\abuttons\x122\n" +
+	"\bchildren\x18\x05 \x03(\v2\x16.customer.MenuTreeNodeR\bchildren\";\n" +
+	"\vMenuTreeOut\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.customer.MenuTreeNodeR\x05items\"\xc1\x03\n" +
 	"\aMenuOut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3751,13 +9349,14 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	" \x01(\bR\bisActive\x12\x14\n" +
 	"\x05descr\x18\v \x01(\tR\x05descr\x12-\n" +
 	"\x06parent\x18\f \x01(\v2\x15.customer.MenuOutBaseR\x06parent\x12=\n" +
-	"\vpermissions\x18\r \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\"\x91\x01\n" +
+	"\vpermissions\x18\r \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\x12\x1b\n" +
+	"\ttenant_id\x18\x0e \x01(\rR\btenantId\"\x91\x01\n" +
 	"\x0ePagMenuOutBase\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
 	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
 	"\x05pages\x18\x04 \x01(\x03R\x05pages\x12+\n" +
-	"\x05items\x18\x05 \x03(\v2\x15.customer.MenuOutBaseR\x05items\"\xd1\x01\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.customer.MenuOutBaseR\x05items\"\x82\x02\n" +
 	"\x13CreateButtonRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
@@ -3765,7 +9364,9 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x14\n" +
 	"\x05descr\x18\x05 \x01(\tR\x05descr\x12\x17\n" +
 	"\amenu_id\x18\x06 \x01(\rR\x06menuId\x12%\n" +
-	"\x0epermission_ids\x18\a \x03(\rR\rpermissionIds\"\xd1\x01\n" +
+	"\x0epermission_ids\x18\a \x03(\rR\rpermissionIds\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\rR\btenantId\x12\x12\n" +
+	"\x04code\x18\t \x01(\tR\x04code\"\xe5\x01\n" +
 	"\x13UpdateButtonRequest\x12\x0e\n" +
 	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
@@ -3773,11 +9374,14 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x14\n" +
 	"\x05descr\x18\x05 \x01(\tR\x05descr\x12\x17\n" +
 	"\amenu_id\x18\x06 \x01(\rR\x06menuId\x12%\n" +
-	"\x0epermission_ids\x18\a \x03(\rR\rpermissionIds\"%\n" +
+	"\x0epermission_ids\x18\a \x03(\rR\rpermissionIds\x12\x12\n" +
+	"\x04code\x18\b \x01(\tR\x04code\"T\n" +
 	"\x13DeleteButtonRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\"\"\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\"\n" +
 	"\x10GetButtonRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xfe\x02\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xc6\x03\n" +
 	"\x11ListButtonRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x0e\n" +
@@ -3791,7 +9395,9 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\tis_active\x18\n" +
 	" \x01(\v2\x13.customer.BoolValueR\bisActive\x12\x14\n" +
 	"\x05descr\x18\v \x01(\tR\x05descr\x12\x17\n" +
-	"\amenu_id\x18\f \x01(\rR\x06menuId\"\xc9\x01\n" +
+	"\amenu_id\x18\f \x01(\rR\x06menuId\x122\n" +
+	"\ttenant_id\x18\r \x01(\v2\x15.customer.UInt32ValueR\btenantId\x12\x12\n" +
+	"\x04code\x18\x0e \x01(\tR\x04code\"\xfa\x01\n" +
 	"\rButtonOutBase\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3801,7 +9407,9 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12#\n" +
 	"\rarrange_order\x18\x05 \x01(\rR\farrangeOrder\x12\x1b\n" +
 	"\tis_active\x18\x06 \x01(\bR\bisActive\x12\x14\n" +
-	"\x05descr\x18\a \x01(\tR\x05descr\"\xaf\x02\n" +
+	"\x05descr\x18\a \x01(\tR\x05descr\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\rR\btenantId\x12\x12\n" +
+	"\x04code\x18\t \x01(\tR\x04code\"\xe0\x02\n" +
 	"\tButtonOut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3813,20 +9421,42 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\tis_active\x18\x06 \x01(\bR\bisActive\x12\x14\n" +
 	"\x05descr\x18\a \x01(\tR\x05descr\x12)\n" +
 	"\x04menu\x18\b \x01(\v2\x15.customer.MenuOutBaseR\x04menu\x12=\n" +
-	"\vpermissions\x18\t \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\"\x95\x01\n" +
+	"\vpermissions\x18\t \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\x12\x1b\n" +
+	"\ttenant_id\x18\n" +
+	" \x01(\rR\btenantId\x12\x12\n" +
+	"\x04code\x18\v \x01(\tR\x04code\"\x1f\n" +
+	"\x1dListAllowedButtonCodesRequest\"z\n" +
+	"\x0fMenuButtonCodes\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\rR\x06menuId\x12\x1b\n" +
+	"\tmenu_name\x18\x02 \x01(\tR\bmenuName\x12\x1b\n" +
+	"\tmenu_path\x18\x03 \x01(\tR\bmenuPath\x12\x14\n" +
+	"\x05codes\x18\x04 \x03(\tR\x05codes\"H\n" +
+	"\x15AllowedButtonCodesOut\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.customer.MenuButtonCodesR\x05items\"\x95\x01\n" +
 	"\x10PagButtonOutBase\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
 	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
 	"\x05pages\x18\x04 \x01(\x03R\x05pages\x12-\n" +
-	"\x05items\x18\x05 \x03(\v2\x17.customer.ButtonOutBaseR\x05items\"\x9e\x01\n" +
+	"\x05items\x18\x05 \x03(\v2\x17.customer.ButtonOutBaseR\x05items\"\x80\x03\n" +
 	"\x11CreateRoleRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05descr\x18\x02 \x01(\tR\x05descr\x12%\n" +
 	"\x0epermission_ids\x18\x03 \x03(\rR\rpermissionIds\x12\x19\n" +
 	"\bmenu_ids\x18\x04 \x03(\rR\amenuIds\x12\x1d\n" +
 	"\n" +
-	"button_ids\x18\x05 \x03(\rR\tbuttonIds\"\xae\x01\n" +
+	"button_ids\x18\x05 \x03(\rR\tbuttonIds\x12\x1d\n" +
+	"\n" +
+	"parent_ids\x18\x06 \x03(\rR\tparentIds\x12\x1b\n" +
+	"\ttenant_id\x18\a \x01(\rR\btenantId\x12\x1d\n" +
+	"\n" +
+	"data_scope\x18\b \x01(\tR\tdataScope\x12\x19\n" +
+	"\bdept_ids\x18\t \x03(\rR\adeptIds\x12.\n" +
+	"\x13deny_permission_ids\x18\n" +
+	" \x03(\rR\x11denyPermissionIds\x12:\n" +
+	"\n" +
+	"conditions\x18\v \x01(\v2\x1a.customer.PolicyConditionsR\n" +
+	"conditions\"\xf3\x02\n" +
 	"\x11UpdateRoleRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05descr\x18\x02 \x01(\tR\x05descr\x12%\n" +
@@ -3834,11 +9464,49 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\bmenu_ids\x18\x04 \x03(\rR\amenuIds\x12\x1d\n" +
 	"\n" +
 	"button_ids\x18\x05 \x03(\rR\tbuttonIds\x12\x0e\n" +
-	"\x02pk\x18\x06 \x01(\rR\x02pk\"#\n" +
+	"\x02pk\x18\x06 \x01(\rR\x02pk\x12\x1d\n" +
+	"\n" +
+	"parent_ids\x18\a \x03(\rR\tparentIds\x12\x1d\n" +
+	"\n" +
+	"data_scope\x18\b \x01(\tR\tdataScope\x12\x19\n" +
+	"\bdept_ids\x18\t \x03(\rR\adeptIds\x12.\n" +
+	"\x13deny_permission_ids\x18\n" +
+	" \x03(\rR\x11denyPermissionIds\x12:\n" +
+	"\n" +
+	"conditions\x18\v \x01(\v2\x1a.customer.PolicyConditionsR\n" +
+	"conditions\"\x81\x01\n" +
 	"\x11DeleteRoleRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\" \n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12-\n" +
+	"\x13reassign_to_role_id\x18\x02 \x01(\rR\x10reassignToRoleId\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\" \n" +
 	"\x0eGetRoleRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xb1\x02\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\"L\n" +
+	"\x10CloneRoleRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05descr\x18\x03 \x01(\tR\x05descr\"v\n" +
+	"\x10DiffRolesRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x19\n" +
+	"\bother_pk\x18\x02 \x01(\rR\aotherPk\x127\n" +
+	"\bproposed\x18\x03 \x01(\v2\x1b.customer.UpdateRoleRequestR\bproposed\"3\n" +
+	"\rRoleDiffEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x90\x01\n" +
+	"\x10RoleDiffCategory\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12-\n" +
+	"\x05added\x18\x02 \x03(\v2\x17.customer.RoleDiffEntryR\x05added\x121\n" +
+	"\aremoved\x18\x03 \x03(\v2\x17.customer.RoleDiffEntryR\aremoved\"K\n" +
+	"\x0fRoleFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xa7\x01\n" +
+	"\vRoleDiffOut\x12)\n" +
+	"\x04base\x18\x01 \x01(\v2\x15.customer.RoleOutBaseR\x04base\x121\n" +
+	"\x06fields\x18\x02 \x03(\v2\x19.customer.RoleFieldChangeR\x06fields\x12:\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2\x1a.customer.RoleDiffCategoryR\n" +
+	"categories\"\xe5\x02\n" +
 	"\x0fListRoleRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x0e\n" +
@@ -3850,7 +9518,8 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x10after_updated_at\x18\b \x01(\tR\x0eafterUpdatedAt\x12\x12\n" +
 	"\x04name\x18\t \x01(\tR\x04name\x12\x14\n" +
 	"\x05descr\x18\n" +
-	" \x01(\tR\x05descr\"\x85\x01\n" +
+	" \x01(\tR\x05descr\x122\n" +
+	"\ttenant_id\x18\v \x01(\v2\x15.customer.UInt32ValueR\btenantId\"\xfd\x01\n" +
 	"\vRoleOutBase\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3858,7 +9527,33 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
-	"\x05descr\x18\x05 \x01(\tR\x05descr\"\xa0\x02\n" +
+	"\x05descr\x18\x05 \x01(\tR\x05descr\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\rR\btenantId\x12\x1d\n" +
+	"\n" +
+	"data_scope\x18\a \x01(\tR\tdataScope\x12:\n" +
+	"\n" +
+	"conditions\x18\b \x01(\v2\x1a.customer.PolicyConditionsR\n" +
+	"conditions\"v\n" +
+	"\x0eRoleImpactUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\rR\btenantId\"\x84\x01\n" +
+	"\x0fRoleImpactGrant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x19\n" +
+	"\bstart_at\x18\x03 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x04 \x01(\tR\x05endAt\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"\xb7\x03\n" +
+	"\rRoleImpactOut\x12)\n" +
+	"\x04role\x18\x01 \x01(\v2\x15.customer.RoleOutBaseR\x04role\x12.\n" +
+	"\x05users\x18\x02 \x03(\v2\x18.customer.RoleImpactUserR\x05users\x121\n" +
+	"\x06grants\x18\x03 \x03(\v2\x19.customer.RoleImpactGrantR\x06grants\x121\n" +
+	"\bchildren\x18\x04 \x03(\v2\x15.customer.RoleOutBaseR\bchildren\x12+\n" +
+	"\x05menus\x18\x05 \x03(\v2\x15.customer.MenuOutBaseR\x05menus\x121\n" +
+	"\abuttons\x18\x06 \x03(\v2\x17.customer.ButtonOutBaseR\abuttons\x12=\n" +
+	"\vpermissions\x18\a \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\x12F\n" +
+	"\x10deny_permissions\x18\b \x03(\v2\x1b.customer.PermissionOutBaseR\x0fdenyPermissions\"\x96\x06\n" +
 	"\aRoleOut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3869,29 +9564,47 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\x05descr\x18\x05 \x01(\tR\x05descr\x12=\n" +
 	"\vpermissions\x18\x06 \x03(\v2\x1b.customer.PermissionOutBaseR\vpermissions\x12+\n" +
 	"\x05menus\x18\a \x03(\v2\x15.customer.MenuOutBaseR\x05menus\x121\n" +
-	"\abuttons\x18\b \x03(\v2\x17.customer.ButtonOutBaseR\abuttons\"\x91\x01\n" +
+	"\abuttons\x18\b \x03(\v2\x17.customer.ButtonOutBaseR\abuttons\x12/\n" +
+	"\aparents\x18\t \x03(\v2\x15.customer.RoleOutBaseR\aparents\x12P\n" +
+	"\x15inherited_permissions\x18\n" +
+	" \x03(\v2\x1b.customer.PermissionOutBaseR\x14inheritedPermissions\x12>\n" +
+	"\x0finherited_menus\x18\v \x03(\v2\x15.customer.MenuOutBaseR\x0einheritedMenus\x12D\n" +
+	"\x11inherited_buttons\x18\f \x03(\v2\x17.customer.ButtonOutBaseR\x10inheritedButtons\x12\x1b\n" +
+	"\ttenant_id\x18\r \x01(\rR\btenantId\x12\x1d\n" +
+	"\n" +
+	"data_scope\x18\x0e \x01(\tR\tdataScope\x12+\n" +
+	"\x05depts\x18\x0f \x03(\v2\x15.customer.DeptOutBaseR\x05depts\x12F\n" +
+	"\x10deny_permissions\x18\x10 \x03(\v2\x1b.customer.PermissionOutBaseR\x0fdenyPermissions\x12:\n" +
+	"\n" +
+	"conditions\x18\x11 \x01(\v2\x1a.customer.PolicyConditionsR\n" +
+	"conditions\"\x91\x01\n" +
 	"\x0ePagRoleOutBase\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
 	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
 	"\x05pages\x18\x04 \x01(\x03R\x05pages\x12+\n" +
-	"\x05items\x18\x05 \x03(\v2\x15.customer.RoleOutBaseR\x05items\"\x9c\x01\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.customer.RoleOutBaseR\x05items\"\xd4\x01\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
 	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x19\n" +
-	"\bis_staff\x18\x04 \x01(\bR\aisStaff\x12\x17\n" +
-	"\arole_id\x18\x05 \x01(\rR\x06roleId\"\x90\x01\n" +
+	"\bis_staff\x18\x04 \x01(\bR\aisStaff\x12\x19\n" +
+	"\brole_ids\x18\x05 \x03(\rR\aroleIds\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\rR\btenantId\x12\x17\n" +
+	"\adept_id\x18\a \x01(\rR\x06deptId\"\xab\x01\n" +
 	"\x11UpdateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1b\n" +
 	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x19\n" +
-	"\bis_staff\x18\x04 \x01(\bR\aisStaff\x12\x17\n" +
-	"\arole_id\x18\x05 \x01(\rR\x06roleId\x12\x0e\n" +
-	"\x02pk\x18\x06 \x01(\rR\x02pk\"#\n" +
+	"\bis_staff\x18\x04 \x01(\bR\aisStaff\x12\x19\n" +
+	"\brole_ids\x18\x05 \x03(\rR\aroleIds\x12\x0e\n" +
+	"\x02pk\x18\x06 \x01(\rR\x02pk\x12\x17\n" +
+	"\adept_id\x18\a \x01(\rR\x06deptId\"R\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\" \n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02pk\x18\x01 \x01(\rR\x02pk\"\x9e\x03\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xeb\x03\n" +
 	"\x0fListUserRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x0e\n" +
@@ -3905,10 +9618,15 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\tis_active\x18\n" +
 	" \x01(\v2\x13.customer.BoolValueR\bisActive\x12.\n" +
 	"\bis_staff\x18\v \x01(\v2\x13.customer.BoolValueR\aisStaff\x12\x17\n" +
-	"\arole_id\x18\f \x01(\rR\x06roleId\"F\n" +
+	"\arole_id\x18\f \x01(\rR\x06roleId\x122\n" +
+	"\ttenant_id\x18\r \x01(\v2\x15.customer.UInt32ValueR\btenantId\x12\x17\n" +
+	"\adept_id\x18\x0e \x01(\rR\x06deptId\"\x7f\n" +
 	"\fLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd6\x01\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"captcha_id\x18\x03 \x01(\tR\tcaptchaId\x12\x18\n" +
+	"\acaptcha\x18\x04 \x01(\tR\acaptcha\"\xa0\x02\n" +
 	"\aUserOut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -3917,8 +9635,10 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1a\n" +
 	"\busername\x18\x04 \x01(\tR\busername\x12\x1b\n" +
 	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x19\n" +
-	"\bis_staff\x18\x06 \x01(\bR\aisStaff\x12)\n" +
-	"\x04role\x18\a \x01(\v2\x15.customer.RoleOutBaseR\x04role\"\x89\x01\n" +
+	"\bis_staff\x18\x06 \x01(\bR\aisStaff\x12+\n" +
+	"\x05roles\x18\a \x03(\v2\x15.customer.RoleOutBaseR\x05roles\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\rR\btenantId\x12)\n" +
+	"\x04dept\x18\t \x01(\v2\x15.customer.DeptOutBaseR\x04dept\"\x89\x01\n" +
 	"\n" +
 	"PagUserOut\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
@@ -3934,49 +9654,338 @@ const file_apps_customer_rpc_customer_proto_rawDesc = "" +
 	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12)\n" +
 	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\" \n" +
 	"\bLoginOut\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\x9e\x03\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\",\n" +
+	"\x16GenerateCaptchaRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\"m\n" +
+	"\n" +
+	"CaptchaOut\x12\x1d\n" +
+	"\n" +
+	"captcha_id\x18\x01 \x01(\tR\tcaptchaId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x16\n" +
+	"\x06expire\x18\x04 \x01(\x03R\x06expire\"\\\n" +
+	"\x13CreateTenantRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05descr\x18\x03 \x01(\tR\x05descr\"l\n" +
+	"\x13UpdateTenantRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05descr\x18\x04 \x01(\tR\x05descr\"T\n" +
+	"\x13DeleteTenantRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\"\n" +
+	"\x10GetTenantRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xe5\x02\n" +
+	"\x11ListTenantRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x0e\n" +
+	"\x02pk\x18\x03 \x01(\rR\x02pk\x12\x10\n" +
+	"\x03pks\x18\x04 \x01(\tR\x03pks\x12*\n" +
+	"\x11before_created_at\x18\x05 \x01(\tR\x0fbeforeCreatedAt\x12(\n" +
+	"\x10after_created_at\x18\x06 \x01(\tR\x0eafterCreatedAt\x12*\n" +
+	"\x11before_updated_at\x18\a \x01(\tR\x0fbeforeUpdatedAt\x12(\n" +
+	"\x10after_updated_at\x18\b \x01(\tR\x0eafterUpdatedAt\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x120\n" +
+	"\tis_active\x18\n" +
+	" \x01(\v2\x13.customer.BoolValueR\bisActive\x12\x14\n" +
+	"\x05descr\x18\v \x01(\tR\x05descr\"\xa0\x01\n" +
+	"\tTenantOut\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05descr\x18\x06 \x01(\tR\x05descr\"\x8d\x01\n" +
+	"\fPagTenantOut\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x03R\x05pages\x12)\n" +
+	"\x05items\x18\x05 \x03(\v2\x13.customer.TenantOutR\x05items\"\xb9\x01\n" +
+	"\x11CreateDeptRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rarrange_order\x18\x02 \x01(\rR\farrangeOrder\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05descr\x18\x04 \x01(\tR\x05descr\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\rR\bparentId\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\rR\btenantId\"\xac\x01\n" +
+	"\x11UpdateDeptRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rarrange_order\x18\x03 \x01(\rR\farrangeOrder\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05descr\x18\x05 \x01(\tR\x05descr\x12\x1b\n" +
+	"\tparent_id\x18\x06 \x01(\rR\bparentId\"R\n" +
+	"\x11DeleteDeptRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\" \n" +
+	"\x0eGetDeptRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\"\xcb\x03\n" +
+	"\x0fListDeptRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x0e\n" +
+	"\x02pk\x18\x03 \x01(\rR\x02pk\x12\x10\n" +
+	"\x03pks\x18\x04 \x01(\tR\x03pks\x12*\n" +
+	"\x11before_created_at\x18\x05 \x01(\tR\x0fbeforeCreatedAt\x12(\n" +
+	"\x10after_created_at\x18\x06 \x01(\tR\x0eafterCreatedAt\x12*\n" +
+	"\x11before_updated_at\x18\a \x01(\tR\x0fbeforeUpdatedAt\x12(\n" +
+	"\x10after_updated_at\x18\b \x01(\tR\x0eafterUpdatedAt\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x120\n" +
+	"\tis_active\x18\n" +
+	" \x01(\v2\x13.customer.BoolValueR\bisActive\x12\x14\n" +
+	"\x05descr\x18\v \x01(\tR\x05descr\x122\n" +
+	"\tparent_id\x18\f \x01(\v2\x15.customer.UInt32ValueR\bparentId\x122\n" +
+	"\ttenant_id\x18\r \x01(\v2\x15.customer.UInt32ValueR\btenantId\"\xe4\x01\n" +
+	"\vDeptOutBase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12#\n" +
+	"\rarrange_order\x18\x05 \x01(\rR\farrangeOrder\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05descr\x18\a \x01(\tR\x05descr\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\rR\btenantId\"\x8f\x02\n" +
+	"\aDeptOut\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12#\n" +
+	"\rarrange_order\x18\x05 \x01(\rR\farrangeOrder\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05descr\x18\a \x01(\tR\x05descr\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\rR\btenantId\x12-\n" +
+	"\x06parent\x18\t \x01(\v2\x15.customer.DeptOutBaseR\x06parent\"\x91\x01\n" +
+	"\x0ePagDeptOutBase\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x03R\x05pages\x12+\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.customer.DeptOutBaseR\x05items\"\x96\x01\n" +
+	"\x1aCreateUserRoleGrantRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\rR\x06roleId\x12\x19\n" +
+	"\bstart_at\x18\x03 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x04 \x01(\tR\x05endAt\x12\x14\n" +
+	"\x05descr\x18\x05 \x01(\tR\x05descr\"\xa8\x01\n" +
+	" CreateRolePermissionGrantRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\rR\x06roleId\x12#\n" +
+	"\rpermission_id\x18\x02 \x01(\rR\fpermissionId\x12\x19\n" +
+	"\bstart_at\x18\x03 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x04 \x01(\tR\x05endAt\x12\x14\n" +
+	"\x05descr\x18\x05 \x01(\tR\x05descr\"S\n" +
+	"\x12DeleteGrantRequest\x12\x0e\n" +
+	"\x02pk\x18\x01 \x01(\rR\x02pk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\x8c\x01\n" +
+	"\x18ListUserRoleGrantRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x04 \x01(\rR\x06roleId\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"\x9e\x01\n" +
+	"\x1eListRolePermissionGrantRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\rR\x06roleId\x12#\n" +
+	"\rpermission_id\x18\x04 \x01(\rR\fpermissionId\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"\xa1\x02\n" +
+	"\x10UserRoleGrantOut\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\rR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\rR\x06userId\x12)\n" +
+	"\x04role\x18\x06 \x01(\v2\x15.customer.RoleOutBaseR\x04role\x12\x19\n" +
+	"\bstart_at\x18\a \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\b \x01(\tR\x05endAt\x12\x14\n" +
+	"\x05descr\x18\t \x01(\tR\x05descr\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06active\"\xb9\x02\n" +
+	"\x16RolePermissionGrantOut\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\rR\btenantId\x12\x17\n" +
+	"\arole_id\x18\x05 \x01(\rR\x06roleId\x12;\n" +
+	"\n" +
+	"permission\x18\x06 \x01(\v2\x1b.customer.PermissionOutBaseR\n" +
+	"permission\x12\x19\n" +
+	"\bstart_at\x18\a \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\b \x01(\tR\x05endAt\x12\x14\n" +
+	"\x05descr\x18\t \x01(\tR\x05descr\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06active\"\x9b\x01\n" +
+	"\x13PagUserRoleGrantOut\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x03R\x05pages\x120\n" +
+	"\x05items\x18\x05 \x03(\v2\x1a.customer.UserRoleGrantOutR\x05items\"\xa7\x01\n" +
+	"\x19PagRolePermissionGrantOut\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x03R\x05pages\x126\n" +
+	"\x05items\x18\x05 \x03(\v2 .customer.RolePermissionGrantOutR\x05items\")\n" +
+	"\x11IntrospectRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xe4\x01\n" +
+	"\vTokenClaims\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12\x17\n" +
+	"\adept_id\x18\x03 \x01(\rR\x06deptId\x12\x19\n" +
+	"\bis_staff\x18\x04 \x01(\bR\aisStaff\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\x12\x19\n" +
+	"\btoken_id\x18\x06 \x01(\tR\atokenId\x12\x1b\n" +
+	"\tissued_at\x18\a \x01(\x03R\bissuedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAt\"n\n" +
+	"\rIntrospectOut\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12-\n" +
+	"\x06claims\x18\x03 \x01(\v2\x15.customer.TokenClaimsR\x06claims\"X\n" +
+	"\fCheckRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x10\n" +
+	"\x03obj\x18\x02 \x01(\tR\x03obj\x12\x10\n" +
+	"\x03act\x18\x03 \x01(\tR\x03act\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\"\x83\x01\n" +
+	"\bCheckOut\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12-\n" +
+	"\x06claims\x18\x03 \x01(\v2\x15.customer.TokenClaimsR\x06claims\x12\x18\n" +
+	"\aallowed\x18\x04 \x01(\bR\aallowed\"/\n" +
+	"\tCheckItem\x12\x10\n" +
+	"\x03obj\x18\x01 \x01(\tR\x03obj\x12\x10\n" +
+	"\x03act\x18\x02 \x01(\tR\x03act\"c\n" +
+	"\x10CheckManyRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12)\n" +
+	"\x05items\x18\x02 \x03(\v2\x13.customer.CheckItemR\x05items\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"\x87\x01\n" +
+	"\fCheckManyOut\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12-\n" +
+	"\x06claims\x18\x03 \x01(\v2\x15.customer.TokenClaimsR\x06claims\x12\x18\n" +
+	"\aallowed\x18\x04 \x03(\bR\aallowed\"+\n" +
+	"\x11ExportRbacRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"C\n" +
+	"\x0fRbacDocumentOut\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"t\n" +
+	"\x11ImportRbacRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05prune\x18\x04 \x01(\bR\x05prune\"z\n" +
+	"\n" +
+	"RbacChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"Y\n" +
+	"\rImportRbacOut\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12.\n" +
+	"\achanges\x18\x02 \x03(\v2\x14.customer.RbacChangeR\achanges2\xc1\x04\n" +
 	"\n" +
 	"Permission\x12R\n" +
 	"\x10CreatePermission\x12!.customer.CreatePermissionRequest\x1a\x1b.customer.PermissionOutBase\x12R\n" +
-	"\x10UpdatePermission\x12!.customer.UpdatePermissionRequest\x1a\x1b.customer.PermissionOutBase\x12G\n" +
-	"\x10DeletePermission\x12!.customer.DeletePermissionRequest\x1a\x10.customer.NilOut\x12L\n" +
+	"\x10UpdatePermission\x12!.customer.UpdatePermissionRequest\x1a\x1b.customer.PermissionOutBase\x12J\n" +
+	"\x10DeletePermission\x12!.customer.DeletePermissionRequest\x1a\x13.customer.DeleteOut\x12L\n" +
 	"\rGetPermission\x12\x1e.customer.GetPermissionRequest\x1a\x1b.customer.PermissionOutBase\x12Q\n" +
-	"\x0eListPermission\x12\x1f.customer.ListPermissionRequest\x1a\x1e.customer.PagPermissionOutBase2\xb8\x02\n" +
+	"\x0eListPermission\x12\x1f.customer.ListPermissionRequest\x1a\x1e.customer.PagPermissionOutBase\x12K\n" +
+	"\rExplainAccess\x12\x1e.customer.ExplainAccessRequest\x1a\x1a.customer.ExplainAccessOut\x12Q\n" +
+	"\x0fSyncPermissions\x12 .customer.SyncPermissionsRequest\x1a\x1c.customer.SyncPermissionsOut2\xca\x04\n" +
 	"\x04Menu\x12<\n" +
 	"\n" +
 	"CreateMenu\x12\x1b.customer.CreateMenuRequest\x1a\x11.customer.MenuOut\x12<\n" +
 	"\n" +
-	"UpdateMenu\x12\x1b.customer.UpdateMenuRequest\x1a\x11.customer.MenuOut\x12;\n" +
+	"UpdateMenu\x12\x1b.customer.UpdateMenuRequest\x1a\x11.customer.MenuOut\x12>\n" +
 	"\n" +
-	"DeleteMenu\x12\x1b.customer.DeleteMenuRequest\x1a\x10.customer.NilOut\x126\n" +
+	"DeleteMenu\x12\x1b.customer.DeleteMenuRequest\x1a\x13.customer.DeleteOut\x126\n" +
 	"\aGetMenu\x12\x18.customer.GetMenuRequest\x1a\x11.customer.MenuOut\x12?\n" +
-	"\bListMenu\x12\x19.customer.ListMenuRequest\x1a\x18.customer.PagMenuOutBase2\xd6\x02\n" +
+	"\bListMenu\x12\x19.customer.ListMenuRequest\x1a\x18.customer.PagMenuOutBase\x12B\n" +
+	"\vGetMenuTree\x12\x1c.customer.GetMenuTreeRequest\x1a\x15.customer.MenuTreeOut\x128\n" +
+	"\bMoveMenu\x12\x19.customer.MoveMenuRequest\x1a\x11.customer.MenuOut\x12?\n" +
+	"\fReorderMenus\x12\x1d.customer.ReorderMenusRequest\x1a\x10.customer.NilOut\x12N\n" +
+	"\x10ExportMenuRoutes\x12!.customer.ExportMenuRoutesRequest\x1a\x17.customer.MenuRoutesOut2\xbd\x03\n" +
 	"\x06Button\x12B\n" +
 	"\fCreateButton\x12\x1d.customer.CreateButtonRequest\x1a\x13.customer.ButtonOut\x12B\n" +
-	"\fUpdateButton\x12\x1d.customer.UpdateButtonRequest\x1a\x13.customer.ButtonOut\x12?\n" +
-	"\fDeleteButton\x12\x1d.customer.DeleteButtonRequest\x1a\x10.customer.NilOut\x12<\n" +
+	"\fUpdateButton\x12\x1d.customer.UpdateButtonRequest\x1a\x13.customer.ButtonOut\x12B\n" +
+	"\fDeleteButton\x12\x1d.customer.DeleteButtonRequest\x1a\x13.customer.DeleteOut\x12<\n" +
 	"\tGetButton\x12\x1a.customer.GetButtonRequest\x1a\x13.customer.ButtonOut\x12E\n" +
 	"\n" +
-	"ListButton\x12\x1b.customer.ListButtonRequest\x1a\x1a.customer.PagButtonOutBase2\xb8\x02\n" +
+	"ListButton\x12\x1b.customer.ListButtonRequest\x1a\x1a.customer.PagButtonOutBase\x12b\n" +
+	"\x16ListAllowedButtonCodes\x12'.customer.ListAllowedButtonCodesRequest\x1a\x1f.customer.AllowedButtonCodesOut2\xfb\x03\n" +
 	"\x04Role\x12<\n" +
 	"\n" +
 	"CreateRole\x12\x1b.customer.CreateRoleRequest\x1a\x11.customer.RoleOut\x12<\n" +
 	"\n" +
-	"UpdateRole\x12\x1b.customer.UpdateRoleRequest\x1a\x11.customer.RoleOut\x12;\n" +
+	"UpdateRole\x12\x1b.customer.UpdateRoleRequest\x1a\x11.customer.RoleOut\x12>\n" +
 	"\n" +
-	"DeleteRole\x12\x1b.customer.DeleteRoleRequest\x1a\x10.customer.NilOut\x126\n" +
+	"DeleteRole\x12\x1b.customer.DeleteRoleRequest\x1a\x13.customer.DeleteOut\x126\n" +
 	"\aGetRole\x12\x18.customer.GetRoleRequest\x1a\x11.customer.RoleOut\x12?\n" +
-	"\bListRole\x12\x19.customer.ListRoleRequest\x1a\x18.customer.PagRoleOutBase2\x81\x04\n" +
+	"\bListRole\x12\x19.customer.ListRoleRequest\x1a\x18.customer.PagRoleOutBase\x12B\n" +
+	"\rGetRoleImpact\x12\x18.customer.GetRoleRequest\x1a\x17.customer.RoleImpactOut\x12:\n" +
+	"\tCloneRole\x12\x1a.customer.CloneRoleRequest\x1a\x11.customer.RoleOut\x12>\n" +
+	"\tDiffRoles\x12\x1a.customer.DiffRolesRequest\x1a\x15.customer.RoleDiffOut2\x84\x04\n" +
 	"\x04User\x12<\n" +
 	"\n" +
 	"CreateUser\x12\x1b.customer.CreateUserRequest\x1a\x11.customer.UserOut\x12@\n" +
-	"\x0eUpdateCustomer\x12\x1b.customer.UpdateUserRequest\x1a\x11.customer.UserOut\x12?\n" +
-	"\x0eDeleteCustomer\x12\x1b.customer.DeleteUserRequest\x1a\x10.customer.NilOut\x12:\n" +
+	"\x0eUpdateCustomer\x12\x1b.customer.UpdateUserRequest\x1a\x11.customer.UserOut\x12B\n" +
+	"\x0eDeleteCustomer\x12\x1b.customer.DeleteUserRequest\x1a\x13.customer.DeleteOut\x12:\n" +
 	"\vGetCustomer\x12\x18.customer.GetUserRequest\x1a\x11.customer.UserOut\x12?\n" +
 	"\fListCustomer\x12\x19.customer.ListUserRequest\x1a\x14.customer.PagUserOut\x12A\n" +
 	"\rResetPassword\x12\x1e.customer.ResetPasswordRequest\x1a\x10.customer.NilOut\x12C\n" +
 	"\x0eChangePassword\x12\x1f.customer.ChangePasswordRequest\x1a\x10.customer.NilOut\x123\n" +
-	"\x05Login\x12\x16.customer.LoginRequest\x1a\x12.customer.LoginOutB\n" +
+	"\x05Login\x12\x16.customer.LoginRequest\x1a\x12.customer.LoginOut2T\n" +
+	"\aCaptcha\x12I\n" +
+	"\x0fGenerateCaptcha\x12 .customer.GenerateCaptchaRequest\x1a\x14.customer.CaptchaOut2\xd5\x02\n" +
+	"\x06Tenant\x12B\n" +
+	"\fCreateTenant\x12\x1d.customer.CreateTenantRequest\x1a\x13.customer.TenantOut\x12B\n" +
+	"\fUpdateTenant\x12\x1d.customer.UpdateTenantRequest\x1a\x13.customer.TenantOut\x12B\n" +
+	"\fDeleteTenant\x12\x1d.customer.DeleteTenantRequest\x1a\x13.customer.DeleteOut\x12<\n" +
+	"\tGetTenant\x12\x1a.customer.GetTenantRequest\x1a\x13.customer.TenantOut\x12A\n" +
+	"\n" +
+	"ListTenant\x12\x1b.customer.ListTenantRequest\x1a\x16.customer.PagTenantOut2\xbb\x02\n" +
+	"\x04Dept\x12<\n" +
+	"\n" +
+	"CreateDept\x12\x1b.customer.CreateDeptRequest\x1a\x11.customer.DeptOut\x12<\n" +
+	"\n" +
+	"UpdateDept\x12\x1b.customer.UpdateDeptRequest\x1a\x11.customer.DeptOut\x12>\n" +
+	"\n" +
+	"DeleteDept\x12\x1b.customer.DeleteDeptRequest\x1a\x13.customer.DeleteOut\x126\n" +
+	"\aGetDept\x12\x18.customer.GetDeptRequest\x1a\x11.customer.DeptOut\x12?\n" +
+	"\bListDept\x12\x19.customer.ListDeptRequest\x1a\x18.customer.PagDeptOutBase2\xa7\x04\n" +
+	"\x05Grant\x12W\n" +
+	"\x13CreateUserRoleGrant\x12$.customer.CreateUserRoleGrantRequest\x1a\x1a.customer.UserRoleGrantOut\x12H\n" +
+	"\x13DeleteUserRoleGrant\x12\x1c.customer.DeleteGrantRequest\x1a\x13.customer.DeleteOut\x12V\n" +
+	"\x11ListUserRoleGrant\x12\".customer.ListUserRoleGrantRequest\x1a\x1d.customer.PagUserRoleGrantOut\x12i\n" +
+	"\x19CreateRolePermissionGrant\x12*.customer.CreateRolePermissionGrantRequest\x1a .customer.RolePermissionGrantOut\x12N\n" +
+	"\x19DeleteRolePermissionGrant\x12\x1c.customer.DeleteGrantRequest\x1a\x13.customer.DeleteOut\x12h\n" +
+	"\x17ListRolePermissionGrant\x12(.customer.ListRolePermissionGrantRequest\x1a#.customer.PagRolePermissionGrantOut2\xc0\x01\n" +
+	"\x04Auth\x12B\n" +
+	"\n" +
+	"Introspect\x12\x1b.customer.IntrospectRequest\x1a\x17.customer.IntrospectOut\x123\n" +
+	"\x05Check\x12\x16.customer.CheckRequest\x1a\x12.customer.CheckOut\x12?\n" +
+	"\tCheckMany\x12\x1a.customer.CheckManyRequest\x1a\x16.customer.CheckManyOut2\x90\x01\n" +
+	"\x04Rbac\x12D\n" +
+	"\n" +
+	"ExportRbac\x12\x1b.customer.ExportRbacRequest\x1a\x19.customer.RbacDocumentOut\x12B\n" +
+	"\n" +
+	"ImportRbac\x12\x1b.customer.ImportRbacRequest\x1a\x17.customer.ImportRbacOutB\n" +
 	"Z\b./rpc/pbb\x06proto3"
 
 var (
@@ -3991,139 +10000,341 @@ func file_apps_customer_rpc_customer_proto_rawDescGZIP() []byte {
 	return file_apps_customer_rpc_customer_proto_rawDescData
 }
 
-var file_apps_customer_rpc_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_apps_customer_rpc_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
 var file_apps_customer_rpc_customer_proto_goTypes = []any{
-	(*UInt32Value)(nil),             // 0: customer.UInt32Value
-	(*BoolValue)(nil),               // 1: customer.BoolValue
-	(*NilOut)(nil),                  // 2: customer.NilOut
-	(*CreatePermissionRequest)(nil), // 3: customer.CreatePermissionRequest
-	(*UpdatePermissionRequest)(nil), // 4: customer.UpdatePermissionRequest
-	(*GetPermissionRequest)(nil),    // 5: customer.GetPermissionRequest
-	(*DeletePermissionRequest)(nil), // 6: customer.DeletePermissionRequest
-	(*ListPermissionRequest)(nil),   // 7: customer.ListPermissionRequest
-	(*PermissionOutBase)(nil),       // 8: customer.PermissionOutBase
-	(*PagPermissionOutBase)(nil),    // 9: customer.PagPermissionOutBase
-	(*CreateMenuRequest)(nil),       // 10: customer.CreateMenuRequest
-	(*UpdateMenuRequest)(nil),       // 11: customer.UpdateMenuRequest
-	(*DeleteMenuRequest)(nil),       // 12: customer.DeleteMenuRequest
-	(*GetMenuRequest)(nil),          // 13: customer.GetMenuRequest
-	(*ListMenuRequest)(nil),         // 14: customer.ListMenuRequest
-	(*MetaSchemas)(nil),             // 15: customer.MetaSchemas
-	(*MenuOutBase)(nil),             // 16: customer.MenuOutBase
-	(*MenuOut)(nil),                 // 17: customer.MenuOut
-	(*PagMenuOutBase)(nil),          // 18: customer.PagMenuOutBase
-	(*CreateButtonRequest)(nil),     // 19: customer.CreateButtonRequest
-	(*UpdateButtonRequest)(nil),     // 20: customer.UpdateButtonRequest
-	(*DeleteButtonRequest)(nil),     // 21: customer.DeleteButtonRequest
-	(*GetButtonRequest)(nil),        // 22: customer.GetButtonRequest
-	(*ListButtonRequest)(nil),       // 23: customer.ListButtonRequest
-	(*ButtonOutBase)(nil),           // 24: customer.ButtonOutBase
-	(*ButtonOut)(nil),               // 25: customer.ButtonOut
-	(*PagButtonOutBase)(nil),        // 26: customer.PagButtonOutBase
-	(*CreateRoleRequest)(nil),       // 27: customer.CreateRoleRequest
-	(*UpdateRoleRequest)(nil),       // 28: customer.UpdateRoleRequest
-	(*DeleteRoleRequest)(nil),       // 29: customer.DeleteRoleRequest
-	(*GetRoleRequest)(nil),          // 30: customer.GetRoleRequest
-	(*ListRoleRequest)(nil),         // 31: customer.ListRoleRequest
-	(*RoleOutBase)(nil),             // 32: customer.RoleOutBase
-	(*RoleOut)(nil),                 // 33: customer.RoleOut
-	(*PagRoleOutBase)(nil),          // 34: customer.PagRoleOutBase
-	(*CreateUserRequest)(nil),       // 35: customer.CreateUserRequest
-	(*UpdateUserRequest)(nil),       // 36: customer.UpdateUserRequest
-	(*DeleteUserRequest)(nil),       // 37: customer.DeleteUserRequest
-	(*GetUserRequest)(nil),          // 38: customer.GetUserRequest
-	(*ListUserRequest)(nil),         // 39: customer.ListUserRequest
-	(*LoginRequest)(nil),            // 40: customer.LoginRequest
-	(*UserOut)(nil),                 // 41: customer.UserOut
-	(*PagUserOut)(nil),              // 42: customer.PagUserOut
-	(*ResetPasswordRequest)(nil),    // 43: customer.ResetPasswordRequest
-	(*ChangePasswordRequest)(nil),   // 44: customer.ChangePasswordRequest
-	(*LoginOut)(nil),                // 45: customer.LoginOut
+	(*UInt32Value)(nil),                      // 0: customer.UInt32Value
+	(*BoolValue)(nil),                        // 1: customer.BoolValue
+	(*NilOut)(nil),                           // 2: customer.NilOut
+	(*DeleteDependent)(nil),                  // 3: customer.DeleteDependent
+	(*DeleteOut)(nil),                        // 4: customer.DeleteOut
+	(*PolicyConditions)(nil),                 // 5: customer.PolicyConditions
+	(*CreatePermissionRequest)(nil),          // 6: customer.CreatePermissionRequest
+	(*UpdatePermissionRequest)(nil),          // 7: customer.UpdatePermissionRequest
+	(*GetPermissionRequest)(nil),             // 8: customer.GetPermissionRequest
+	(*DeletePermissionRequest)(nil),          // 9: customer.DeletePermissionRequest
+	(*ListPermissionRequest)(nil),            // 10: customer.ListPermissionRequest
+	(*PermissionOutBase)(nil),                // 11: customer.PermissionOutBase
+	(*PagPermissionOutBase)(nil),             // 12: customer.PagPermissionOutBase
+	(*ExplainAccessRequest)(nil),             // 13: customer.ExplainAccessRequest
+	(*ExplainSubject)(nil),                   // 14: customer.ExplainSubject
+	(*ExplainRule)(nil),                      // 15: customer.ExplainRule
+	(*ExplainAccessOut)(nil),                 // 16: customer.ExplainAccessOut
+	(*SyncPermissionsRequest)(nil),           // 17: customer.SyncPermissionsRequest
+	(*SyncPermissionsOut)(nil),               // 18: customer.SyncPermissionsOut
+	(*CreateMenuRequest)(nil),                // 19: customer.CreateMenuRequest
+	(*UpdateMenuRequest)(nil),                // 20: customer.UpdateMenuRequest
+	(*DeleteMenuRequest)(nil),                // 21: customer.DeleteMenuRequest
+	(*GetMenuRequest)(nil),                   // 22: customer.GetMenuRequest
+	(*ListMenuRequest)(nil),                  // 23: customer.ListMenuRequest
+	(*ExportMenuRoutesRequest)(nil),          // 24: customer.ExportMenuRoutesRequest
+	(*MenuRoutesOut)(nil),                    // 25: customer.MenuRoutesOut
+	(*MoveMenuRequest)(nil),                  // 26: customer.MoveMenuRequest
+	(*MenuOrder)(nil),                        // 27: customer.MenuOrder
+	(*ReorderMenusRequest)(nil),              // 28: customer.ReorderMenusRequest
+	(*GetMenuTreeRequest)(nil),               // 29: customer.GetMenuTreeRequest
+	(*MetaSchemas)(nil),                      // 30: customer.MetaSchemas
+	(*MenuOutBase)(nil),                      // 31: customer.MenuOutBase
+	(*MenuTreeButton)(nil),                   // 32: customer.MenuTreeButton
+	(*MenuTreeNode)(nil),                     // 33: customer.MenuTreeNode
+	(*MenuTreeOut)(nil),                      // 34: customer.MenuTreeOut
+	(*MenuOut)(nil),                          // 35: customer.MenuOut
+	(*PagMenuOutBase)(nil),                   // 36: customer.PagMenuOutBase
+	(*CreateButtonRequest)(nil),              // 37: customer.CreateButtonRequest
+	(*UpdateButtonRequest)(nil),              // 38: customer.UpdateButtonRequest
+	(*DeleteButtonRequest)(nil),              // 39: customer.DeleteButtonRequest
+	(*GetButtonRequest)(nil),                 // 40: customer.GetButtonRequest
+	(*ListButtonRequest)(nil),                // 41: customer.ListButtonRequest
+	(*ButtonOutBase)(nil),                    // 42: customer.ButtonOutBase
+	(*ButtonOut)(nil),                        // 43: customer.ButtonOut
+	(*ListAllowedButtonCodesRequest)(nil),    // 44: customer.ListAllowedButtonCodesRequest
+	(*MenuButtonCodes)(nil),                  // 45: customer.MenuButtonCodes
+	(*AllowedButtonCodesOut)(nil),            // 46: customer.AllowedButtonCodesOut
+	(*PagButtonOutBase)(nil),                 // 47: customer.PagButtonOutBase
+	(*CreateRoleRequest)(nil),                // 48: customer.CreateRoleRequest
+	(*UpdateRoleRequest)(nil),                // 49: customer.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),                // 50: customer.DeleteRoleRequest
+	(*GetRoleRequest)(nil),                   // 51: customer.GetRoleRequest
+	(*CloneRoleRequest)(nil),                 // 52: customer.CloneRoleRequest
+	(*DiffRolesRequest)(nil),                 // 53: customer.DiffRolesRequest
+	(*RoleDiffEntry)(nil),                    // 54: customer.RoleDiffEntry
+	(*RoleDiffCategory)(nil),                 // 55: customer.RoleDiffCategory
+	(*RoleFieldChange)(nil),                  // 56: customer.RoleFieldChange
+	(*RoleDiffOut)(nil),                      // 57: customer.RoleDiffOut
+	(*ListRoleRequest)(nil),                  // 58: customer.ListRoleRequest
+	(*RoleOutBase)(nil),                      // 59: customer.RoleOutBase
+	(*RoleImpactUser)(nil),                   // 60: customer.RoleImpactUser
+	(*RoleImpactGrant)(nil),                  // 61: customer.RoleImpactGrant
+	(*RoleImpactOut)(nil),                    // 62: customer.RoleImpactOut
+	(*RoleOut)(nil),                          // 63: customer.RoleOut
+	(*PagRoleOutBase)(nil),                   // 64: customer.PagRoleOutBase
+	(*CreateUserRequest)(nil),                // 65: customer.CreateUserRequest
+	(*UpdateUserRequest)(nil),                // 66: customer.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                // 67: customer.DeleteUserRequest
+	(*GetUserRequest)(nil),                   // 68: customer.GetUserRequest
+	(*ListUserRequest)(nil),                  // 69: customer.ListUserRequest
+	(*LoginRequest)(nil),                     // 70: customer.LoginRequest
+	(*UserOut)(nil),                          // 71: customer.UserOut
+	(*PagUserOut)(nil),                       // 72: customer.PagUserOut
+	(*ResetPasswordRequest)(nil),             // 73: customer.ResetPasswordRequest
+	(*ChangePasswordRequest)(nil),            // 74: customer.ChangePasswordRequest
+	(*LoginOut)(nil),                         // 75: customer.LoginOut
+	(*GenerateCaptchaRequest)(nil),           // 76: customer.GenerateCaptchaRequest
+	(*CaptchaOut)(nil),                       // 77: customer.CaptchaOut
+	(*CreateTenantRequest)(nil),              // 78: customer.CreateTenantRequest
+	(*UpdateTenantRequest)(nil),              // 79: customer.UpdateTenantRequest
+	(*DeleteTenantRequest)(nil),              // 80: customer.DeleteTenantRequest
+	(*GetTenantRequest)(nil),                 // 81: customer.GetTenantRequest
+	(*ListTenantRequest)(nil),                // 82: customer.ListTenantRequest
+	(*TenantOut)(nil),                        // 83: customer.TenantOut
+	(*PagTenantOut)(nil),                     // 84: customer.PagTenantOut
+	(*CreateDeptRequest)(nil),                // 85: customer.CreateDeptRequest
+	(*UpdateDeptRequest)(nil),                // 86: customer.UpdateDeptRequest
+	(*DeleteDeptRequest)(nil),                // 87: customer.DeleteDeptRequest
+	(*GetDeptRequest)(nil),                   // 88: customer.GetDeptRequest
+	(*ListDeptRequest)(nil),                  // 89: customer.ListDeptRequest
+	(*DeptOutBase)(nil),                      // 90: customer.DeptOutBase
+	(*DeptOut)(nil),                          // 91: customer.DeptOut
+	(*PagDeptOutBase)(nil),                   // 92: customer.PagDeptOutBase
+	(*CreateUserRoleGrantRequest)(nil),       // 93: customer.CreateUserRoleGrantRequest
+	(*CreateRolePermissionGrantRequest)(nil), // 94: customer.CreateRolePermissionGrantRequest
+	(*DeleteGrantRequest)(nil),               // 95: customer.DeleteGrantRequest
+	(*ListUserRoleGrantRequest)(nil),         // 96: customer.ListUserRoleGrantRequest
+	(*ListRolePermissionGrantRequest)(nil),   // 97: customer.ListRolePermissionGrantRequest
+	(*UserRoleGrantOut)(nil),                 // 98: customer.UserRoleGrantOut
+	(*RolePermissionGrantOut)(nil),           // 99: customer.RolePermissionGrantOut
+	(*PagUserRoleGrantOut)(nil),              // 100: customer.PagUserRoleGrantOut
+	(*PagRolePermissionGrantOut)(nil),        // 101: customer.PagRolePermissionGrantOut
+	(*IntrospectRequest)(nil),                // 102: customer.IntrospectRequest
+	(*TokenClaims)(nil),                      // 103: customer.TokenClaims
+	(*IntrospectOut)(nil),                    // 104: customer.IntrospectOut
+	(*CheckRequest)(nil),                     // 105: customer.CheckRequest
+	(*CheckOut)(nil),                         // 106: customer.CheckOut
+	(*CheckItem)(nil),                        // 107: customer.CheckItem
+	(*CheckManyRequest)(nil),                 // 108: customer.CheckManyRequest
+	(*CheckManyOut)(nil),                     // 109: customer.CheckManyOut
+	(*ExportRbacRequest)(nil),                // 110: customer.ExportRbacRequest
+	(*RbacDocumentOut)(nil),                  // 111: customer.RbacDocumentOut
+	(*ImportRbacRequest)(nil),                // 112: customer.ImportRbacRequest
+	(*RbacChange)(nil),                       // 113: customer.RbacChange
+	(*ImportRbacOut)(nil),                    // 114: customer.ImportRbacOut
+	nil,                                      // 115: customer.MetaSchemas.TitlesEntry
 }
 var file_apps_customer_rpc_customer_proto_depIdxs = []int32{
-	8,  // 0: customer.PagPermissionOutBase.items:type_name -> customer.PermissionOutBase
-	15, // 1: customer.CreateMenuRequest.meta:type_name -> customer.MetaSchemas
-	15, // 2: customer.UpdateMenuRequest.meta:type_name -> customer.MetaSchemas
-	1,  // 3: customer.ListMenuRequest.is_active:type_name -> customer.BoolValue
-	0,  // 4: customer.ListMenuRequest.parent_id:type_name -> customer.UInt32Value
-	15, // 5: customer.MenuOutBase.meta:type_name -> customer.MetaSchemas
-	15, // 6: customer.MenuOut.meta:type_name -> customer.MetaSchemas
-	16, // 7: customer.MenuOut.parent:type_name -> customer.MenuOutBase
-	8,  // 8: customer.MenuOut.permissions:type_name -> customer.PermissionOutBase
-	16, // 9: customer.PagMenuOutBase.items:type_name -> customer.MenuOutBase
-	1,  // 10: customer.ListButtonRequest.is_active:type_name -> customer.BoolValue
-	16, // 11: customer.ButtonOut.menu:type_name -> customer.MenuOutBase
-	8,  // 12: customer.ButtonOut.permissions:type_name -> customer.PermissionOutBase
-	24, // 13: customer.PagButtonOutBase.items:type_name -> customer.ButtonOutBase
-	8,  // 14: customer.RoleOut.permissions:type_name -> customer.PermissionOutBase
-	16, // 15: customer.RoleOut.menus:type_name -> customer.MenuOutBase
-	24, // 16: customer.RoleOut.buttons:type_name -> customer.ButtonOutBase
-	32, // 17: customer.PagRoleOutBase.items:type_name -> customer.RoleOutBase
-	1,  // 18: customer.ListUserRequest.is_active:type_name -> customer.BoolValue
-	1,  // 19: customer.ListUserRequest.is_staff:type_name -> customer.BoolValue
-	32, // 20: customer.UserOut.role:type_name -> customer.RoleOutBase
-	41, // 21: customer.PagUserOut.items:type_name -> customer.UserOut
-	3,  // 22: customer.Permission.CreatePermission:input_type -> customer.CreatePermissionRequest
-	4,  // 23: customer.Permission.UpdatePermission:input_type -> customer.UpdatePermissionRequest
-	6,  // 24: customer.Permission.DeletePermission:input_type -> customer.DeletePermissionRequest
-	5,  // 25: customer.Permission.GetPermission:input_type -> customer.GetPermissionRequest
-	7,  // 26: customer.Permission.ListPermission:input_type -> customer.ListPermissionRequest
-	10, // 27: customer.Menu.CreateMenu:input_type -> customer.CreateMenuRequest
-	11, // 28: customer.Menu.UpdateMenu:input_type -> customer.UpdateMenuRequest
-	12, // 29: customer.Menu.DeleteMenu:input_type -> customer.DeleteMenuRequest
-	13, // 30: customer.Menu.GetMenu:input_type -> customer.GetMenuRequest
-	14, // 31: customer.Menu.ListMenu:input_type -> customer.ListMenuRequest
-	19, // 32: customer.Button.CreateButton:input_type -> customer.CreateButtonRequest
-	20, // 33: customer.Button.UpdateButton:input_type -> customer.UpdateButtonRequest
-	21, // 34: customer.Button.DeleteButton:input_type -> customer.DeleteButtonRequest
-	22, // 35: customer.Button.GetButton:input_type -> customer.GetButtonRequest
-	23, // 36: customer.Button.ListButton:input_type -> customer.ListButtonRequest
-	27, // 37: customer.Role.CreateRole:input_type -> customer.CreateRoleRequest
-	28, // 38: customer.Role.UpdateRole:input_type -> customer.UpdateRoleRequest
-	29, // 39: customer.Role.DeleteRole:input_type -> customer.DeleteRoleRequest
-	30, // 40: customer.Role.GetRole:input_type -> customer.GetRoleRequest
-	31, // 41: customer.Role.ListRole:input_type -> customer.ListRoleRequest
-	35, // 42: customer.User.CreateUser:input_type -> customer.CreateUserRequest
-	36, // 43: customer.User.UpdateCustomer:input_type -> customer.UpdateUserRequest
-	37, // 44: customer.User.DeleteCustomer:input_type -> customer.DeleteUserRequest
-	38, // 45: customer.User.GetCustomer:input_type -> customer.GetUserRequest
-	39, // 46: customer.User.ListCustomer:input_type -> customer.ListUserRequest
-	43, // 47: customer.User.ResetPassword:input_type -> customer.ResetPasswordRequest
-	44, // 48: customer.User.ChangePassword:input_type -> customer.ChangePasswordRequest
-	40, // 49: customer.User.Login:input_type -> customer.LoginRequest
-	8,  // 50: customer.Permission.CreatePermission:output_type -> customer.PermissionOutBase
-	8,  // 51: customer.Permission.UpdatePermission:output_type -> customer.PermissionOutBase
-	2,  // 52: customer.Permission.DeletePermission:output_type -> customer.NilOut
-	8,  // 53: customer.Permission.GetPermission:output_type -> customer.PermissionOutBase
-	9,  // 54: customer.Permission.ListPermission:output_type -> customer.PagPermissionOutBase
-	17, // 55: customer.Menu.CreateMenu:output_type -> customer.MenuOut
-	17, // 56: customer.Menu.UpdateMenu:output_type -> customer.MenuOut
-	2,  // 57: customer.Menu.DeleteMenu:output_type -> customer.NilOut
-	17, // 58: customer.Menu.GetMenu:output_type -> customer.MenuOut
-	18, // 59: customer.Menu.ListMenu:output_type -> customer.PagMenuOutBase
-	25, // 60: customer.Button.CreateButton:output_type -> customer.ButtonOut
-	25, // 61: customer.Button.UpdateButton:output_type -> customer.ButtonOut
-	2,  // 62: customer.Button.DeleteButton:output_type -> customer.NilOut
-	25, // 63: customer.Button.GetButton:output_type -> customer.ButtonOut
-	26, // 64: customer.Button.ListButton:output_type -> customer.PagButtonOutBase
-	33, // 65: customer.Role.CreateRole:output_type -> customer.RoleOut
-	33, // 66: customer.Role.UpdateRole:output_type -> customer.RoleOut
-	2,  // 67: customer.Role.DeleteRole:output_type -> customer.NilOut
-	33, // 68: customer.Role.GetRole:output_type -> customer.RoleOut
-	34, // 69: customer.Role.ListRole:output_type -> customer.PagRoleOutBase
-	41, // 70: customer.User.CreateUser:output_type -> customer.UserOut
-	41, // 71: customer.User.UpdateCustomer:output_type -> customer.UserOut
-	2,  // 72: customer.User.DeleteCustomer:output_type -> customer.NilOut
-	41, // 73: customer.User.GetCustomer:output_type -> customer.UserOut
-	42, // 74: customer.User.ListCustomer:output_type -> customer.PagUserOut
-	2,  // 75: customer.User.ResetPassword:output_type -> customer.NilOut
-	2,  // 76: customer.User.ChangePassword:output_type -> customer.NilOut
-	45, // 77: customer.User.Login:output_type -> customer.LoginOut
-	50, // [50:78] is the sub-list for method output_type
-	22, // [22:50] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	3,   // 0: customer.DeleteOut.dependents:type_name -> customer.DeleteDependent
+	5,   // 1: customer.CreatePermissionRequest.conditions:type_name -> customer.PolicyConditions
+	5,   // 2: customer.UpdatePermissionRequest.conditions:type_name -> customer.PolicyConditions
+	0,   // 3: customer.ListPermissionRequest.tenant_id:type_name -> customer.UInt32Value
+	5,   // 4: customer.PermissionOutBase.conditions:type_name -> customer.PolicyConditions
+	11,  // 5: customer.PagPermissionOutBase.items:type_name -> customer.PermissionOutBase
+	1,   // 6: customer.ExplainAccessRequest.is_staff:type_name -> customer.BoolValue
+	14,  // 7: customer.ExplainRule.subject:type_name -> customer.ExplainSubject
+	14,  // 8: customer.ExplainRule.chain:type_name -> customer.ExplainSubject
+	15,  // 9: customer.ExplainAccessOut.matched:type_name -> customer.ExplainRule
+	15,  // 10: customer.ExplainAccessOut.near_misses:type_name -> customer.ExplainRule
+	11,  // 11: customer.SyncPermissionsOut.created:type_name -> customer.PermissionOutBase
+	11,  // 12: customer.SyncPermissionsOut.updated:type_name -> customer.PermissionOutBase
+	11,  // 13: customer.SyncPermissionsOut.stale:type_name -> customer.PermissionOutBase
+	30,  // 14: customer.CreateMenuRequest.meta:type_name -> customer.MetaSchemas
+	30,  // 15: customer.UpdateMenuRequest.meta:type_name -> customer.MetaSchemas
+	1,   // 16: customer.ListMenuRequest.is_active:type_name -> customer.BoolValue
+	0,   // 17: customer.ListMenuRequest.parent_id:type_name -> customer.UInt32Value
+	0,   // 18: customer.ListMenuRequest.tenant_id:type_name -> customer.UInt32Value
+	27,  // 19: customer.ReorderMenusRequest.items:type_name -> customer.MenuOrder
+	115, // 20: customer.MetaSchemas.titles:type_name -> customer.MetaSchemas.TitlesEntry
+	30,  // 21: customer.MenuOutBase.meta:type_name -> customer.MetaSchemas
+	42,  // 22: customer.MenuTreeButton.button:type_name -> customer.ButtonOutBase
+	11,  // 23: customer.MenuTreeButton.permissions:type_name -> customer.PermissionOutBase
+	31,  // 24: customer.MenuTreeNode.menu:type_name -> customer.MenuOutBase
+	11,  // 25: customer.MenuTreeNode.permissions:type_name -> customer.PermissionOutBase
+	32,  // 26: customer.MenuTreeNode.buttons:type_name -> customer.MenuTreeButton
+	33,  // 27: customer.MenuTreeNode.children:type_name -> customer.MenuTreeNode
+	33,  // 28: customer.MenuTreeOut.items:type_name -> customer.MenuTreeNode
+	30,  // 29: customer.MenuOut.meta:type_name -> customer.MetaSchemas
+	31,  // 30: customer.MenuOut.parent:type_name -> customer.MenuOutBase
+	11,  // 31: customer.MenuOut.permissions:type_name -> customer.PermissionOutBase
+	31,  // 32: customer.PagMenuOutBase.items:type_name -> customer.MenuOutBase
+	1,   // 33: customer.ListButtonRequest.is_active:type_name -> customer.BoolValue
+	0,   // 34: customer.ListButtonRequest.tenant_id:type_name -> customer.UInt32Value
+	31,  // 35: customer.ButtonOut.menu:type_name -> customer.MenuOutBase
+	11,  // 36: customer.ButtonOut.permissions:type_name -> customer.PermissionOutBase
+	45,  // 37: customer.AllowedButtonCodesOut.items:type_name -> customer.MenuButtonCodes
+	42,  // 38: customer.PagButtonOutBase.items:type_name -> customer.ButtonOutBase
+	5,   // 39: customer.CreateRoleRequest.conditions:type_name -> customer.PolicyConditions
+	5,   // 40: customer.UpdateRoleRequest.conditions:type_name -> customer.PolicyConditions
+	49,  // 41: customer.DiffRolesRequest.proposed:type_name -> customer.UpdateRoleRequest
+	54,  // 42: customer.RoleDiffCategory.added:type_name -> customer.RoleDiffEntry
+	54,  // 43: customer.RoleDiffCategory.removed:type_name -> customer.RoleDiffEntry
+	59,  // 44: customer.RoleDiffOut.base:type_name -> customer.RoleOutBase
+	56,  // 45: customer.RoleDiffOut.fields:type_name -> customer.RoleFieldChange
+	55,  // 46: customer.RoleDiffOut.categories:type_name -> customer.RoleDiffCategory
+	0,   // 47: customer.ListRoleRequest.tenant_id:type_name -> customer.UInt32Value
+	5,   // 48: customer.RoleOutBase.conditions:type_name -> customer.PolicyConditions
+	59,  // 49: customer.RoleImpactOut.role:type_name -> customer.RoleOutBase
+	60,  // 50: customer.RoleImpactOut.users:type_name -> customer.RoleImpactUser
+	61,  // 51: customer.RoleImpactOut.grants:type_name -> customer.RoleImpactGrant
+	59,  // 52: customer.RoleImpactOut.children:type_name -> customer.RoleOutBase
+	31,  // 53: customer.RoleImpactOut.menus:type_name -> customer.MenuOutBase
+	42,  // 54: customer.RoleImpactOut.buttons:type_name -> customer.ButtonOutBase
+	11,  // 55: customer.RoleImpactOut.permissions:type_name -> customer.PermissionOutBase
+	11,  // 56: customer.RoleImpactOut.deny_permissions:type_name -> customer.PermissionOutBase
+	11,  // 57: customer.RoleOut.permissions:type_name -> customer.PermissionOutBase
+	31,  // 58: customer.RoleOut.menus:type_name -> customer.MenuOutBase
+	42,  // 59: customer.RoleOut.buttons:type_name -> customer.ButtonOutBase
+	59,  // 60: customer.RoleOut.parents:type_name -> customer.RoleOutBase
+	11,  // 61: customer.RoleOut.inherited_permissions:type_name -> customer.PermissionOutBase
+	31,  // 62: customer.RoleOut.inherited_menus:type_name -> customer.MenuOutBase
+	42,  // 63: customer.RoleOut.inherited_buttons:type_name -> customer.ButtonOutBase
+	90,  // 64: customer.RoleOut.depts:type_name -> customer.DeptOutBase
+	11,  // 65: customer.RoleOut.deny_permissions:type_name -> customer.PermissionOutBase
+	5,   // 66: customer.RoleOut.conditions:type_name -> customer.PolicyConditions
+	59,  // 67: customer.PagRoleOutBase.items:type_name -> customer.RoleOutBase
+	1,   // 68: customer.ListUserRequest.is_active:type_name -> customer.BoolValue
+	1,   // 69: customer.ListUserRequest.is_staff:type_name -> customer.BoolValue
+	0,   // 70: customer.ListUserRequest.tenant_id:type_name -> customer.UInt32Value
+	59,  // 71: customer.UserOut.roles:type_name -> customer.RoleOutBase
+	90,  // 72: customer.UserOut.dept:type_name -> customer.DeptOutBase
+	71,  // 73: customer.PagUserOut.items:type_name -> customer.UserOut
+	1,   // 74: customer.ListTenantRequest.is_active:type_name -> customer.BoolValue
+	83,  // 75: customer.PagTenantOut.items:type_name -> customer.TenantOut
+	1,   // 76: customer.ListDeptRequest.is_active:type_name -> customer.BoolValue
+	0,   // 77: customer.ListDeptRequest.parent_id:type_name -> customer.UInt32Value
+	0,   // 78: customer.ListDeptRequest.tenant_id:type_name -> customer.UInt32Value
+	90,  // 79: customer.DeptOut.parent:type_name -> customer.DeptOutBase
+	90,  // 80: customer.PagDeptOutBase.items:type_name -> customer.DeptOutBase
+	59,  // 81: customer.UserRoleGrantOut.role:type_name -> customer.RoleOutBase
+	11,  // 82: customer.RolePermissionGrantOut.permission:type_name -> customer.PermissionOutBase
+	98,  // 83: customer.PagUserRoleGrantOut.items:type_name -> customer.UserRoleGrantOut
+	99,  // 84: customer.PagRolePermissionGrantOut.items:type_name -> customer.RolePermissionGrantOut
+	103, // 85: customer.IntrospectOut.claims:type_name -> customer.TokenClaims
+	103, // 86: customer.CheckOut.claims:type_name -> customer.TokenClaims
+	107, // 87: customer.CheckManyRequest.items:type_name -> customer.CheckItem
+	103, // 88: customer.CheckManyOut.claims:type_name -> customer.TokenClaims
+	113, // 89: customer.ImportRbacOut.changes:type_name -> customer.RbacChange
+	6,   // 90: customer.Permission.CreatePermission:input_type -> customer.CreatePermissionRequest
+	7,   // 91: customer.Permission.UpdatePermission:input_type -> customer.UpdatePermissionRequest
+	9,   // 92: customer.Permission.DeletePermission:input_type -> customer.DeletePermissionRequest
+	8,   // 93: customer.Permission.GetPermission:input_type -> customer.GetPermissionRequest
+	10,  // 94: customer.Permission.ListPermission:input_type -> customer.ListPermissionRequest
+	13,  // 95: customer.Permission.ExplainAccess:input_type -> customer.ExplainAccessRequest
+	17,  // 96: customer.Permission.SyncPermissions:input_type -> customer.SyncPermissionsRequest
+	19,  // 97: customer.Menu.CreateMenu:input_type -> customer.CreateMenuRequest
+	20,  // 98: customer.Menu.UpdateMenu:input_type -> customer.UpdateMenuRequest
+	21,  // 99: customer.Menu.DeleteMenu:input_type -> customer.DeleteMenuRequest
+	22,  // 100: customer.Menu.GetMenu:input_type -> customer.GetMenuRequest
+	23,  // 101: customer.Menu.ListMenu:input_type -> customer.ListMenuRequest
+	29,  // 102: customer.Menu.GetMenuTree:input_type -> customer.GetMenuTreeRequest
+	26,  // 103: customer.Menu.MoveMenu:input_type -> customer.MoveMenuRequest
+	28,  // 104: customer.Menu.ReorderMenus:input_type -> customer.ReorderMenusRequest
+	24,  // 105: customer.Menu.ExportMenuRoutes:input_type -> customer.ExportMenuRoutesRequest
+	37,  // 106: customer.Button.CreateButton:input_type -> customer.CreateButtonRequest
+	38,  // 107: customer.Button.UpdateButton:input_type -> customer.UpdateButtonRequest
+	39,  // 108: customer.Button.DeleteButton:input_type -> customer.DeleteButtonRequest
+	40,  // 109: customer.Button.GetButton:input_type -> customer.GetButtonRequest
+	41,  // 110: customer.Button.ListButton:input_type -> customer.ListButtonRequest
+	44,  // 111: customer.Button.ListAllowedButtonCodes:input_type -> customer.ListAllowedButtonCodesRequest
+	48,  // 112: customer.Role.CreateRole:input_type -> customer.CreateRoleRequest
+	49,  // 113: customer.Role.UpdateRole:input_type -> customer.UpdateRoleRequest
+	50,  // 114: customer.Role.DeleteRole:input_type -> customer.DeleteRoleRequest
+	51,  // 115: customer.Role.GetRole:input_type -> customer.GetRoleRequest
+	58,  // 116: customer.Role.ListRole:input_type -> customer.ListRoleRequest
+	51,  // 117: customer.Role.GetRoleImpact:input_type -> customer.GetRoleRequest
+	52,  // 118: customer.Role.CloneRole:input_type -> customer.CloneRoleRequest
+	53,  // 119: customer.Role.DiffRoles:input_type -> customer.DiffRolesRequest
+	65,  // 120: customer.User.CreateUser:input_type -> customer.CreateUserRequest
+	66,  // 121: customer.User.UpdateCustomer:input_type -> customer.UpdateUserRequest
+	67,  // 122: customer.User.DeleteCustomer:input_type -> customer.DeleteUserRequest
+	68,  // 123: customer.User.GetCustomer:input_type -> customer.GetUserRequest
+	69,  // 124: customer.User.ListCustomer:input_type -> customer.ListUserRequest
+	73,  // 125: customer.User.ResetPassword:input_type -> customer.ResetPasswordRequest
+	74,  // 126: customer.User.ChangePassword:input_type -> customer.ChangePasswordRequest
+	70,  // 127: customer.User.Login:input_type -> customer.LoginRequest
+	76,  // 128: customer.Captcha.GenerateCaptcha:input_type -> customer.GenerateCaptchaRequest
+	78,  // 129: customer.Tenant.CreateTenant:input_type -> customer.CreateTenantRequest
+	79,  // 130: customer.Tenant.UpdateTenant:input_type -> customer.UpdateTenantRequest
+	80,  // 131: customer.Tenant.DeleteTenant:input_type -> customer.DeleteTenantRequest
+	81,  // 132: customer.Tenant.GetTenant:input_type -> customer.GetTenantRequest
+	82,  // 133: customer.Tenant.ListTenant:input_type -> customer.ListTenantRequest
+	85,  // 134: customer.Dept.CreateDept:input_type -> customer.CreateDeptRequest
+	86,  // 135: customer.Dept.UpdateDept:input_type -> customer.UpdateDeptRequest
+	87,  // 136: customer.Dept.DeleteDept:input_type -> customer.DeleteDeptRequest
+	88,  // 137: customer.Dept.GetDept:input_type -> customer.GetDeptRequest
+	89,  // 138: customer.Dept.ListDept:input_type -> customer.ListDeptRequest
+	93,  // 139: customer.Grant.CreateUserRoleGrant:input_type -> customer.CreateUserRoleGrantRequest
+	95,  // 140: customer.Grant.DeleteUserRoleGrant:input_type -> customer.DeleteGrantRequest
+	96,  // 141: customer.Grant.ListUserRoleGrant:input_type -> customer.ListUserRoleGrantRequest
+	94,  // 142: customer.Grant.CreateRolePermissionGrant:input_type -> customer.CreateRolePermissionGrantRequest
+	95,  // 143: customer.Grant.DeleteRolePermissionGrant:input_type -> customer.DeleteGrantRequest
+	97,  // 144: customer.Grant.ListRolePermissionGrant:input_type -> customer.ListRolePermissionGrantRequest
+	102, // 145: customer.Auth.Introspect:input_type -> customer.IntrospectRequest
+	105, // 146: customer.Auth.Check:input_type -> customer.CheckRequest
+	108, // 147: customer.Auth.CheckMany:input_type -> customer.CheckManyRequest
+	110, // 148: customer.Rbac.ExportRbac:input_type -> customer.ExportRbacRequest
+	112, // 149: customer.Rbac.ImportRbac:input_type -> customer.ImportRbacRequest
+	11,  // 150: customer.Permission.CreatePermission:output_type -> customer.PermissionOutBase
+	11,  // 151: customer.Permission.UpdatePermission:output_type -> customer.PermissionOutBase
+	4,   // 152: customer.Permission.DeletePermission:output_type -> customer.DeleteOut
+	11,  // 153: customer.Permission.GetPermission:output_type -> customer.PermissionOutBase
+	12,  // 154: customer.Permission.ListPermission:output_type -> customer.PagPermissionOutBase
+	16,  // 155: customer.Permission.ExplainAccess:output_type -> customer.ExplainAccessOut
+	18,  // 156: customer.Permission.SyncPermissions:output_type -> customer.SyncPermissionsOut
+	35,  // 157: customer.Menu.CreateMenu:output_type -> customer.MenuOut
+	35,  // 158: customer.Menu.UpdateMenu:output_type -> customer.MenuOut
+	4,   // 159: customer.Menu.DeleteMenu:output_type -> customer.DeleteOut
+	35,  // 160: customer.Menu.GetMenu:output_type -> customer.MenuOut
+	36,  // 161: customer.Menu.ListMenu:output_type -> customer.PagMenuOutBase
+	34,  // 162: customer.Menu.GetMenuTree:output_type -> customer.MenuTreeOut
+	35,  // 163: customer.Menu.MoveMenu:output_type -> customer.MenuOut
+	2,   // 164: customer.Menu.ReorderMenus:output_type -> customer.NilOut
+	25,  // 165: customer.Menu.ExportMenuRoutes:output_type -> customer.MenuRoutesOut
+	43,  // 166: customer.Button.CreateButton:output_type -> customer.ButtonOut
+	43,  // 167: customer.Button.UpdateButton:output_type -> customer.ButtonOut
+	4,   // 168: customer.Button.DeleteButton:output_type -> customer.DeleteOut
+	43,  // 169: customer.Button.GetButton:output_type -> customer.ButtonOut
+	47,  // 170: customer.Button.ListButton:output_type -> customer.PagButtonOutBase
+	46,  // 171: customer.Button.ListAllowedButtonCodes:output_type -> customer.AllowedButtonCodesOut
+	63,  // 172: customer.Role.CreateRole:output_type -> customer.RoleOut
+	63,  // 173: customer.Role.UpdateRole:output_type -> customer.RoleOut
+	4,   // 174: customer.Role.DeleteRole:output_type -> customer.DeleteOut
+	63,  // 175: customer.Role.GetRole:output_type -> customer.RoleOut
+	64,  // 176: customer.Role.ListRole:output_type -> customer.PagRoleOutBase
+	62,  // 177: customer.Role.GetRoleImpact:output_type -> customer.RoleImpactOut
+	63,  // 178: customer.Role.CloneRole:output_type -> customer.RoleOut
+	57,  // 179: customer.Role.DiffRoles:output_type -> customer.RoleDiffOut
+	71,  // 180: customer.User.CreateUser:output_type -> customer.UserOut
+	71,  // 181: customer.User.UpdateCustomer:output_type -> customer.UserOut
+	4,   // 182: customer.User.DeleteCustomer:output_type -> customer.DeleteOut
+	71,  // 183: customer.User.GetCustomer:output_type -> customer.UserOut
+	72,  // 184: customer.User.ListCustomer:output_type -> customer.PagUserOut
+	2,   // 185: customer.User.ResetPassword:output_type -> customer.NilOut
+	2,   // 186: customer.User.ChangePassword:output_type -> customer.NilOut
+	75,  // 187: customer.User.Login:output_type -> customer.LoginOut
+	77,  // 188: customer.Captcha.GenerateCaptcha:output_type -> customer.CaptchaOut
+	83,  // 189: customer.Tenant.CreateTenant:output_type -> customer.TenantOut
+	83,  // 190: customer.Tenant.UpdateTenant:output_type -> customer.TenantOut
+	4,   // 191: customer.Tenant.DeleteTenant:output_type -> customer.DeleteOut
+	83,  // 192: customer.Tenant.GetTenant:output_type -> customer.TenantOut
+	84,  // 193: customer.Tenant.ListTenant:output_type -> customer.PagTenantOut
+	91,  // 194: customer.Dept.CreateDept:output_type -> customer.DeptOut
+	91,  // 195: customer.Dept.UpdateDept:output_type -> customer.DeptOut
+	4,   // 196: customer.Dept.DeleteDept:output_type -> customer.DeleteOut
+	91,  // 197: customer.Dept.GetDept:output_type -> customer.DeptOut
+	92,  // 198: customer.Dept.ListDept:output_type -> customer.PagDeptOutBase
+	98,  // 199: customer.Grant.CreateUserRoleGrant:output_type -> customer.UserRoleGrantOut
+	4,   // 200: customer.Grant.DeleteUserRoleGrant:output_type -> customer.DeleteOut
+	100, // 201: customer.Grant.ListUserRoleGrant:output_type -> customer.PagUserRoleGrantOut
+	99,  // 202: customer.Grant.CreateRolePermissionGrant:output_type -> customer.RolePermissionGrantOut
+	4,   // 203: customer.Grant.DeleteRolePermissionGrant:output_type -> customer.DeleteOut
+	101, // 204: customer.Grant.ListRolePermissionGrant:output_type -> customer.PagRolePermissionGrantOut
+	104, // 205: customer.Auth.Introspect:output_type -> customer.IntrospectOut
+	106, // 206: customer.Auth.Check:output_type -> customer.CheckOut
+	109, // 207: customer.Auth.CheckMany:output_type -> customer.CheckManyOut
+	111, // 208: customer.Rbac.ExportRbac:output_type -> customer.RbacDocumentOut
+	114, // 209: customer.Rbac.ImportRbac:output_type -> customer.ImportRbacOut
+	150, // [150:210] is the sub-list for method output_type
+	90,  // [90:150] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_apps_customer_rpc_customer_proto_init() }
@@ -4137,9 +10348,9 @@ func file_apps_customer_rpc_customer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apps_customer_rpc_customer_proto_rawDesc), len(file_apps_customer_rpc_customer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   116,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   11,
 		},
 		GoTypes:           file_apps_customer_rpc_customer_proto_goTypes,
 		DependencyIndexes: file_apps_customer_rpc_customer_proto_depIdxs,
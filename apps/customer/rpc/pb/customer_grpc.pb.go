@@ -24,6 +24,8 @@ const (
 	Permission_DeletePermission_FullMethodName = "/customer.Permission/DeletePermission"
 	Permission_GetPermission_FullMethodName    = "/customer.Permission/GetPermission"
 	Permission_ListPermission_FullMethodName   = "/customer.Permission/ListPermission"
+	Permission_ExplainAccess_FullMethodName    = "/customer.Permission/ExplainAccess"
+	Permission_SyncPermissions_FullMethodName  = "/customer.Permission/SyncPermissions"
 )
 
 // PermissionClient is the client API for Permission service.
@@ -32,9 +34,11 @@ const (
 type PermissionClient interface {
 	CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*PermissionOutBase, error)
 	UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...grpc.CallOption) (*PermissionOutBase, error)
-	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*NilOut, error)
+	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeleteOut, error)
 	GetPermission(ctx context.Context, in *GetPermissionRequest, opts ...grpc.CallOption) (*PermissionOutBase, error)
 	ListPermission(ctx context.Context, in *ListPermissionRequest, opts ...grpc.CallOption) (*PagPermissionOutBase, error)
+	ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessOut, error)
+	SyncPermissions(ctx context.Context, in *SyncPermissionsRequest, opts ...grpc.CallOption) (*SyncPermissionsOut, error)
 }
 
 type permissionClient struct {
@@ -65,9 +69,9 @@ func (c *permissionClient) UpdatePermission(ctx context.Context, in *UpdatePermi
 	return out, nil
 }
 
-func (c *permissionClient) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*NilOut, error) {
+func (c *permissionClient) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(NilOut)
+	out := new(DeleteOut)
 	err := c.cc.Invoke(ctx, Permission_DeletePermission_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -95,15 +99,37 @@ func (c *permissionClient) ListPermission(ctx context.Context, in *ListPermissio
 	return out, nil
 }
 
+func (c *permissionClient) ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainAccessOut)
+	err := c.cc.Invoke(ctx, Permission_ExplainAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionClient) SyncPermissions(ctx context.Context, in *SyncPermissionsRequest, opts ...grpc.CallOption) (*SyncPermissionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncPermissionsOut)
+	err := c.cc.Invoke(ctx, Permission_SyncPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PermissionServer is the server API for Permission service.
 // All implementations must embed UnimplementedPermissionServer
 // for forward compatibility.
 type PermissionServer interface {
 	CreatePermission(context.Context, *CreatePermissionRequest) (*PermissionOutBase, error)
 	UpdatePermission(context.Context, *UpdatePermissionRequest) (*PermissionOutBase, error)
-	DeletePermission(context.Context, *DeletePermissionRequest) (*NilOut, error)
+	DeletePermission(context.Context, *DeletePermissionRequest) (*DeleteOut, error)
 	GetPermission(context.Context, *GetPermissionRequest) (*PermissionOutBase, error)
 	ListPermission(context.Context, *ListPermissionRequest) (*PagPermissionOutBase, error)
+	ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessOut, error)
+	SyncPermissions(context.Context, *SyncPermissionsRequest) (*SyncPermissionsOut, error)
 	mustEmbedUnimplementedPermissionServer()
 }
 
@@ -120,7 +146,7 @@ func (UnimplementedPermissionServer) CreatePermission(context.Context, *CreatePe
 func (UnimplementedPermissionServer) UpdatePermission(context.Context, *UpdatePermissionRequest) (*PermissionOutBase, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermission not implemented")
 }
-func (UnimplementedPermissionServer) DeletePermission(context.Context, *DeletePermissionRequest) (*NilOut, error) {
+func (UnimplementedPermissionServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeleteOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePermission not implemented")
 }
 func (UnimplementedPermissionServer) GetPermission(context.Context, *GetPermissionRequest) (*PermissionOutBase, error) {
@@ -129,6 +155,12 @@ func (UnimplementedPermissionServer) GetPermission(context.Context, *GetPermissi
 func (UnimplementedPermissionServer) ListPermission(context.Context, *ListPermissionRequest) (*PagPermissionOutBase, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPermission not implemented")
 }
+func (UnimplementedPermissionServer) ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainAccess not implemented")
+}
+func (UnimplementedPermissionServer) SyncPermissions(context.Context, *SyncPermissionsRequest) (*SyncPermissionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncPermissions not implemented")
+}
 func (UnimplementedPermissionServer) mustEmbedUnimplementedPermissionServer() {}
 func (UnimplementedPermissionServer) testEmbeddedByValue()                    {}
 
@@ -240,6 +272,42 @@ func _Permission_ListPermission_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Permission_ExplainAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).ExplainAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Permission_ExplainAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).ExplainAccess(ctx, req.(*ExplainAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Permission_SyncPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).SyncPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Permission_SyncPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).SyncPermissions(ctx, req.(*SyncPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Permission_ServiceDesc is the grpc.ServiceDesc for Permission service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,17 +335,29 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPermission",
 			Handler:    _Permission_ListPermission_Handler,
 		},
+		{
+			MethodName: "ExplainAccess",
+			Handler:    _Permission_ExplainAccess_Handler,
+		},
+		{
+			MethodName: "SyncPermissions",
+			Handler:    _Permission_SyncPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apps/customer/rpc/customer.proto",
 }
 
 const (
-	Menu_CreateMenu_FullMethodName = "/customer.Menu/CreateMenu"
-	Menu_UpdateMenu_FullMethodName = "/customer.Menu/UpdateMenu"
-	Menu_DeleteMenu_FullMethodName = "/customer.Menu/DeleteMenu"
-	Menu_GetMenu_FullMethodName    = "/customer.Menu/GetMenu"
-	Menu_ListMenu_FullMethodName   = "/customer.Menu/ListMenu"
+	Menu_CreateMenu_FullMethodName       = "/customer.Menu/CreateMenu"
+	Menu_UpdateMenu_FullMethodName       = "/customer.Menu/UpdateMenu"
+	Menu_DeleteMenu_FullMethodName       = "/customer.Menu/DeleteMenu"
+	Menu_GetMenu_FullMethodName          = "/customer.Menu/GetMenu"
+	Menu_ListMenu_FullMethodName         = "/customer.Menu/ListMenu"
+	Menu_GetMenuTree_FullMethodName      = "/customer.Menu/GetMenuTree"
+	Menu_MoveMenu_FullMethodName         = "/customer.Menu/MoveMenu"
+	Menu_ReorderMenus_FullMethodName     = "/customer.Menu/ReorderMenus"
+	Menu_ExportMenuRoutes_FullMethodName = "/customer.Menu/ExportMenuRoutes"
 )
 
 // MenuClient is the client API for Menu service.
@@ -286,9 +366,13 @@ const (
 type MenuClient interface {
 	CreateMenu(ctx context.Context, in *CreateMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
 	UpdateMenu(ctx context.Context, in *UpdateMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
-	DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*NilOut, error)
+	DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*DeleteOut, error)
 	GetMenu(ctx context.Context, in *GetMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
 	ListMenu(ctx context.Context, in *ListMenuRequest, opts ...grpc.CallOption) (*PagMenuOutBase, error)
+	GetMenuTree(ctx context.Context, in *GetMenuTreeRequest, opts ...grpc.CallOption) (*MenuTreeOut, error)
+	MoveMenu(ctx context.Context, in *MoveMenuRequest, opts ...grpc.CallOption) (*MenuOut, error)
+	ReorderMenus(ctx context.Context, in *ReorderMenusRequest, opts ...grpc.CallOption) (*NilOut, error)
+	ExportMenuRoutes(ctx context.Context, in *ExportMenuRoutesRequest, opts ...grpc.CallOption) (*MenuRoutesOut, error)
 }
 
 type menuClient struct {
@@ -319,9 +403,9 @@ func (c *menuClient) UpdateMenu(ctx context.Context, in *UpdateMenuRequest, opts
 	return out, nil
 }
 
-func (c *menuClient) DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*NilOut, error) {
+func (c *menuClient) DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(NilOut)
+	out := new(DeleteOut)
 	err := c.cc.Invoke(ctx, Menu_DeleteMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -349,15 +433,59 @@ func (c *menuClient) ListMenu(ctx context.Context, in *ListMenuRequest, opts ...
 	return out, nil
 }
 
+func (c *menuClient) GetMenuTree(ctx context.Context, in *GetMenuTreeRequest, opts ...grpc.CallOption) (*MenuTreeOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuTreeOut)
+	err := c.cc.Invoke(ctx, Menu_GetMenuTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuClient) MoveMenu(ctx context.Context, in *MoveMenuRequest, opts ...grpc.CallOption) (*MenuOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuOut)
+	err := c.cc.Invoke(ctx, Menu_MoveMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuClient) ReorderMenus(ctx context.Context, in *ReorderMenusRequest, opts ...grpc.CallOption) (*NilOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NilOut)
+	err := c.cc.Invoke(ctx, Menu_ReorderMenus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuClient) ExportMenuRoutes(ctx context.Context, in *ExportMenuRoutesRequest, opts ...grpc.CallOption) (*MenuRoutesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuRoutesOut)
+	err := c.cc.Invoke(ctx, Menu_ExportMenuRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MenuServer is the server API for Menu service.
 // All implementations must embed UnimplementedMenuServer
 // for forward compatibility.
 type MenuServer interface {
 	CreateMenu(context.Context, *CreateMenuRequest) (*MenuOut, error)
 	UpdateMenu(context.Context, *UpdateMenuRequest) (*MenuOut, error)
-	DeleteMenu(context.Context, *DeleteMenuRequest) (*NilOut, error)
+	DeleteMenu(context.Context, *DeleteMenuRequest) (*DeleteOut, error)
 	GetMenu(context.Context, *GetMenuRequest) (*MenuOut, error)
 	ListMenu(context.Context, *ListMenuRequest) (*PagMenuOutBase, error)
+	GetMenuTree(context.Context, *GetMenuTreeRequest) (*MenuTreeOut, error)
+	MoveMenu(context.Context, *MoveMenuRequest) (*MenuOut, error)
+	ReorderMenus(context.Context, *ReorderMenusRequest) (*NilOut, error)
+	ExportMenuRoutes(context.Context, *ExportMenuRoutesRequest) (*MenuRoutesOut, error)
 	mustEmbedUnimplementedMenuServer()
 }
 
@@ -374,7 +502,7 @@ func (UnimplementedMenuServer) CreateMenu(context.Context, *CreateMenuRequest) (
 func (UnimplementedMenuServer) UpdateMenu(context.Context, *UpdateMenuRequest) (*MenuOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMenu not implemented")
 }
-func (UnimplementedMenuServer) DeleteMenu(context.Context, *DeleteMenuRequest) (*NilOut, error) {
+func (UnimplementedMenuServer) DeleteMenu(context.Context, *DeleteMenuRequest) (*DeleteOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenu not implemented")
 }
 func (UnimplementedMenuServer) GetMenu(context.Context, *GetMenuRequest) (*MenuOut, error) {
@@ -383,6 +511,18 @@ func (UnimplementedMenuServer) GetMenu(context.Context, *GetMenuRequest) (*MenuO
 func (UnimplementedMenuServer) ListMenu(context.Context, *ListMenuRequest) (*PagMenuOutBase, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMenu not implemented")
 }
+func (UnimplementedMenuServer) GetMenuTree(context.Context, *GetMenuTreeRequest) (*MenuTreeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuTree not implemented")
+}
+func (UnimplementedMenuServer) MoveMenu(context.Context, *MoveMenuRequest) (*MenuOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveMenu not implemented")
+}
+func (UnimplementedMenuServer) ReorderMenus(context.Context, *ReorderMenusRequest) (*NilOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderMenus not implemented")
+}
+func (UnimplementedMenuServer) ExportMenuRoutes(context.Context, *ExportMenuRoutesRequest) (*MenuRoutesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMenuRoutes not implemented")
+}
 func (UnimplementedMenuServer) mustEmbedUnimplementedMenuServer() {}
 func (UnimplementedMenuServer) testEmbeddedByValue()              {}
 
@@ -494,6 +634,78 @@ func _Menu_ListMenu_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Menu_GetMenuTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServer).GetMenuTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Menu_GetMenuTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServer).GetMenuTree(ctx, req.(*GetMenuTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Menu_MoveMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServer).MoveMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Menu_MoveMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServer).MoveMenu(ctx, req.(*MoveMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Menu_ReorderMenus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderMenusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServer).ReorderMenus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Menu_ReorderMenus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServer).ReorderMenus(ctx, req.(*ReorderMenusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Menu_ExportMenuRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMenuRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServer).ExportMenuRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Menu_ExportMenuRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServer).ExportMenuRoutes(ctx, req.(*ExportMenuRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Menu_ServiceDesc is the grpc.ServiceDesc for Menu service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -521,17 +733,34 @@ var Menu_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMenu",
 			Handler:    _Menu_ListMenu_Handler,
 		},
+		{
+			MethodName: "GetMenuTree",
+			Handler:    _Menu_GetMenuTree_Handler,
+		},
+		{
+			MethodName: "MoveMenu",
+			Handler:    _Menu_MoveMenu_Handler,
+		},
+		{
+			MethodName: "ReorderMenus",
+			Handler:    _Menu_ReorderMenus_Handler,
+		},
+		{
+			MethodName: "ExportMenuRoutes",
+			Handler:    _Menu_ExportMenuRoutes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apps/customer/rpc/customer.proto",
 }
 
 const (
-	Button_CreateButton_FullMethodName = "/customer.Button/CreateButton"
-	Button_UpdateButton_FullMethodName = "/customer.Button/UpdateButton"
-	Button_DeleteButton_FullMethodName = "/customer.Button/DeleteButton"
-	Button_GetButton_FullMethodName    = "/customer.Button/GetButton"
-	Button_ListButton_FullMethodName   = "/customer.Button/ListButton"
+	Button_CreateButton_FullMethodName           = "/customer.Button/CreateButton"
+	Button_UpdateButton_FullMethodName           = "/customer.Button/UpdateButton"
+	Button_DeleteButton_FullMethodName           = "/customer.Button/DeleteButton"
+	Button_GetButton_FullMethodName              = "/customer.Button/GetButton"
+	Button_ListButton_FullMethodName             = "/customer.Button/ListButton"
+	Button_ListAllowedButtonCodes_FullMethodName = "/customer.Button/ListAllowedButtonCodes"
 )
 
 // ButtonClient is the client API for Button service.
@@ -540,9 +769,10 @@ const (
 type ButtonClient interface {
 	CreateButton(ctx context.Context, in *CreateButtonRequest, opts ...grpc.CallOption) (*ButtonOut, error)
 	UpdateButton(ctx context.Context, in *UpdateButtonRequest, opts ...grpc.CallOption) (*ButtonOut, error)
-	DeleteButton(ctx context.Context, in *DeleteButtonRequest, opts ...grpc.CallOption) (*NilOut, error)
+	DeleteButton(ctx context.Context, in *DeleteButtonRequest, opts ...grpc.CallOption) (*DeleteOut, error)
 	GetButton(ctx context.Context, in *GetButtonRequest, opts ...grpc.CallOption) (*ButtonOut, error)
 	ListButton(ctx context.Context, in *ListButtonRequest, opts ...grpc.CallOption) (*PagButtonOutBase, error)
+	ListAllowedButtonCodes(ctx context.Context, in *ListAllowedButtonCodesRequest, opts ...grpc.CallOption) (*AllowedButtonCodesOut, error)
 }
 
 type buttonClient struct {
@@ -573,9 +803,9 @@ func (c *buttonClient) UpdateButton(ctx context.Context, in *UpdateButtonRequest
 	return out, nil
 }
 
-func (c *buttonClient) DeleteButton(ctx context.Context, in *DeleteButtonRequest, opts ...grpc.CallOption) (*NilOut, error) {
+func (c *buttonClient) DeleteButton(ctx context.Context, in *DeleteButtonRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(NilOut)
+	out := new(DeleteOut)
 	err := c.cc.Invoke(ctx, Button_DeleteButton_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -603,15 +833,26 @@ func (c *buttonClient) ListButton(ctx context.Context, in *ListButtonRequest, op
 	return out, nil
 }
 
+func (c *buttonClient) ListAllowedButtonCodes(ctx context.Context, in *ListAllowedButtonCodesRequest, opts ...grpc.CallOption) (*AllowedButtonCodesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllowedButtonCodesOut)
+	err := c.cc.Invoke(ctx, Button_ListAllowedButtonCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ButtonServer is the server API for Button service.
 // All implementations must embed UnimplementedButtonServer
 // for forward compatibility.
 type ButtonServer interface {
 	CreateButton(context.Context, *CreateButtonRequest) (*ButtonOut, error)
 	UpdateButton(context.Context, *UpdateButtonRequest) (*ButtonOut, error)
-	DeleteButton(context.Context, *DeleteButtonRequest) (*NilOut, error)
+	DeleteButton(context.Context, *DeleteButtonRequest) (*DeleteOut, error)
 	GetButton(context.Context, *GetButtonRequest) (*ButtonOut, error)
 	ListButton(context.Context, *ListButtonRequest) (*PagButtonOutBase, error)
+	ListAllowedButtonCodes(context.Context, *ListAllowedButtonCodesRequest) (*AllowedButtonCodesOut, error)
 	mustEmbedUnimplementedButtonServer()
 }
 
@@ -628,7 +869,7 @@ func (UnimplementedButtonServer) CreateButton(context.Context, *CreateButtonRequ
 func (UnimplementedButtonServer) UpdateButton(context.Context, *UpdateButtonRequest) (*ButtonOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateButton not implemented")
 }
-func (UnimplementedButtonServer) DeleteButton(context.Context, *DeleteButtonRequest) (*NilOut, error) {
+func (UnimplementedButtonServer) DeleteButton(context.Context, *DeleteButtonRequest) (*DeleteOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteButton not implemented")
 }
 func (UnimplementedButtonServer) GetButton(context.Context, *GetButtonRequest) (*ButtonOut, error) {
@@ -637,6 +878,9 @@ func (UnimplementedButtonServer) GetButton(context.Context, *GetButtonRequest) (
 func (UnimplementedButtonServer) ListButton(context.Context, *ListButtonRequest) (*PagButtonOutBase, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListButton not implemented")
 }
+func (UnimplementedButtonServer) ListAllowedButtonCodes(context.Context, *ListAllowedButtonCodesRequest) (*AllowedButtonCodesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowedButtonCodes not implemented")
+}
 func (UnimplementedButtonServer) mustEmbedUnimplementedButtonServer() {}
 func (UnimplementedButtonServer) testEmbeddedByValue()                {}
 
@@ -748,6 +992,24 @@ func _Button_ListButton_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Button_ListAllowedButtonCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllowedButtonCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ButtonServer).ListAllowedButtonCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Button_ListAllowedButtonCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ButtonServer).ListAllowedButtonCodes(ctx, req.(*ListAllowedButtonCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Button_ServiceDesc is the grpc.ServiceDesc for Button service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -775,17 +1037,24 @@ var Button_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListButton",
 			Handler:    _Button_ListButton_Handler,
 		},
+		{
+			MethodName: "ListAllowedButtonCodes",
+			Handler:    _Button_ListAllowedButtonCodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apps/customer/rpc/customer.proto",
 }
 
 const (
-	Role_CreateRole_FullMethodName = "/customer.Role/CreateRole"
-	Role_UpdateRole_FullMethodName = "/customer.Role/UpdateRole"
-	Role_DeleteRole_FullMethodName = "/customer.Role/DeleteRole"
-	Role_GetRole_FullMethodName    = "/customer.Role/GetRole"
-	Role_ListRole_FullMethodName   = "/customer.Role/ListRole"
+	Role_CreateRole_FullMethodName    = "/customer.Role/CreateRole"
+	Role_UpdateRole_FullMethodName    = "/customer.Role/UpdateRole"
+	Role_DeleteRole_FullMethodName    = "/customer.Role/DeleteRole"
+	Role_GetRole_FullMethodName       = "/customer.Role/GetRole"
+	Role_ListRole_FullMethodName      = "/customer.Role/ListRole"
+	Role_GetRoleImpact_FullMethodName = "/customer.Role/GetRoleImpact"
+	Role_CloneRole_FullMethodName     = "/customer.Role/CloneRole"
+	Role_DiffRoles_FullMethodName     = "/customer.Role/DiffRoles"
 )
 
 // RoleClient is the client API for Role service.
@@ -794,9 +1063,12 @@ const (
 type RoleClient interface {
 	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*RoleOut, error)
 	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*RoleOut, error)
-	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*NilOut, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteOut, error)
 	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*RoleOut, error)
 	ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*PagRoleOutBase, error)
+	GetRoleImpact(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*RoleImpactOut, error)
+	CloneRole(ctx context.Context, in *CloneRoleRequest, opts ...grpc.CallOption) (*RoleOut, error)
+	DiffRoles(ctx context.Context, in *DiffRolesRequest, opts ...grpc.CallOption) (*RoleDiffOut, error)
 }
 
 type roleClient struct {
@@ -827,9 +1099,9 @@ func (c *roleClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts
 	return out, nil
 }
 
-func (c *roleClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*NilOut, error) {
+func (c *roleClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(NilOut)
+	out := new(DeleteOut)
 	err := c.cc.Invoke(ctx, Role_DeleteRole_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -857,15 +1129,48 @@ func (c *roleClient) ListRole(ctx context.Context, in *ListRoleRequest, opts ...
 	return out, nil
 }
 
+func (c *roleClient) GetRoleImpact(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*RoleImpactOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleImpactOut)
+	err := c.cc.Invoke(ctx, Role_GetRoleImpact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleClient) CloneRole(ctx context.Context, in *CloneRoleRequest, opts ...grpc.CallOption) (*RoleOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleOut)
+	err := c.cc.Invoke(ctx, Role_CloneRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleClient) DiffRoles(ctx context.Context, in *DiffRolesRequest, opts ...grpc.CallOption) (*RoleDiffOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleDiffOut)
+	err := c.cc.Invoke(ctx, Role_DiffRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServer is the server API for Role service.
 // All implementations must embed UnimplementedRoleServer
 // for forward compatibility.
 type RoleServer interface {
 	CreateRole(context.Context, *CreateRoleRequest) (*RoleOut, error)
 	UpdateRole(context.Context, *UpdateRoleRequest) (*RoleOut, error)
-	DeleteRole(context.Context, *DeleteRoleRequest) (*NilOut, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteOut, error)
 	GetRole(context.Context, *GetRoleRequest) (*RoleOut, error)
 	ListRole(context.Context, *ListRoleRequest) (*PagRoleOutBase, error)
+	GetRoleImpact(context.Context, *GetRoleRequest) (*RoleImpactOut, error)
+	CloneRole(context.Context, *CloneRoleRequest) (*RoleOut, error)
+	DiffRoles(context.Context, *DiffRolesRequest) (*RoleDiffOut, error)
 	mustEmbedUnimplementedRoleServer()
 }
 
@@ -882,7 +1187,7 @@ func (UnimplementedRoleServer) CreateRole(context.Context, *CreateRoleRequest) (
 func (UnimplementedRoleServer) UpdateRole(context.Context, *UpdateRoleRequest) (*RoleOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
 }
-func (UnimplementedRoleServer) DeleteRole(context.Context, *DeleteRoleRequest) (*NilOut, error) {
+func (UnimplementedRoleServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
 }
 func (UnimplementedRoleServer) GetRole(context.Context, *GetRoleRequest) (*RoleOut, error) {
@@ -891,6 +1196,15 @@ func (UnimplementedRoleServer) GetRole(context.Context, *GetRoleRequest) (*RoleO
 func (UnimplementedRoleServer) ListRole(context.Context, *ListRoleRequest) (*PagRoleOutBase, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRole not implemented")
 }
+func (UnimplementedRoleServer) GetRoleImpact(context.Context, *GetRoleRequest) (*RoleImpactOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleImpact not implemented")
+}
+func (UnimplementedRoleServer) CloneRole(context.Context, *CloneRoleRequest) (*RoleOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneRole not implemented")
+}
+func (UnimplementedRoleServer) DiffRoles(context.Context, *DiffRolesRequest) (*RoleDiffOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRoles not implemented")
+}
 func (UnimplementedRoleServer) mustEmbedUnimplementedRoleServer() {}
 func (UnimplementedRoleServer) testEmbeddedByValue()              {}
 
@@ -1002,6 +1316,60 @@ func _Role_ListRole_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Role_GetRoleImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServer).GetRoleImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Role_GetRoleImpact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServer).GetRoleImpact(ctx, req.(*GetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Role_CloneRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServer).CloneRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Role_CloneRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServer).CloneRole(ctx, req.(*CloneRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Role_DiffRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServer).DiffRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Role_DiffRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServer).DiffRoles(ctx, req.(*DiffRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Role_ServiceDesc is the grpc.ServiceDesc for Role service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1029,6 +1397,18 @@ var Role_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRole",
 			Handler:    _Role_ListRole_Handler,
 		},
+		{
+			MethodName: "GetRoleImpact",
+			Handler:    _Role_GetRoleImpact_Handler,
+		},
+		{
+			MethodName: "CloneRole",
+			Handler:    _Role_CloneRole_Handler,
+		},
+		{
+			MethodName: "DiffRoles",
+			Handler:    _Role_DiffRoles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apps/customer/rpc/customer.proto",
@@ -1051,7 +1431,7 @@ const (
 type UserClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*UserOut, error)
 	UpdateCustomer(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserOut, error)
-	DeleteCustomer(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*NilOut, error)
+	DeleteCustomer(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteOut, error)
 	GetCustomer(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserOut, error)
 	ListCustomer(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*PagUserOut, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*NilOut, error)
@@ -1087,9 +1467,9 @@ func (c *userClient) UpdateCustomer(ctx context.Context, in *UpdateUserRequest,
 	return out, nil
 }
 
-func (c *userClient) DeleteCustomer(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*NilOut, error) {
+func (c *userClient) DeleteCustomer(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(NilOut)
+	out := new(DeleteOut)
 	err := c.cc.Invoke(ctx, User_DeleteCustomer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1153,7 +1533,7 @@ func (c *userClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.C
 type UserServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*UserOut, error)
 	UpdateCustomer(context.Context, *UpdateUserRequest) (*UserOut, error)
-	DeleteCustomer(context.Context, *DeleteUserRequest) (*NilOut, error)
+	DeleteCustomer(context.Context, *DeleteUserRequest) (*DeleteOut, error)
 	GetCustomer(context.Context, *GetUserRequest) (*UserOut, error)
 	ListCustomer(context.Context, *ListUserRequest) (*PagUserOut, error)
 	ResetPassword(context.Context, *ResetPasswordRequest) (*NilOut, error)
@@ -1175,7 +1555,7 @@ func (UnimplementedUserServer) CreateUser(context.Context, *CreateUserRequest) (
 func (UnimplementedUserServer) UpdateCustomer(context.Context, *UpdateUserRequest) (*UserOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
 }
-func (UnimplementedUserServer) DeleteCustomer(context.Context, *DeleteUserRequest) (*NilOut, error) {
+func (UnimplementedUserServer) DeleteCustomer(context.Context, *DeleteUserRequest) (*DeleteOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
 }
 func (UnimplementedUserServer) GetCustomer(context.Context, *GetUserRequest) (*UserOut, error) {
@@ -1401,3 +1781,1233 @@ var User_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apps/customer/rpc/customer.proto",
 }
+
+const (
+	Captcha_GenerateCaptcha_FullMethodName = "/customer.Captcha/GenerateCaptcha"
+)
+
+// CaptchaClient is the client API for Captcha service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CaptchaClient interface {
+	GenerateCaptcha(ctx context.Context, in *GenerateCaptchaRequest, opts ...grpc.CallOption) (*CaptchaOut, error)
+}
+
+type captchaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaptchaClient(cc grpc.ClientConnInterface) CaptchaClient {
+	return &captchaClient{cc}
+}
+
+func (c *captchaClient) GenerateCaptcha(ctx context.Context, in *GenerateCaptchaRequest, opts ...grpc.CallOption) (*CaptchaOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptchaOut)
+	err := c.cc.Invoke(ctx, Captcha_GenerateCaptcha_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaptchaServer is the server API for Captcha service.
+// All implementations must embed UnimplementedCaptchaServer
+// for forward compatibility.
+type CaptchaServer interface {
+	GenerateCaptcha(context.Context, *GenerateCaptchaRequest) (*CaptchaOut, error)
+	mustEmbedUnimplementedCaptchaServer()
+}
+
+// UnimplementedCaptchaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaptchaServer struct{}
+
+func (UnimplementedCaptchaServer) GenerateCaptcha(context.Context, *GenerateCaptchaRequest) (*CaptchaOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateCaptcha not implemented")
+}
+func (UnimplementedCaptchaServer) mustEmbedUnimplementedCaptchaServer() {}
+func (UnimplementedCaptchaServer) testEmbeddedByValue()                 {}
+
+// UnsafeCaptchaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaptchaServer will
+// result in compilation errors.
+type UnsafeCaptchaServer interface {
+	mustEmbedUnimplementedCaptchaServer()
+}
+
+func RegisterCaptchaServer(s grpc.ServiceRegistrar, srv CaptchaServer) {
+	// If the following call pancis, it indicates UnimplementedCaptchaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Captcha_ServiceDesc, srv)
+}
+
+func _Captcha_GenerateCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateCaptchaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaptchaServer).GenerateCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Captcha_GenerateCaptcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaptchaServer).GenerateCaptcha(ctx, req.(*GenerateCaptchaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Captcha_ServiceDesc is the grpc.ServiceDesc for Captcha service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Captcha_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "customer.Captcha",
+	HandlerType: (*CaptchaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateCaptcha",
+			Handler:    _Captcha_GenerateCaptcha_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/customer/rpc/customer.proto",
+}
+
+const (
+	Tenant_CreateTenant_FullMethodName = "/customer.Tenant/CreateTenant"
+	Tenant_UpdateTenant_FullMethodName = "/customer.Tenant/UpdateTenant"
+	Tenant_DeleteTenant_FullMethodName = "/customer.Tenant/DeleteTenant"
+	Tenant_GetTenant_FullMethodName    = "/customer.Tenant/GetTenant"
+	Tenant_ListTenant_FullMethodName   = "/customer.Tenant/ListTenant"
+)
+
+// TenantClient is the client API for Tenant service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TenantClient interface {
+	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*TenantOut, error)
+	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*TenantOut, error)
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteOut, error)
+	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*TenantOut, error)
+	ListTenant(ctx context.Context, in *ListTenantRequest, opts ...grpc.CallOption) (*PagTenantOut, error)
+}
+
+type tenantClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantClient(cc grpc.ClientConnInterface) TenantClient {
+	return &tenantClient{cc}
+}
+
+func (c *tenantClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*TenantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantOut)
+	err := c.cc.Invoke(ctx, Tenant_CreateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*TenantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantOut)
+	err := c.cc.Invoke(ctx, Tenant_UpdateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOut)
+	err := c.cc.Invoke(ctx, Tenant_DeleteTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*TenantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantOut)
+	err := c.cc.Invoke(ctx, Tenant_GetTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantClient) ListTenant(ctx context.Context, in *ListTenantRequest, opts ...grpc.CallOption) (*PagTenantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PagTenantOut)
+	err := c.cc.Invoke(ctx, Tenant_ListTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantServer is the server API for Tenant service.
+// All implementations must embed UnimplementedTenantServer
+// for forward compatibility.
+type TenantServer interface {
+	CreateTenant(context.Context, *CreateTenantRequest) (*TenantOut, error)
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*TenantOut, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteOut, error)
+	GetTenant(context.Context, *GetTenantRequest) (*TenantOut, error)
+	ListTenant(context.Context, *ListTenantRequest) (*PagTenantOut, error)
+	mustEmbedUnimplementedTenantServer()
+}
+
+// UnimplementedTenantServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantServer struct{}
+
+func (UnimplementedTenantServer) CreateTenant(context.Context, *CreateTenantRequest) (*TenantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedTenantServer) UpdateTenant(context.Context, *UpdateTenantRequest) (*TenantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedTenantServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedTenantServer) GetTenant(context.Context, *GetTenantRequest) (*TenantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedTenantServer) ListTenant(context.Context, *ListTenantRequest) (*PagTenantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenant not implemented")
+}
+func (UnimplementedTenantServer) mustEmbedUnimplementedTenantServer() {}
+func (UnimplementedTenantServer) testEmbeddedByValue()                {}
+
+// UnsafeTenantServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantServer will
+// result in compilation errors.
+type UnsafeTenantServer interface {
+	mustEmbedUnimplementedTenantServer()
+}
+
+func RegisterTenantServer(s grpc.ServiceRegistrar, srv TenantServer) {
+	// If the following call pancis, it indicates UnimplementedTenantServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Tenant_ServiceDesc, srv)
+}
+
+func _Tenant_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_UpdateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).UpdateTenant(ctx, req.(*UpdateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenant_ListTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServer).ListTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenant_ListTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServer).ListTenant(ctx, req.(*ListTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Tenant_ServiceDesc is the grpc.ServiceDesc for Tenant service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Tenant_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "customer.Tenant",
+	HandlerType: (*TenantServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTenant",
+			Handler:    _Tenant_CreateTenant_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _Tenant_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _Tenant_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "GetTenant",
+			Handler:    _Tenant_GetTenant_Handler,
+		},
+		{
+			MethodName: "ListTenant",
+			Handler:    _Tenant_ListTenant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/customer/rpc/customer.proto",
+}
+
+const (
+	Dept_CreateDept_FullMethodName = "/customer.Dept/CreateDept"
+	Dept_UpdateDept_FullMethodName = "/customer.Dept/UpdateDept"
+	Dept_DeleteDept_FullMethodName = "/customer.Dept/DeleteDept"
+	Dept_GetDept_FullMethodName    = "/customer.Dept/GetDept"
+	Dept_ListDept_FullMethodName   = "/customer.Dept/ListDept"
+)
+
+// DeptClient is the client API for Dept service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeptClient interface {
+	CreateDept(ctx context.Context, in *CreateDeptRequest, opts ...grpc.CallOption) (*DeptOut, error)
+	UpdateDept(ctx context.Context, in *UpdateDeptRequest, opts ...grpc.CallOption) (*DeptOut, error)
+	DeleteDept(ctx context.Context, in *DeleteDeptRequest, opts ...grpc.CallOption) (*DeleteOut, error)
+	GetDept(ctx context.Context, in *GetDeptRequest, opts ...grpc.CallOption) (*DeptOut, error)
+	ListDept(ctx context.Context, in *ListDeptRequest, opts ...grpc.CallOption) (*PagDeptOutBase, error)
+}
+
+type deptClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeptClient(cc grpc.ClientConnInterface) DeptClient {
+	return &deptClient{cc}
+}
+
+func (c *deptClient) CreateDept(ctx context.Context, in *CreateDeptRequest, opts ...grpc.CallOption) (*DeptOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeptOut)
+	err := c.cc.Invoke(ctx, Dept_CreateDept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deptClient) UpdateDept(ctx context.Context, in *UpdateDeptRequest, opts ...grpc.CallOption) (*DeptOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeptOut)
+	err := c.cc.Invoke(ctx, Dept_UpdateDept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deptClient) DeleteDept(ctx context.Context, in *DeleteDeptRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOut)
+	err := c.cc.Invoke(ctx, Dept_DeleteDept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deptClient) GetDept(ctx context.Context, in *GetDeptRequest, opts ...grpc.CallOption) (*DeptOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeptOut)
+	err := c.cc.Invoke(ctx, Dept_GetDept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deptClient) ListDept(ctx context.Context, in *ListDeptRequest, opts ...grpc.CallOption) (*PagDeptOutBase, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PagDeptOutBase)
+	err := c.cc.Invoke(ctx, Dept_ListDept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeptServer is the server API for Dept service.
+// All implementations must embed UnimplementedDeptServer
+// for forward compatibility.
+type DeptServer interface {
+	CreateDept(context.Context, *CreateDeptRequest) (*DeptOut, error)
+	UpdateDept(context.Context, *UpdateDeptRequest) (*DeptOut, error)
+	DeleteDept(context.Context, *DeleteDeptRequest) (*DeleteOut, error)
+	GetDept(context.Context, *GetDeptRequest) (*DeptOut, error)
+	ListDept(context.Context, *ListDeptRequest) (*PagDeptOutBase, error)
+	mustEmbedUnimplementedDeptServer()
+}
+
+// UnimplementedDeptServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeptServer struct{}
+
+func (UnimplementedDeptServer) CreateDept(context.Context, *CreateDeptRequest) (*DeptOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDept not implemented")
+}
+func (UnimplementedDeptServer) UpdateDept(context.Context, *UpdateDeptRequest) (*DeptOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDept not implemented")
+}
+func (UnimplementedDeptServer) DeleteDept(context.Context, *DeleteDeptRequest) (*DeleteOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDept not implemented")
+}
+func (UnimplementedDeptServer) GetDept(context.Context, *GetDeptRequest) (*DeptOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDept not implemented")
+}
+func (UnimplementedDeptServer) ListDept(context.Context, *ListDeptRequest) (*PagDeptOutBase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDept not implemented")
+}
+func (UnimplementedDeptServer) mustEmbedUnimplementedDeptServer() {}
+func (UnimplementedDeptServer) testEmbeddedByValue()              {}
+
+// UnsafeDeptServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeptServer will
+// result in compilation errors.
+type UnsafeDeptServer interface {
+	mustEmbedUnimplementedDeptServer()
+}
+
+func RegisterDeptServer(s grpc.ServiceRegistrar, srv DeptServer) {
+	// If the following call pancis, it indicates UnimplementedDeptServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Dept_ServiceDesc, srv)
+}
+
+func _Dept_CreateDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServer).CreateDept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dept_CreateDept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServer).CreateDept(ctx, req.(*CreateDeptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dept_UpdateDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServer).UpdateDept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dept_UpdateDept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServer).UpdateDept(ctx, req.(*UpdateDeptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dept_DeleteDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServer).DeleteDept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dept_DeleteDept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServer).DeleteDept(ctx, req.(*DeleteDeptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dept_GetDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServer).GetDept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dept_GetDept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServer).GetDept(ctx, req.(*GetDeptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dept_ListDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServer).ListDept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dept_ListDept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServer).ListDept(ctx, req.(*ListDeptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Dept_ServiceDesc is the grpc.ServiceDesc for Dept service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Dept_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "customer.Dept",
+	HandlerType: (*DeptServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDept",
+			Handler:    _Dept_CreateDept_Handler,
+		},
+		{
+			MethodName: "UpdateDept",
+			Handler:    _Dept_UpdateDept_Handler,
+		},
+		{
+			MethodName: "DeleteDept",
+			Handler:    _Dept_DeleteDept_Handler,
+		},
+		{
+			MethodName: "GetDept",
+			Handler:    _Dept_GetDept_Handler,
+		},
+		{
+			MethodName: "ListDept",
+			Handler:    _Dept_ListDept_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/customer/rpc/customer.proto",
+}
+
+const (
+	Grant_CreateUserRoleGrant_FullMethodName       = "/customer.Grant/CreateUserRoleGrant"
+	Grant_DeleteUserRoleGrant_FullMethodName       = "/customer.Grant/DeleteUserRoleGrant"
+	Grant_ListUserRoleGrant_FullMethodName         = "/customer.Grant/ListUserRoleGrant"
+	Grant_CreateRolePermissionGrant_FullMethodName = "/customer.Grant/CreateRolePermissionGrant"
+	Grant_DeleteRolePermissionGrant_FullMethodName = "/customer.Grant/DeleteRolePermissionGrant"
+	Grant_ListRolePermissionGrant_FullMethodName   = "/customer.Grant/ListRolePermissionGrant"
+)
+
+// GrantClient is the client API for Grant service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GrantClient interface {
+	CreateUserRoleGrant(ctx context.Context, in *CreateUserRoleGrantRequest, opts ...grpc.CallOption) (*UserRoleGrantOut, error)
+	DeleteUserRoleGrant(ctx context.Context, in *DeleteGrantRequest, opts ...grpc.CallOption) (*DeleteOut, error)
+	ListUserRoleGrant(ctx context.Context, in *ListUserRoleGrantRequest, opts ...grpc.CallOption) (*PagUserRoleGrantOut, error)
+	CreateRolePermissionGrant(ctx context.Context, in *CreateRolePermissionGrantRequest, opts ...grpc.CallOption) (*RolePermissionGrantOut, error)
+	DeleteRolePermissionGrant(ctx context.Context, in *DeleteGrantRequest, opts ...grpc.CallOption) (*DeleteOut, error)
+	ListRolePermissionGrant(ctx context.Context, in *ListRolePermissionGrantRequest, opts ...grpc.CallOption) (*PagRolePermissionGrantOut, error)
+}
+
+type grantClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGrantClient(cc grpc.ClientConnInterface) GrantClient {
+	return &grantClient{cc}
+}
+
+func (c *grantClient) CreateUserRoleGrant(ctx context.Context, in *CreateUserRoleGrantRequest, opts ...grpc.CallOption) (*UserRoleGrantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRoleGrantOut)
+	err := c.cc.Invoke(ctx, Grant_CreateUserRoleGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grantClient) DeleteUserRoleGrant(ctx context.Context, in *DeleteGrantRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOut)
+	err := c.cc.Invoke(ctx, Grant_DeleteUserRoleGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grantClient) ListUserRoleGrant(ctx context.Context, in *ListUserRoleGrantRequest, opts ...grpc.CallOption) (*PagUserRoleGrantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PagUserRoleGrantOut)
+	err := c.cc.Invoke(ctx, Grant_ListUserRoleGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grantClient) CreateRolePermissionGrant(ctx context.Context, in *CreateRolePermissionGrantRequest, opts ...grpc.CallOption) (*RolePermissionGrantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolePermissionGrantOut)
+	err := c.cc.Invoke(ctx, Grant_CreateRolePermissionGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grantClient) DeleteRolePermissionGrant(ctx context.Context, in *DeleteGrantRequest, opts ...grpc.CallOption) (*DeleteOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOut)
+	err := c.cc.Invoke(ctx, Grant_DeleteRolePermissionGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grantClient) ListRolePermissionGrant(ctx context.Context, in *ListRolePermissionGrantRequest, opts ...grpc.CallOption) (*PagRolePermissionGrantOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PagRolePermissionGrantOut)
+	err := c.cc.Invoke(ctx, Grant_ListRolePermissionGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GrantServer is the server API for Grant service.
+// All implementations must embed UnimplementedGrantServer
+// for forward compatibility.
+type GrantServer interface {
+	CreateUserRoleGrant(context.Context, *CreateUserRoleGrantRequest) (*UserRoleGrantOut, error)
+	DeleteUserRoleGrant(context.Context, *DeleteGrantRequest) (*DeleteOut, error)
+	ListUserRoleGrant(context.Context, *ListUserRoleGrantRequest) (*PagUserRoleGrantOut, error)
+	CreateRolePermissionGrant(context.Context, *CreateRolePermissionGrantRequest) (*RolePermissionGrantOut, error)
+	DeleteRolePermissionGrant(context.Context, *DeleteGrantRequest) (*DeleteOut, error)
+	ListRolePermissionGrant(context.Context, *ListRolePermissionGrantRequest) (*PagRolePermissionGrantOut, error)
+	mustEmbedUnimplementedGrantServer()
+}
+
+// UnimplementedGrantServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGrantServer struct{}
+
+func (UnimplementedGrantServer) CreateUserRoleGrant(context.Context, *CreateUserRoleGrantRequest) (*UserRoleGrantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserRoleGrant not implemented")
+}
+func (UnimplementedGrantServer) DeleteUserRoleGrant(context.Context, *DeleteGrantRequest) (*DeleteOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserRoleGrant not implemented")
+}
+func (UnimplementedGrantServer) ListUserRoleGrant(context.Context, *ListUserRoleGrantRequest) (*PagUserRoleGrantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserRoleGrant not implemented")
+}
+func (UnimplementedGrantServer) CreateRolePermissionGrant(context.Context, *CreateRolePermissionGrantRequest) (*RolePermissionGrantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRolePermissionGrant not implemented")
+}
+func (UnimplementedGrantServer) DeleteRolePermissionGrant(context.Context, *DeleteGrantRequest) (*DeleteOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRolePermissionGrant not implemented")
+}
+func (UnimplementedGrantServer) ListRolePermissionGrant(context.Context, *ListRolePermissionGrantRequest) (*PagRolePermissionGrantOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRolePermissionGrant not implemented")
+}
+func (UnimplementedGrantServer) mustEmbedUnimplementedGrantServer() {}
+func (UnimplementedGrantServer) testEmbeddedByValue()               {}
+
+// UnsafeGrantServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GrantServer will
+// result in compilation errors.
+type UnsafeGrantServer interface {
+	mustEmbedUnimplementedGrantServer()
+}
+
+func RegisterGrantServer(s grpc.ServiceRegistrar, srv GrantServer) {
+	// If the following call pancis, it indicates UnimplementedGrantServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Grant_ServiceDesc, srv)
+}
+
+func _Grant_CreateUserRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRoleGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrantServer).CreateUserRoleGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grant_CreateUserRoleGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrantServer).CreateUserRoleGrant(ctx, req.(*CreateUserRoleGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Grant_DeleteUserRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrantServer).DeleteUserRoleGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grant_DeleteUserRoleGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrantServer).DeleteUserRoleGrant(ctx, req.(*DeleteGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Grant_ListUserRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRoleGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrantServer).ListUserRoleGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grant_ListUserRoleGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrantServer).ListUserRoleGrant(ctx, req.(*ListUserRoleGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Grant_CreateRolePermissionGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRolePermissionGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrantServer).CreateRolePermissionGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grant_CreateRolePermissionGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrantServer).CreateRolePermissionGrant(ctx, req.(*CreateRolePermissionGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Grant_DeleteRolePermissionGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrantServer).DeleteRolePermissionGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grant_DeleteRolePermissionGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrantServer).DeleteRolePermissionGrant(ctx, req.(*DeleteGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Grant_ListRolePermissionGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolePermissionGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrantServer).ListRolePermissionGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grant_ListRolePermissionGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrantServer).ListRolePermissionGrant(ctx, req.(*ListRolePermissionGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Grant_ServiceDesc is the grpc.ServiceDesc for Grant service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Grant_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "customer.Grant",
+	HandlerType: (*GrantServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUserRoleGrant",
+			Handler:    _Grant_CreateUserRoleGrant_Handler,
+		},
+		{
+			MethodName: "DeleteUserRoleGrant",
+			Handler:    _Grant_DeleteUserRoleGrant_Handler,
+		},
+		{
+			MethodName: "ListUserRoleGrant",
+			Handler:    _Grant_ListUserRoleGrant_Handler,
+		},
+		{
+			MethodName: "CreateRolePermissionGrant",
+			Handler:    _Grant_CreateRolePermissionGrant_Handler,
+		},
+		{
+			MethodName: "DeleteRolePermissionGrant",
+			Handler:    _Grant_DeleteRolePermissionGrant_Handler,
+		},
+		{
+			MethodName: "ListRolePermissionGrant",
+			Handler:    _Grant_ListRolePermissionGrant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/customer/rpc/customer.proto",
+}
+
+const (
+	Auth_Introspect_FullMethodName = "/customer.Auth/Introspect"
+	Auth_Check_FullMethodName      = "/customer.Auth/Check"
+	Auth_CheckMany_FullMethodName  = "/customer.Auth/CheckMany"
+)
+
+// AuthClient is the client API for Auth service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 供其他服务校验令牌和鉴权，调用方无需持有JWT密钥和casbin策略
+type AuthClient interface {
+	Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectOut, error)
+	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckOut, error)
+	CheckMany(ctx context.Context, in *CheckManyRequest, opts ...grpc.CallOption) (*CheckManyOut, error)
+}
+
+type authClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthClient(cc grpc.ClientConnInterface) AuthClient {
+	return &authClient{cc}
+}
+
+func (c *authClient) Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntrospectOut)
+	err := c.cc.Invoke(ctx, Auth_Introspect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckOut)
+	err := c.cc.Invoke(ctx, Auth_Check_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CheckMany(ctx context.Context, in *CheckManyRequest, opts ...grpc.CallOption) (*CheckManyOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckManyOut)
+	err := c.cc.Invoke(ctx, Auth_CheckMany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServer is the server API for Auth service.
+// All implementations must embed UnimplementedAuthServer
+// for forward compatibility.
+//
+// 供其他服务校验令牌和鉴权，调用方无需持有JWT密钥和casbin策略
+type AuthServer interface {
+	Introspect(context.Context, *IntrospectRequest) (*IntrospectOut, error)
+	Check(context.Context, *CheckRequest) (*CheckOut, error)
+	CheckMany(context.Context, *CheckManyRequest) (*CheckManyOut, error)
+	mustEmbedUnimplementedAuthServer()
+}
+
+// UnimplementedAuthServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServer struct{}
+
+func (UnimplementedAuthServer) Introspect(context.Context, *IntrospectRequest) (*IntrospectOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Introspect not implemented")
+}
+func (UnimplementedAuthServer) Check(context.Context, *CheckRequest) (*CheckOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedAuthServer) CheckMany(context.Context, *CheckManyRequest) (*CheckManyOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMany not implemented")
+}
+func (UnimplementedAuthServer) mustEmbedUnimplementedAuthServer() {}
+func (UnimplementedAuthServer) testEmbeddedByValue()              {}
+
+// UnsafeAuthServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServer will
+// result in compilation errors.
+type UnsafeAuthServer interface {
+	mustEmbedUnimplementedAuthServer()
+}
+
+func RegisterAuthServer(s grpc.ServiceRegistrar, srv AuthServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Auth_ServiceDesc, srv)
+}
+
+func _Auth_Introspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Introspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_Introspect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Introspect(ctx, req.(*IntrospectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Check(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CheckMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CheckMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CheckMany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CheckMany(ctx, req.(*CheckManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Auth_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "customer.Auth",
+	HandlerType: (*AuthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Introspect",
+			Handler:    _Auth_Introspect_Handler,
+		},
+		{
+			MethodName: "Check",
+			Handler:    _Auth_Check_Handler,
+		},
+		{
+			MethodName: "CheckMany",
+			Handler:    _Auth_CheckMany_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/customer/rpc/customer.proto",
+}
+
+const (
+	Rbac_ExportRbac_FullMethodName = "/customer.Rbac/ExportRbac"
+	Rbac_ImportRbac_FullMethodName = "/customer.Rbac/ImportRbac"
+)
+
+// RbacClient is the client API for Rbac service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 以声明式文档导出和导入权限、菜单、按钮和角色，用于初始化新环境
+// 数据之间使用自然键引用：权限为 "请求方法 URL"，菜单、按钮和角色为名称
+type RbacClient interface {
+	ExportRbac(ctx context.Context, in *ExportRbacRequest, opts ...grpc.CallOption) (*RbacDocumentOut, error)
+	ImportRbac(ctx context.Context, in *ImportRbacRequest, opts ...grpc.CallOption) (*ImportRbacOut, error)
+}
+
+type rbacClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRbacClient(cc grpc.ClientConnInterface) RbacClient {
+	return &rbacClient{cc}
+}
+
+func (c *rbacClient) ExportRbac(ctx context.Context, in *ExportRbacRequest, opts ...grpc.CallOption) (*RbacDocumentOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RbacDocumentOut)
+	err := c.cc.Invoke(ctx, Rbac_ExportRbac_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rbacClient) ImportRbac(ctx context.Context, in *ImportRbacRequest, opts ...grpc.CallOption) (*ImportRbacOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRbacOut)
+	err := c.cc.Invoke(ctx, Rbac_ImportRbac_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RbacServer is the server API for Rbac service.
+// All implementations must embed UnimplementedRbacServer
+// for forward compatibility.
+//
+// 以声明式文档导出和导入权限、菜单、按钮和角色，用于初始化新环境
+// 数据之间使用自然键引用：权限为 "请求方法 URL"，菜单、按钮和角色为名称
+type RbacServer interface {
+	ExportRbac(context.Context, *ExportRbacRequest) (*RbacDocumentOut, error)
+	ImportRbac(context.Context, *ImportRbacRequest) (*ImportRbacOut, error)
+	mustEmbedUnimplementedRbacServer()
+}
+
+// UnimplementedRbacServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRbacServer struct{}
+
+func (UnimplementedRbacServer) ExportRbac(context.Context, *ExportRbacRequest) (*RbacDocumentOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRbac not implemented")
+}
+func (UnimplementedRbacServer) ImportRbac(context.Context, *ImportRbacRequest) (*ImportRbacOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRbac not implemented")
+}
+func (UnimplementedRbacServer) mustEmbedUnimplementedRbacServer() {}
+func (UnimplementedRbacServer) testEmbeddedByValue()              {}
+
+// UnsafeRbacServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RbacServer will
+// result in compilation errors.
+type UnsafeRbacServer interface {
+	mustEmbedUnimplementedRbacServer()
+}
+
+func RegisterRbacServer(s grpc.ServiceRegistrar, srv RbacServer) {
+	// If the following call pancis, it indicates UnimplementedRbacServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Rbac_ServiceDesc, srv)
+}
+
+func _Rbac_ExportRbac_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRbacRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RbacServer).ExportRbac(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rbac_ExportRbac_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RbacServer).ExportRbac(ctx, req.(*ExportRbacRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rbac_ImportRbac_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRbacRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RbacServer).ImportRbac(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rbac_ImportRbac_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RbacServer).ImportRbac(ctx, req.(*ImportRbacRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Rbac_ServiceDesc is the grpc.ServiceDesc for Rbac service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Rbac_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "customer.Rbac",
+	HandlerType: (*RbacServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportRbac",
+			Handler:    _Rbac_ExportRbac_Handler,
+		},
+		{
+			MethodName: "ImportRbac",
+			Handler:    _Rbac_ImportRbac_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apps/customer/rpc/customer.proto",
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestEnv 鉴权时请求的上下文属性，用于判断策略的附加条件
+type RequestEnv struct {
+	IP      netip.Addr // 客户端IP，无效值表示未知
+	Time    time.Time  // 请求时间，零值时使用当前时间
+	IsStaff bool       // 是否为工作人员
+}
+
+// Conditions 策略的附加条件，所有已设置的条件都满足时策略才生效
+type Conditions struct {
+	CIDRs        []string `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`                 // 允许的客户端网段，如 10.0.0.0/8
+	Weekdays     []int    `json:"weekdays,omitempty" yaml:"weekdays,omitempty"`           // 允许的星期，0为星期日
+	StartTime    string   `json:"start_time,omitempty" yaml:"start_time,omitempty"`       // 每日开始时间 HH:MM
+	EndTime      string   `json:"end_time,omitempty" yaml:"end_time,omitempty"`           // 每日结束时间 HH:MM，小于开始时间表示跨越零点
+	Timezone     string   `json:"timezone,omitempty" yaml:"timezone,omitempty"`           // 时间条件所在时区，为空时使用服务器时区
+	RequireStaff bool     `json:"require_staff,omitempty" yaml:"require_staff,omitempty"` // 仅工作人员可用
+}
+
+// IsZero 判断是否未设置任何条件
+func (c Conditions) IsZero() bool {
+	return len(c.CIDRs) == 0 && len(c.Weekdays) == 0 &&
+		c.StartTime == "" && c.EndTime == "" && !c.RequireStaff
+}
+
+// Validate 校验条件的格式
+func (c Conditions) Validate() error {
+	for _, cidr := range c.CIDRs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return fmt.Errorf("invalid cidr %q: %w", cidr, err)
+		}
+	}
+	for _, d := range c.Weekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("invalid weekday %d: must be between 0 and 6", d)
+		}
+	}
+	if (c.StartTime == "") != (c.EndTime == "") {
+		return fmt.Errorf("start_time and end_time must be set together")
+	}
+	if c.StartTime != "" {
+		if _, err := parseClock(c.StartTime); err != nil {
+			return err
+		}
+		if _, err := parseClock(c.EndTime); err != nil {
+			return err
+		}
+	}
+	if c.Timezone != "" {
+		if _, err := time.LoadLocation(c.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", c.Timezone, err)
+		}
+	}
+	return nil
+}
+
+// Match 判断请求是否满足条件
+func (c Conditions) Match(env *RequestEnv) bool {
+	if env == nil {
+		env = &RequestEnv{}
+	}
+	if c.RequireStaff && !env.IsStaff {
+		return false
+	}
+	if len(c.CIDRs) > 0 {
+		if !env.IP.IsValid() {
+			return false
+		}
+		ip := env.IP.Unmap()
+		if !slices.ContainsFunc(c.CIDRs, func(cidr string) bool {
+			prefix, err := netip.ParsePrefix(cidr)
+			return err == nil && prefix.Contains(ip)
+		}) {
+			return false
+		}
+	}
+	if len(c.Weekdays) == 0 && c.StartTime == "" {
+		return true
+	}
+	now := env.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if c.Timezone != "" {
+		loc, err := time.LoadLocation(c.Timezone)
+		if err != nil {
+			return false
+		}
+		now = now.In(loc)
+	}
+	if len(c.Weekdays) > 0 && !slices.Contains(c.Weekdays, int(now.Weekday())) {
+		return false
+	}
+	if c.StartTime != "" {
+		start, err1 := parseClock(c.StartTime)
+		end, err2 := parseClock(c.EndTime)
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		cur := now.Hour()*60 + now.Minute()
+		if start <= end {
+			return cur >= start && cur < end
+		}
+		// 跨越零点的时间段
+		return cur >= start || cur < end
+	}
+	return true
+}
+
+// parseClock 解析 HH:MM 格式的时间，返回当天的分钟数
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: must be HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// EncodeConditions 将条件序列化后保存到业务表，未设置任何条件时为空字符串
+func EncodeConditions(c Conditions) (string, error) {
+	if c.IsZero() {
+		return "", nil
+	}
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DecodeConditions 解析业务表中保存的条件，空字符串表示未设置条件
+func DecodeConditions(s string) (Conditions, error) {
+	var c Conditions
+	if s == "" {
+		return c, nil
+	}
+	err := json.Unmarshal([]byte(s), &c)
+	return c, err
+}
+
+// JoinConditions 将多个业务表中保存的条件合并为 p 规则的 cond 字段，所有条件都满足时规则才生效
+func JoinConditions(encoded ...string) string {
+	parts := make([]string, 0, len(encoded))
+	for _, s := range encoded {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+// ruleConditions 解析后的 p 规则 cond 字段缓存
+var ruleConditions sync.Map
+
+// CondMatch 判断请求是否满足 p 规则 cond 字段中的全部条件，无法解析的条件视为不满足
+func CondMatch(env *RequestEnv, cond string) bool {
+	if cond == "" {
+		return true
+	}
+	var cs []Conditions
+	if v, ok := ruleConditions.Load(cond); ok {
+		cs = v.([]Conditions)
+	} else {
+		if err := json.Unmarshal([]byte(cond), &cs); err != nil {
+			return false
+		}
+		ruleConditions.Store(cond, cs)
+	}
+	for _, c := range cs {
+		if !c.Match(env) {
+			return false
+		}
+	}
+	return true
+}
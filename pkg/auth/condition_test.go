@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestConditionsMatch(t *testing.T) {
+	// 2024-01-01 为星期一
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	office := netip.MustParseAddr("10.1.2.3")
+	home := netip.MustParseAddr("192.168.1.1")
+	cases := []struct {
+		name string
+		cond Conditions
+		env  *RequestEnv
+		want bool
+	}{
+		{"zero conditions", Conditions{}, nil, true},
+		{"cidr match", Conditions{CIDRs: []string{"10.0.0.0/8"}}, &RequestEnv{IP: office}, true},
+		{"cidr mismatch", Conditions{CIDRs: []string{"10.0.0.0/8"}}, &RequestEnv{IP: home}, false},
+		{"cidr unknown ip", Conditions{CIDRs: []string{"10.0.0.0/8"}}, &RequestEnv{}, false},
+		{"cidr ipv4-mapped", Conditions{CIDRs: []string{"10.0.0.0/8"}}, &RequestEnv{IP: netip.MustParseAddr("::ffff:10.1.2.3")}, true},
+		{"require staff", Conditions{RequireStaff: true}, &RequestEnv{IsStaff: true}, true},
+		{"require staff denied", Conditions{RequireStaff: true}, &RequestEnv{}, false},
+		{"weekday match", Conditions{Weekdays: []int{1, 2}}, &RequestEnv{Time: monday(9, 0)}, true},
+		{"weekday mismatch", Conditions{Weekdays: []int{0, 6}}, &RequestEnv{Time: monday(9, 0)}, false},
+		{"within hours", Conditions{StartTime: "09:00", EndTime: "18:00"}, &RequestEnv{Time: monday(9, 0)}, true},
+		{"end is exclusive", Conditions{StartTime: "09:00", EndTime: "18:00"}, &RequestEnv{Time: monday(18, 0)}, false},
+		{"overnight late", Conditions{StartTime: "22:00", EndTime: "06:00"}, &RequestEnv{Time: monday(23, 30)}, true},
+		{"overnight early", Conditions{StartTime: "22:00", EndTime: "06:00"}, &RequestEnv{Time: monday(5, 59)}, true},
+		{"overnight midday", Conditions{StartTime: "22:00", EndTime: "06:00"}, &RequestEnv{Time: monday(12, 0)}, false},
+		{
+			"timezone shifts clock",
+			Conditions{StartTime: "09:00", EndTime: "18:00", Timezone: "Asia/Shanghai"},
+			&RequestEnv{Time: monday(2, 0)},
+			true,
+		},
+		{"invalid timezone", Conditions{Weekdays: []int{1}, Timezone: "Mars/Base"}, &RequestEnv{Time: monday(9, 0)}, false},
+		{
+			"all conditions must hold",
+			Conditions{CIDRs: []string{"10.0.0.0/8"}, RequireStaff: true},
+			&RequestEnv{IP: office},
+			false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.cond.Match(c.env); got != c.want {
+				t.Fatalf("Match = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConditionsValidate(t *testing.T) {
+	cases := []struct {
+		name string
+		cond Conditions
+		ok   bool
+	}{
+		{"valid", Conditions{CIDRs: []string{"10.0.0.0/8"}, Weekdays: []int{0, 6}, StartTime: "09:00", EndTime: "18:00"}, true},
+		{"invalid cidr", Conditions{CIDRs: []string{"10.0.0.0"}}, false},
+		{"invalid weekday", Conditions{Weekdays: []int{7}}, false},
+		{"start without end", Conditions{StartTime: "09:00"}, false},
+		{"invalid clock", Conditions{StartTime: "9am", EndTime: "18:00"}, false},
+		{"invalid timezone", Conditions{Timezone: "Mars/Base"}, false},
+	}
+	for _, c := range cases {
+		if err := c.cond.Validate(); (err == nil) != c.ok {
+			t.Errorf("%s: Validate = %v, want ok=%v", c.name, err, c.ok)
+		}
+	}
+}
+
+func TestEncodeDecodeConditions(t *testing.T) {
+	if s, err := EncodeConditions(Conditions{}); err != nil || s != "" {
+		t.Fatalf("EncodeConditions(zero) = %q, %v", s, err)
+	}
+	if _, err := EncodeConditions(Conditions{Weekdays: []int{9}}); err == nil {
+		t.Fatal("EncodeConditions accepted an invalid weekday")
+	}
+	in := Conditions{CIDRs: []string{"10.0.0.0/8"}, RequireStaff: true}
+	s, err := EncodeConditions(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := DecodeConditions(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.CIDRs) != 1 || out.CIDRs[0] != "10.0.0.0/8" || !out.RequireStaff {
+		t.Fatalf("round trip = %+v, want %+v", out, in)
+	}
+}
+
+func TestCondMatch(t *testing.T) {
+	cidr, _ := EncodeConditions(Conditions{CIDRs: []string{"10.0.0.0/8"}})
+	staff, _ := EncodeConditions(Conditions{RequireStaff: true})
+	office := netip.MustParseAddr("10.1.2.3")
+	cases := []struct {
+		name string
+		cond string
+		env  *RequestEnv
+		want bool
+	}{
+		{"no condition", "", nil, true},
+		{"no condition joined", JoinConditions("", ""), nil, true},
+		{"single satisfied", JoinConditions(cidr), &RequestEnv{IP: office}, true},
+		{"single failed", JoinConditions(cidr), &RequestEnv{IP: netip.MustParseAddr("192.168.1.1")}, false},
+		{"joined all satisfied", JoinConditions(cidr, "", staff), &RequestEnv{IP: office, IsStaff: true}, true},
+		{"joined one failed", JoinConditions(cidr, staff), &RequestEnv{IP: office}, false},
+		{"unparsable", "{not json", &RequestEnv{IP: office}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// 第二次调用命中解析缓存，结果应一致
+			for range 2 {
+				if got := CondMatch(c.env, c.cond); got != c.want {
+					t.Fatalf("CondMatch(%q) = %v, want %v", c.cond, got, c.want)
+				}
+			}
+		})
+	}
+}
@@ -22,3 +22,20 @@ func GetUserClaims(ctx context.Context) (*UserClaims, error) {
 func SetUserClaims(ctx context.Context, uc *UserClaims) context.Context {
 	return context.WithValue(ctx, ctxUserClaimsKey, uc)
 }
+
+// TenantFromContext 返回调用方需要被限定的租户
+// 上下文中没有用户身份（内部调用）或调用方为平台工作人员时返回false，表示不限定租户
+func TenantFromContext(ctx context.Context) (uint32, bool) {
+	uc, err := GetUserClaims(ctx)
+	if err != nil || uc.IsPlatformStaff() {
+		return 0, false
+	}
+	return uc.TenantId, true
+}
+
+// CanWriteTenant 判断调用方能否修改属于指定租户的数据
+// 租户调用方只能修改本租户的数据，平台级数据对其只读
+func CanWriteTenant(ctx context.Context, tenantId uint32) bool {
+	tid, ok := TenantFromContext(ctx)
+	return !ok || tid == tenantId
+}
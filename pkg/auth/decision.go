@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// maxDecisions 缓存的鉴权结果数量上限，超过后清空重新缓存
+const maxDecisions = 100000
+
+// decisionCache 缓存鉴权结果，键为 (sub, dom, obj, act)，存在带条件的策略时还包含请求属性
+// 策略的任何修改都会使整个缓存失效，代次用于丢弃失效前计算、失效后才写入的结果
+type decisionCache struct {
+	mutex     sync.RWMutex    // 用于并发访问的读写锁
+	decisions map[string]bool // 请求到鉴权结果的映射
+	gen       atomic.Uint64   // 缓存代次，每次失效时递增
+}
+
+func newDecisionCache() *decisionCache {
+	return &decisionCache{
+		decisions: make(map[string]bool),
+	}
+}
+
+// decisionKey 拼接缓存键，各字段之间使用不会出现在请求中的分隔符
+func decisionKey(fields ...string) string {
+	return strings.Join(fields, "\x00")
+}
+
+// generation 返回当前缓存代次，应在计算鉴权结果前获取
+func (c *decisionCache) generation() uint64 {
+	return c.gen.Load()
+}
+
+// get 查询缓存的鉴权结果
+func (c *decisionCache) get(key string) (bool, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	ok, found := c.decisions[key]
+	return ok, found
+}
+
+// set 写入鉴权结果，gen与当前代次不一致时说明期间策略已变更，结果被丢弃
+func (c *decisionCache) set(gen uint64, key string, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.gen.Load() != gen {
+		return
+	}
+	if len(c.decisions) >= maxDecisions {
+		c.decisions = make(map[string]bool)
+	}
+	c.decisions[key] = ok
+}
+
+// invalidate 清空全部缓存的鉴权结果
+func (c *decisionCache) invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.gen.Add(1)
+	c.decisions = make(map[string]bool)
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"bufio"
+	"net/http"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MethodGrpc gRPC接口的权限使用的请求方法，URL为完整方法名，如 /customer.User/GetUser
+const MethodGrpc = "GRPC"
+
+// Endpoint 服务对外提供的接口，每个接口对应一条权限
+type Endpoint struct {
+	Url     string
+	Method  string
+	Matcher string // URL匹配方式，带路径参数的REST接口为 MatchKeyMatch2，其余为 MatchExact
+	Label   string // 权限的标签，gRPC接口为服务名
+	Descr   string // 权限的描述，取自接口的注释
+}
+
+// Key 接口的自然键，与权限的自然键一致
+func (e Endpoint) Key() string {
+	return e.Method + " " + e.Url
+}
+
+// GrpcEndpoints 从生成代码中的proto文件描述生成接口列表，按URL排序
+// 不依赖gRPC服务的注册，可以在服务启动前调用；comments 为完整方法名到注释的映射，通常由 ParseProtoComments 生成
+func GrpcEndpoints(files []protoreflect.FileDescriptor, comments map[string]string) []Endpoint {
+	endpoints := make([]Endpoint, 0)
+	for _, fd := range files {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			sd := services.Get(i)
+			name := string(sd.FullName())
+			// 健康检查和反射等框架提供的服务不需要鉴权
+			if strings.HasPrefix(name, "grpc.") {
+				continue
+			}
+			methods := sd.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				url := "/" + name + "/" + string(methods.Get(j).Name())
+				endpoints = append(endpoints, Endpoint{
+					Url:     url,
+					Method:  MethodGrpc,
+					Matcher: MatchExact,
+					Label:   string(sd.Name()),
+					Descr:   comments[url],
+				})
+			}
+		}
+	}
+	slices.SortFunc(endpoints, func(a, b Endpoint) int {
+		return strings.Compare(a.Url, b.Url)
+	})
+	return endpoints
+}
+
+// IsHttpMethod 判断是否为HTTP请求方法，用于区分REST接口和gRPC接口的权限
+func IsHttpMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseProtoComments 解析proto文件中rpc方法的注释，返回完整方法名到注释的映射
+// 优先使用rpc所在行的行尾注释，其次使用紧邻的前置注释
+func ParseProtoComments(src string) map[string]string {
+	comments := make(map[string]string)
+	pkg, service := "", ""
+	leading := make([]string, 0)
+	scanner := bufio.NewScanner(strings.NewReader(src))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		code, comment, _ := strings.Cut(line, "//")
+		code, comment = strings.TrimSpace(code), strings.TrimSpace(comment)
+		if code == "" {
+			if comment != "" {
+				leading = append(leading, comment)
+			} else {
+				leading = leading[:0]
+			}
+			continue
+		}
+		fields := strings.Fields(strings.NewReplacer("(", " ", ";", " ", "{", " ").Replace(code))
+		switch {
+		case len(fields) >= 2 && fields[0] == "package":
+			pkg = fields[1]
+		case len(fields) >= 2 && fields[0] == "service":
+			service = fields[1]
+		case len(fields) >= 2 && fields[0] == "rpc" && service != "":
+			if comment == "" {
+				comment = strings.Join(leading, " ")
+			}
+			name := service
+			if pkg != "" {
+				name = pkg + "." + service
+			}
+			comments["/"+name+"/"+fields[1]] = comment
+		}
+		leading = leading[:0]
+	}
+	return comments
+}
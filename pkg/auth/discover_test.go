@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestParseProtoComments(t *testing.T) {
+	src := `syntax = "proto3";
+package customer;
+
+message Empty {}
+
+service User {
+	// 新增用户
+	rpc CreateUser (Empty) returns (Empty); // 创建
+	// 查询
+	// 用户详情
+	rpc GetCustomer (Empty) returns (Empty);
+
+	// 与rpc之间有空行的注释不使用
+	
+	rpc ListCustomer(Empty) returns (Empty){}
+	rpc Login (Empty) returns (Empty); // 登录
+}
+
+service Dept {
+	rpc GetDept (Empty) returns (Empty); // 查询部门详情
+}
+`
+	want := map[string]string{
+		"/customer.User/CreateUser":   "创建",
+		"/customer.User/GetCustomer":  "查询 用户详情",
+		"/customer.User/ListCustomer": "",
+		"/customer.User/Login":        "登录",
+		"/customer.Dept/GetDept":      "查询部门详情",
+	}
+	got := ParseProtoComments(src)
+	if len(got) != len(want) {
+		t.Fatalf("comments = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if c, ok := got[k]; !ok || c != v {
+			t.Errorf("comments[%q] = %q, want %q", k, c, v)
+		}
+	}
+}
+
+func TestParseProtoCommentsWithoutPackage(t *testing.T) {
+	got := ParseProtoComments("service Ping {\n\trpc Ping (Empty) returns (Empty); // 探活\n}\n")
+	if got["/Ping/Ping"] != "探活" {
+		t.Fatalf("comments = %v", got)
+	}
+}
+
+func testFileDescriptor(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:        proto.String("discover_test.proto"),
+		Package:     proto.String("customer"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Empty")}},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("User"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("Login"), InputType: proto.String(".customer.Empty"), OutputType: proto.String(".customer.Empty")},
+					{Name: proto.String("CreateUser"), InputType: proto.String(".customer.Empty"), OutputType: proto.String(".customer.Empty")},
+				},
+			},
+			{
+				Name: proto.String("Dept"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("GetDept"), InputType: proto.String(".customer.Empty"), OutputType: proto.String(".customer.Empty")},
+				},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+func TestGrpcEndpoints(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		testFileDescriptor(t),
+		grpc_health_v1.File_grpc_health_v1_health_proto,
+	}
+	comments := map[string]string{"/customer.User/Login": "登录"}
+	got := GrpcEndpoints(files, comments)
+	want := []Endpoint{
+		{Url: "/customer.Dept/GetDept", Method: MethodGrpc, Matcher: MatchExact, Label: "Dept"},
+		{Url: "/customer.User/CreateUser", Method: MethodGrpc, Matcher: MatchExact, Label: "User"},
+		{Url: "/customer.User/Login", Method: MethodGrpc, Matcher: MatchExact, Label: "User", Descr: "登录"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("endpoints = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("endpoints[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if got[2].Key() != "GRPC /customer.User/Login" {
+		t.Errorf("Key = %q", got[2].Key())
+	}
+}
+
+func TestIsHttpMethod(t *testing.T) {
+	for method, want := range map[string]bool{"GET": true, "DELETE": true, MethodGrpc: false, "get": false, "": false} {
+		if got := IsHttpMethod(method); got != want {
+			t.Errorf("IsHttpMethod(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
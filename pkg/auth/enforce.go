@@ -3,8 +3,16 @@ package auth
 import (
 	"context"
 	goerrors "errors"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/casbin/casbin/v2/util"
 	"github.com/golang-jwt/jwt/v5"
 
 	"gz-dango/pkg/errors"
@@ -12,8 +20,14 @@ import (
 
 const (
 	SubKey = "sub"
+	DomKey = "dom"
 	ObjKey = "obj"
 	ActKey = "act"
+
+	// PlatformTenantId 平台的租户ID
+	PlatformTenantId uint32 = 0
+	// PlatformDomain 平台级策略所在的域，匹配所有租户域
+	PlatformDomain = "*"
 )
 
 // AuthEnforcer 管理身份验证令牌和授权权限
@@ -25,17 +39,111 @@ type AuthEnforcer struct {
 	// jwt的黑名单缓存
 	blacklist BlacklistManager
 
-	// enforcer 用于访问控制
-	enforcer *casbin.Enforcer
+	// model 不含策略的模型模板，重新加载时基于它构建新的enforcer
+	model model.Model
+
+	// mu 串行化策略的增量修改和重新加载
+	mu sync.Mutex
+
+	// enforcer 用于访问控制，重新加载时整体替换
+	// SyncedEnforcer 内部的读写锁保证增量修改与鉴权之间的并发安全
+	enforcer atomic.Pointer[casbin.SyncedEnforcer]
+
+	// decisions 鉴权结果缓存，策略修改和重新加载时失效
+	decisions *decisionCache
+
+	// conditional 当前策略中是否存在带附加条件的规则，存在时缓存键包含请求属性
+	conditional atomic.Bool
+
+	// pending 本实例发起、等待发布给其他实例的策略修改事件
+	pending []PolicyEvent
+}
+
+// PolicyLoader 从持久化存储中读取全部策略规则
+type PolicyLoader interface {
+	LoadRules(ctx context.Context) (policies [][]string, groupings [][]string, err error)
+}
+
+// ReloadStats 一次策略重新加载的统计信息
+type ReloadStats struct {
+	Duration  time.Duration // 加载耗时
+	Policies  int           // 加载的 p 规则数量
+	Groupings int           // 加载的 g 规则数量
 }
 
 // NewAuthEnforcer 创建一个新的认证缓存实例
+// enforcer 需使用带域的RBAC模型（g = _, _, _），平台域 * 下的继承关系在所有租户域中生效
 // 返回初始化后的AuthCache指针
-func NewAuthEnforcer(enforcer *casbin.Enforcer, key string) *AuthEnforcer {
-	return &AuthEnforcer{
-		enforcer: enforcer,
-		key:      []byte(key),
+func NewAuthEnforcer(enforcer *casbin.Enforcer, key string) (*AuthEnforcer, error) {
+	m := enforcer.GetModel().Copy()
+	m.ClearPolicy()
+	e, err := newSyncedEnforcer(enforcer.GetModel().Copy(), enforcer.GetAdapter())
+	if err != nil {
+		return nil, err
+	}
+	a := &AuthEnforcer{
+		key:       []byte(key),
+		model:     m,
+		decisions: newDecisionCache(),
+	}
+	a.enforcer.Store(e)
+	a.refreshConditional()
+	return a, nil
+}
+
+// newSyncedEnforcer 基于模型（可包含策略）创建并发安全的enforcer
+// 设置域匹配函数和URL、方法匹配函数，并关闭自动保存（策略由业务表维护）
+func newSyncedEnforcer(m model.Model, adapter persist.Adapter) (*casbin.SyncedEnforcer, error) {
+	e, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		return nil, err
+	}
+	if adapter != nil {
+		e.SetAdapter(adapter)
+	}
+	e.AddNamedDomainMatchingFunc("g", "KeyMatch", util.KeyMatch)
+	addMatchFunctions(e)
+	e.EnableAutoSave(false)
+	if err := e.BuildRoleLinks(); err != nil {
+		return nil, err
 	}
+	return e, nil
+}
+
+// Reload 从loader读取全部策略，构建新的enforcer并原子替换当前enforcer
+// 构建期间的鉴权请求继续使用旧的enforcer，不会看到加载了一半的策略
+func (c *AuthEnforcer) Reload(ctx context.Context, loader PolicyLoader) (ReloadStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	start := time.Now()
+	policies, groupings, err := loader.LoadRules(ctx)
+	if err != nil {
+		return ReloadStats{}, err
+	}
+	adapter, _ := loader.(persist.Adapter)
+	e, err := newSyncedEnforcer(c.model.Copy(), adapter)
+	if err != nil {
+		return ReloadStats{}, err
+	}
+	if len(policies) > 0 {
+		if _, err := e.AddPoliciesEx(policies); err != nil {
+			return ReloadStats{}, err
+		}
+	}
+	if len(groupings) > 0 {
+		if _, err := e.AddGroupingPoliciesEx(groupings); err != nil {
+			return ReloadStats{}, err
+		}
+	}
+	c.enforcer.Store(e)
+	c.refreshConditional()
+	c.decisions.invalidate()
+	return ReloadStats{
+		Duration:  time.Since(start),
+		Policies:  len(policies),
+		Groupings: len(groupings),
+	}, nil
 }
 
 // SetBlacklist 设置黑名单缓存
@@ -89,53 +197,168 @@ func (c *AuthEnforcer) Authentication(ctx context.Context, token string) (*UserC
 	return claims, nil
 }
 
-// Authorization 检查特定角色是否具有对某个HTTP方法和URL路径组合的访问权限
-// roleId：请求访问的角色
+// Authorization 检查特定主体在租户域内是否具有对某个HTTP方法和URL路径组合的访问权限
+// sub：请求访问的主体（用户或角色）
+// dom：请求所在的租户域
 // url：请求的目标URL路径
 // method：HTTP请求方法（GET/POST等）
-// 返回是否有访问权限的布尔结果
-func (c *AuthEnforcer) Authorization(role, url, method string) (bool, *errors.Error) {
-	ok, err := c.enforcer.Enforce(role, url, method)
+// env：请求的上下文属性，用于判断策略的附加条件，可以为nil
+// 返回是否有访问权限的布尔结果，结果会被缓存直到策略发生变更
+func (c *AuthEnforcer) Authorization(sub, dom, url, method string, env *RequestEnv) (bool, *errors.Error) {
+	var req RequestEnv
+	if env != nil {
+		req = *env
+	}
+	if req.Time.IsZero() {
+		req.Time = time.Now()
+	}
+	key := decisionKey(sub, dom, url, method)
+	if c.conditional.Load() {
+		// 存在带条件的策略时结果与请求属性有关，时间条件精确到分钟
+		key = decisionKey(
+			key,
+			req.IP.String(),
+			strconv.FormatBool(req.IsStaff),
+			strconv.FormatInt(req.Time.Unix()/60, 10),
+		)
+	}
+	if ok, found := c.decisions.get(key); found {
+		return ok, nil
+	}
+	gen := c.decisions.generation()
+	ok, err := c.enforce(sub, dom, url, method, &req)
+	if err != nil {
+		return false, err
+	}
+	c.decisions.set(gen, key, ok)
+	return ok, nil
+}
+
+// enforce 不经过缓存直接使用当前enforcer鉴权
+func (c *AuthEnforcer) enforce(sub, dom, url, method string, env *RequestEnv) (bool, *errors.Error) {
+	ok, err := c.enforcer.Load().Enforce(sub, dom, url, method, env)
 	if err != nil {
 		return false, errors.FromError(err)
 	}
 	return ok, nil
 }
 
-// AddPolicies 批量添加授权策略规则
-// rules: 要添加的策略规则列表，每个规则是一个字符串切片
-// 返回值: 如果添加成功返回nil，否则返回相应的错误信息
-func (c *AuthEnforcer) AddPolicy(sub, obj, act string) error {
-	if _, err := c.enforcer.AddPolicy(sub, obj, act); err != nil {
-		return err
+// refreshConditional 记录当前策略中是否存在带条件的规则，调用方需持有mu
+func (c *AuthEnforcer) refreshConditional() {
+	rules, err := c.enforcer.Load().GetPolicy()
+	if err != nil {
+		c.conditional.Store(true)
+		return
 	}
-	return nil
+	c.conditional.Store(slices.ContainsFunc(rules, func(rule []string) bool {
+		return len(rule) > 6 && rule[6] != ""
+	}))
 }
 
-// // RemovePolicies 批量移除授权策略规则
-// // rules: 要移除的策略规则列表，每个规则是一个字符串切片
-// // 返回值: 如果移除成功返回nil，否则返回相应的错误信息
-func (c *AuthEnforcer) RemovePolicy(sub, obj, act string) error {
-	if _, err := c.enforcer.RemovePolicy(sub, obj, act); err != nil {
+// mutate 在当前enforcer上应用本实例发起的修改，并记录到待发布的事件中
+func (c *AuthEnforcer) mutate(ev PolicyEvent) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	defer c.decisions.invalidate()
+	defer c.refreshConditional()
+	if err := ev.apply(c.enforcer.Load()); err != nil {
 		return err
 	}
+	c.pending = append(c.pending, ev)
 	return nil
 }
 
+// AddPolicies 批量添加授权策略规则
+// rules: 每条规则为 [sub, dom, obj, act, mt, eft, cond]，可使用 PolicyRule 组装，已存在的规则会被跳过
+// 返回值: 如果添加成功返回nil，否则返回相应的错误信息
+func (c *AuthEnforcer) AddPolicies(rules [][]string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	return c.mutate(PolicyEvent{
+		Op:    PolicyOpAddPolicy,
+		Rules: rules,
+	})
+}
+
+// RemovePolicies 批量移除授权策略规则，不存在的规则会被忽略
+// 返回值: 如果移除成功返回nil，否则返回相应的错误信息
+func (c *AuthEnforcer) RemovePolicies(rules [][]string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	return c.mutate(PolicyEvent{
+		Op:    PolicyOpRemovePolicy,
+		Rules: rules,
+	})
+}
+
+// RemoveFilteredPolicy 移除第index个字段等于value的授权策略规则
+// 返回值: 如果移除成功返回nil，否则返回相应的错误信息
+func (c *AuthEnforcer) RemoveFilteredPolicy(index int, value string) error {
+	return c.mutate(PolicyEvent{
+		Op:          PolicyOpRemoveFilteredPolicy,
+		FieldIndex:  index,
+		FieldValues: []string{value},
+	})
+}
+
+// PolicyRule 组装 p 规则，匹配方式为空时使用完全相等，效果为空时为允许
+// cond 为 JoinConditions 合并的附加条件，为空表示无条件
+func PolicyRule(sub, dom, obj, act, mt, eft, cond string) []string {
+	if mt == "" {
+		mt = MatchExact
+	}
+	if eft == "" {
+		eft = EffectAllow
+	}
+	return []string{sub, dom, obj, act, mt, eft, cond}
+}
+
 // AddGroupPolicies 批量添加用户组策略规则
+// rules: 每条规则为 [sub, obj, dom]，已存在的规则会被跳过
 // 返回值: 如果添加成功返回nil，否则返回相应的错误信息
-func (c *AuthEnforcer) AddGroupPolicy(sub, obj string) error {
-	if _, err := c.enforcer.AddGroupingPolicy(sub, obj); err != nil {
-		return err
+func (c *AuthEnforcer) AddGroupPolicies(rules [][]string) error {
+	if len(rules) == 0 {
+		return nil
 	}
-	return nil
+	return c.mutate(PolicyEvent{
+		Op:    PolicyOpAddGrouping,
+		Rules: rules,
+	})
 }
 
-// RemoveGroupPolicies 批量移除用户组策略规则
+// RemoveGroupPolicy 移除第index个字段等于value的用户组策略规则
 // 返回值: 如果移除成功返回nil，否则返回相应的错误信息
 func (c *AuthEnforcer) RemoveGroupPolicy(index int, value string) error {
-	if _, err := c.enforcer.RemoveFilteredGroupingPolicy(index, value); err != nil {
-		return err
+	return c.mutate(PolicyEvent{
+		Op:          PolicyOpRemoveFilteredGrouping,
+		FieldIndex:  index,
+		FieldValues: []string{value},
+	})
+}
+
+// DrainEvents 取出本实例发起且尚未发布的策略修改事件，事件按应用顺序排列
+func (c *AuthEnforcer) DrainEvents() []PolicyEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	events := c.pending
+	c.pending = nil
+	return events
+}
+
+// ApplyEvents 应用其他实例发布的策略修改事件，应用的事件不会再次发布
+// 返回错误时部分事件可能已经生效，调用方应重新加载全部策略
+func (c *AuthEnforcer) ApplyEvents(events []PolicyEvent) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	defer c.decisions.invalidate()
+	defer c.refreshConditional()
+	e := c.enforcer.Load()
+	for _, ev := range events {
+		if err := ev.apply(e); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+)
+
+// benchLoader 生成与业务表结构相同的策略：用户 -> 角色 -> 菜单 -> 权限
+type benchLoader struct {
+	perms, menus, roles, users int
+}
+
+func (l benchLoader) LoadRules(ctx context.Context) ([][]string, [][]string, error) {
+	policies := make([][]string, 0, l.perms)
+	for i := 0; i < l.perms; i++ {
+		policies = append(policies, PolicyRule(
+			fmt.Sprintf("permission_%d", i), PlatformDomain, fmt.Sprintf("/api/v1/resource/%d", i), "GET", MatchExact, EffectAllow, "",
+		))
+	}
+	groupings := make([][]string, 0)
+	for i := 0; i < l.perms; i++ {
+		groupings = append(groupings, []string{
+			fmt.Sprintf("menu_%d", i%l.menus), fmt.Sprintf("permission_%d", i), PlatformDomain,
+		})
+	}
+	for i := 0; i < l.menus; i++ {
+		groupings = append(groupings, []string{
+			fmt.Sprintf("role_%d", i%l.roles), fmt.Sprintf("menu_%d", i), PlatformDomain,
+		})
+	}
+	for i := 0; i < l.users; i++ {
+		groupings = append(groupings, []string{
+			UserSubject(uint32(i)), fmt.Sprintf("role_%d", i%l.roles), TenantDomain(1),
+		})
+	}
+	return policies, groupings, nil
+}
+
+func newBenchEnforcer(b *testing.B) *AuthEnforcer {
+	b.Helper()
+	m, err := NewModel("")
+	if err != nil {
+		b.Fatal(err)
+	}
+	e, err := casbin.NewEnforcer(m)
+	if err != nil {
+		b.Fatal(err)
+	}
+	a, err := NewAuthEnforcer(e, "bench")
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := a.Reload(context.Background(), benchLoader{perms: 500, menus: 50, roles: 10, users: 1000}); err != nil {
+		b.Fatal(err)
+	}
+	return a
+}
+
+// BenchmarkAuthorizationUncached 每次请求都经过casbin匹配，即引入缓存之前的开销
+func BenchmarkAuthorizationUncached(b *testing.B) {
+	a := newBenchEnforcer(b)
+	sub, dom := UserSubject(7), TenantDomain(1)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := a.enforce(sub, dom, "/api/v1/resource/17", "GET", nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkAuthorization 命中鉴权结果缓存时的开销
+func BenchmarkAuthorization(b *testing.B) {
+	a := newBenchEnforcer(b)
+	sub, dom := UserSubject(7), TenantDomain(1)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := a.Authorization(sub, dom, "/api/v1/resource/17", "GET", nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkAuthorizationParallel 少量活跃用户和接口的并发鉴权，同时有协程每毫秒修改一次策略使缓存失效
+func BenchmarkAuthorizationParallel(b *testing.B) {
+	a := newBenchEnforcer(b)
+	dom := TenantDomain(1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				rule := PolicyRule("permission_bench", PlatformDomain, "/api/v1/bench/:id", "GET", MatchKeyMatch2, EffectDeny, "")
+				_ = a.AddPolicies([][]string{rule})
+				_ = a.RemovePolicies([][]string{rule})
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}()
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			sub := UserSubject(uint32(i % 10))
+			if _, err := a.Authorization(sub, dom, fmt.Sprintf("/api/v1/resource/%d", i%20), "GET", nil); err != nil {
+				b.Fatal(err)
+			}
+			i++
+		}
+	})
+}
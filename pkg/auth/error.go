@@ -67,4 +67,16 @@ var (
 		"发送casbin同步策略信号失败",
 		nil,
 	)
+	ErrTenantReadOnly = errors.New(
+		http.StatusForbidden,
+		"tenant_read_only",
+		"不能修改其他租户或平台的数据",
+		nil,
+	)
+	ErrInvalidConditions = errors.New(
+		http.StatusBadRequest,
+		"invalid_conditions",
+		"附加条件的网段、星期、时间段或时区无效",
+		nil,
+	)
 )
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+)
+
+// 策略变更操作
+const (
+	PolicyOpAddPolicy              = "add_policy"               // 添加 p 规则
+	PolicyOpRemovePolicy           = "remove_policy"            // 移除 p 规则
+	PolicyOpRemoveFilteredPolicy   = "remove_filtered_policy"   // 按字段移除 p 规则
+	PolicyOpAddGrouping            = "add_grouping"             // 添加 g 规则
+	PolicyOpRemoveFilteredGrouping = "remove_filtered_grouping" // 按字段移除 g 规则
+)
+
+// PolicyEvent 一次策略的增量修改，其他实例收到后直接应用到自身的enforcer
+type PolicyEvent struct {
+	Op          string     `json:"op"`
+	Rules       [][]string `json:"rules,omitempty"`
+	FieldIndex  int        `json:"field_index,omitempty"`
+	FieldValues []string   `json:"field_values,omitempty"`
+}
+
+// PolicyChange 实例之间传递的策略变更消息
+// Version 为全局递增的策略版本号，接收方据此发现丢失的消息
+type PolicyChange struct {
+	Version  int64         `json:"version"`
+	Instance string        `json:"instance"`
+	Events   []PolicyEvent `json:"events"`
+}
+
+// apply 将事件应用到enforcer，添加已存在或移除不存在的规则不视为错误
+func (ev PolicyEvent) apply(e *casbin.SyncedEnforcer) error {
+	switch ev.Op {
+	case PolicyOpAddPolicy:
+		_, err := e.AddPoliciesEx(ev.Rules)
+		return err
+	case PolicyOpRemovePolicy:
+		for _, rule := range ev.Rules {
+			if _, err := e.RemovePolicy(rule); err != nil {
+				return err
+			}
+		}
+		return nil
+	case PolicyOpRemoveFilteredPolicy:
+		_, err := e.RemoveFilteredPolicy(ev.FieldIndex, ev.FieldValues...)
+		return err
+	case PolicyOpAddGrouping:
+		_, err := e.AddGroupingPoliciesEx(ev.Rules)
+		return err
+	case PolicyOpRemoveFilteredGrouping:
+		_, err := e.RemoveFilteredGroupingPolicy(ev.FieldIndex, ev.FieldValues...)
+		return err
+	default:
+		return fmt.Errorf("unknown policy event op: %s", ev.Op)
+	}
+}
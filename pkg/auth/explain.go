@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"slices"
+	"time"
+
+	"github.com/casbin/casbin/v2/util"
+)
+
+// maxNearMisses 拒绝访问时最多返回的接近命中的规则数量
+const maxNearMisses = 10
+
+// ExplainRule 一条 p 规则对请求的各项匹配结果
+type ExplainRule struct {
+	Rule          []string // p 规则 [sub, dom, obj, act, mt, eft, cond]
+	Chain         []string // 从请求主体到规则主体的 g 继承链，规则主体不可达时为空
+	DomainMatched bool     // 规则的域是否匹配请求的租户域
+	PathMatched   bool     // URL是否匹配
+	MethodMatched bool     // 请求方法是否匹配
+	CondMatched   bool     // 请求是否满足附加条件
+}
+
+// Reachable 请求主体是否继承了规则的主体
+func (r ExplainRule) Reachable() bool {
+	return len(r.Chain) > 0
+}
+
+// Matched 规则是否命中请求
+func (r ExplainRule) Matched() bool {
+	return r.misses() == 0
+}
+
+// misses 未满足的匹配项数量
+func (r ExplainRule) misses() int {
+	n := 0
+	for _, ok := range []bool{r.Reachable(), r.DomainMatched, r.PathMatched, r.MethodMatched, r.CondMatched} {
+		if !ok {
+			n++
+		}
+	}
+	return n
+}
+
+// Explanation 一次鉴权的判定过程
+type Explanation struct {
+	Allowed    bool          // 鉴权结果
+	Matched    []ExplainRule // 命中的规则，存在拒绝规则时拒绝优先
+	NearMisses []ExplainRule // 拒绝访问时只有一两项不满足的规则，按不满足的项数排序
+}
+
+// Explain 模拟一次鉴权并返回判定依据，不使用也不写入鉴权结果缓存
+// 参数与 Authorization 相同，用于排查 ErrForbidden 的原因
+func (c *AuthEnforcer) Explain(sub, dom, url, method string, env *RequestEnv) (*Explanation, error) {
+	var req RequestEnv
+	if env != nil {
+		req = *env
+	}
+	if req.Time.IsZero() {
+		req.Time = time.Now()
+	}
+	e := c.enforcer.Load()
+	allowed, err := e.Enforce(sub, dom, url, method, &req)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := e.GetPolicy()
+	if err != nil {
+		return nil, err
+	}
+	groupings, err := e.GetGroupingPolicy()
+	if err != nil {
+		return nil, err
+	}
+	chains := inheritChains(sub, dom, groupings)
+
+	exp := &Explanation{Allowed: allowed}
+	misses := make([]ExplainRule, 0)
+	for _, rule := range policies {
+		if len(rule) < 7 {
+			continue
+		}
+		r := ExplainRule{
+			Rule:          slices.Clone(rule),
+			Chain:         chains[rule[0]],
+			DomainMatched: util.KeyMatch(dom, rule[1]),
+			PathMatched:   PathMatch(url, rule[2], rule[4]),
+			MethodMatched: MethodMatch(method, rule[3]),
+			CondMatched:   CondMatch(&req, rule[6]),
+		}
+		switch n := r.misses(); {
+		case n == 0:
+			exp.Matched = append(exp.Matched, r)
+		case n <= 2 && (r.Reachable() || r.PathMatched):
+			// 只关注主体可达或URL相同的规则，其余规则与请求无关
+			misses = append(misses, r)
+		}
+	}
+	if !allowed {
+		slices.SortStableFunc(misses, func(a, b ExplainRule) int {
+			return a.misses() - b.misses()
+		})
+		exp.NearMisses = misses[:min(len(misses), maxNearMisses)]
+	}
+	return exp, nil
+}
+
+// ReachableSubjects 返回主体在租户域内沿 g 规则继承的所有主体，包括主体自身
+// 用于查询用户经角色继承的菜单、按钮等授权，不涉及URL匹配
+func (c *AuthEnforcer) ReachableSubjects(sub, dom string) ([]string, error) {
+	groupings, err := c.enforcer.Load().GetGroupingPolicy()
+	if err != nil {
+		return nil, err
+	}
+	chains := inheritChains(sub, dom, groupings)
+	subjects := make([]string, 0, len(chains))
+	for s := range chains {
+		subjects = append(subjects, s)
+	}
+	slices.Sort(subjects)
+	return subjects, nil
+}
+
+// SubjectPolicies 返回主体为 subs 之一且在租户域内生效的 p 规则
+// 与 ReachableSubjects 配合，用于查询用户经角色继承的拒绝规则
+func (c *AuthEnforcer) SubjectPolicies(subs []string, dom string) ([][]string, error) {
+	policies, err := c.enforcer.Load().GetPolicy()
+	if err != nil {
+		return nil, err
+	}
+	rules := make([][]string, 0)
+	for _, rule := range policies {
+		if len(rule) < 7 || !slices.Contains(subs, rule[0]) || !util.KeyMatch(dom, rule[1]) {
+			continue
+		}
+		rules = append(rules, slices.Clone(rule))
+	}
+	return rules, nil
+}
+
+// inheritChains 计算主体在租户域内沿 g 规则可达的所有主体及最短继承链
+// 返回主体到继承链的映射，继承链以请求主体开头、以该主体结尾
+func inheritChains(sub, dom string, groupings [][]string) map[string][]string {
+	edges := make(map[string][]string)
+	for _, g := range groupings {
+		if len(g) < 3 || !util.KeyMatch(dom, g[2]) {
+			continue
+		}
+		edges[g[0]] = append(edges[g[0]], g[1])
+	}
+	chains := map[string][]string{sub: {sub}}
+	queue := []string{sub}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range edges[cur] {
+			if _, ok := chains[next]; ok {
+				continue
+			}
+			chain := append(slices.Clone(chains[cur]), next)
+			chains[next] = chain
+			queue = append(queue, next)
+		}
+	}
+	return chains
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+)
+
+type staticLoader struct {
+	policies, groupings [][]string
+}
+
+func (l staticLoader) LoadRules(ctx context.Context) ([][]string, [][]string, error) {
+	return l.policies, l.groupings, nil
+}
+
+// explainGroupings 用户1在租户1中继承 role_1，role_1 经 role_2 或直接继承 menu_1
+var explainGroupings = [][]string{
+	{"user_1", "role_1", TenantDomain(1)},
+	{"user_1", "role_3", TenantDomain(2)},
+	{"role_1", "role_2", PlatformDomain},
+	{"role_2", "menu_1", PlatformDomain},
+	{"role_1", "menu_1", PlatformDomain},
+	{"menu_1", "10", PlatformDomain},
+	{"role_1", "11", PlatformDomain},
+	{"role_1", "12", PlatformDomain},
+	{"role_3", "13", PlatformDomain},
+}
+
+func newExplainEnforcer(t *testing.T) *AuthEnforcer {
+	t.Helper()
+	m, err := NewModel("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := casbin.NewEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewAuthEnforcer(e, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loader := staticLoader{
+		policies: [][]string{
+			PolicyRule("10", PlatformDomain, "/api/v1/user", "GET", MatchExact, EffectAllow, ""),
+			PolicyRule("11", PlatformDomain, "/api/v1/user/:id", "DELETE", MatchKeyMatch2, EffectDeny, ""),
+			PolicyRule("12", PlatformDomain, "/api/v1/user/:id", "DELETE", MatchKeyMatch2, EffectAllow, ""),
+			PolicyRule("13", PlatformDomain, "/api/v1/user/:id", "GET", MatchKeyMatch2, EffectAllow, ""),
+			PolicyRule("14", PlatformDomain, "/api/v1/dept", "GET", MatchExact, EffectAllow, ""),
+		},
+		groupings: explainGroupings,
+	}
+	if _, err := a.Reload(context.Background(), loader); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestInheritChains(t *testing.T) {
+	chains := inheritChains("user_1", TenantDomain(1), explainGroupings)
+	want := map[string][]string{
+		"user_1": {"user_1"},
+		"role_1": {"user_1", "role_1"},
+		"role_2": {"user_1", "role_1", "role_2"},
+		"menu_1": {"user_1", "role_1", "menu_1"}, // 取最短继承链
+		"10":     {"user_1", "role_1", "menu_1", "10"},
+		"11":     {"user_1", "role_1", "11"},
+		"12":     {"user_1", "role_1", "12"},
+	}
+	if len(chains) != len(want) {
+		t.Errorf("chains = %v, want %v", chains, want)
+	}
+	for sub, chain := range want {
+		if !slices.Equal(chains[sub], chain) {
+			t.Errorf("chain to %s = %v, want %v", sub, chains[sub], chain)
+		}
+	}
+	// 其他租户域的继承关系不可达
+	if _, ok := chains["role_3"]; ok {
+		t.Errorf("role_3 reachable from tenant 1: %v", chains["role_3"])
+	}
+}
+
+func TestExplain(t *testing.T) {
+	a := newExplainEnforcer(t)
+	cases := []struct {
+		name       string
+		url        string
+		method     string
+		allowed    bool
+		matched    []string // 命中规则的主体
+		nearMisses []string // 接近命中的规则主体，按不满足的项数排序
+	}{
+		{
+			name:    "allowed through inheritance",
+			url:     "/api/v1/user",
+			method:  "GET",
+			allowed: true,
+			matched: []string{"10"},
+		},
+		{
+			name:    "deny overrides allow",
+			url:     "/api/v1/user/5",
+			method:  "DELETE",
+			allowed: false,
+			matched: []string{"11", "12"},
+			// 10 的URL和方法不匹配，13 的主体不可达且方法不匹配
+			nearMisses: []string{"10", "13"},
+		},
+		{
+			name:   "near misses",
+			url:    "/api/v1/user/5",
+			method: "GET",
+			// 10 只有URL不匹配，11、12 只有方法不匹配，13 只有主体不可达（位于其他租户域）
+			nearMisses: []string{"10", "11", "12", "13"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			exp, err := a.Explain("user_1", TenantDomain(1), c.url, c.method, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp.Allowed != c.allowed {
+				t.Errorf("Allowed = %v, want %v", exp.Allowed, c.allowed)
+			}
+			if got := ruleSubjects(exp.Matched); !slices.Equal(got, c.matched) {
+				t.Errorf("Matched = %v, want %v", got, c.matched)
+			}
+			if got := ruleSubjects(exp.NearMisses); !slices.Equal(got, c.nearMisses) {
+				t.Errorf("NearMisses = %v, want %v", got, c.nearMisses)
+			}
+			for i := 1; i < len(exp.NearMisses); i++ {
+				if exp.NearMisses[i-1].misses() > exp.NearMisses[i].misses() {
+					t.Errorf("near misses not ordered by misses: %+v", exp.NearMisses)
+				}
+			}
+		})
+	}
+}
+
+func TestExplainMatchedChain(t *testing.T) {
+	a := newExplainEnforcer(t)
+	exp, err := a.Explain("user_1", TenantDomain(1), "/api/v1/user", "GET", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exp.Matched) != 1 {
+		t.Fatalf("Matched = %+v, want one rule", exp.Matched)
+	}
+	r := exp.Matched[0]
+	if want := []string{"user_1", "role_1", "menu_1", "10"}; !slices.Equal(r.Chain, want) {
+		t.Errorf("Chain = %v, want %v", r.Chain, want)
+	}
+	if !r.DomainMatched || !r.PathMatched || !r.MethodMatched || !r.CondMatched {
+		t.Errorf("matched rule = %+v", r)
+	}
+	if exp.NearMisses != nil {
+		t.Errorf("NearMisses = %+v, want none when allowed", exp.NearMisses)
+	}
+}
+
+func ruleSubjects(rules []ExplainRule) []string {
+	var subs []string
+	for _, r := range rules {
+		subs = append(subs, r.Rule[0])
+	}
+	return subs
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataTokenKey gRPC元数据中携带授权令牌的键
+	MetadataTokenKey = "authorization"
+	// MetadataInternalTokenKey gRPC元数据中携带内部服务令牌的键
+	MetadataInternalTokenKey = "x-internal-token"
+)
+
+// ClaimsInterceptorOptions 解析调用方身份的选项
+type ClaimsInterceptorOptions struct {
+	InternalToken string   // 内部服务调用携带的共享令牌，为空时不接受内部调用
+	PublicMethods []string // 无需令牌即可调用的完整方法名，如登录和生成验证码
+}
+
+// UnaryClaimsInterceptor 从gRPC元数据中解析授权令牌，并将用户信息存储到 context 中
+// 携带无效令牌的请求返回认证错误；未携带令牌的请求只有调用公开方法，
+// 或携带与配置一致的内部服务令牌时放行，此时不限定租户，其余请求返回 ErrNoAuthor
+func UnaryClaimsInterceptor(enforcer *AuthEnforcer, opts ClaimsInterceptorOptions) grpc.UnaryServerInterceptor {
+	public := make(map[string]bool, len(opts.PublicMethods))
+	for _, m := range opts.PublicMethods {
+		public[m] = true
+	}
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if token := firstMetadata(md, MetadataTokenKey); token != "" {
+			claims, err := enforcer.Authentication(ctx, token)
+			if err != nil {
+				return nil, err
+			}
+			return handler(SetUserClaims(ctx, claims), req)
+		}
+		if public[info.FullMethod] || isInternalCall(md, opts.InternalToken) {
+			return handler(ctx, req)
+		}
+		return nil, ErrNoAuthor
+	}
+}
+
+// UnaryInternalTokenInterceptor 为内部服务调用附加服务令牌的客户端拦截器，已携带授权令牌的调用不附加
+func UnaryInternalTokenInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if token != "" && firstMetadata(md, MetadataTokenKey) == "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataInternalTokenKey, token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// isInternalCall 判断是否携带了与配置一致的内部服务令牌，未配置时总是返回false
+func isInternalCall(md metadata.MD, internalToken string) bool {
+	if internalToken == "" {
+		return false
+	}
+	got := firstMetadata(md, MetadataInternalTokenKey)
+	return subtle.ConstantTimeCompare([]byte(got), []byte(internalToken)) == 1
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryClaimsInterceptorWithoutToken(t *testing.T) {
+	interceptor := UnaryClaimsInterceptor(nil, ClaimsInterceptorOptions{
+		InternalToken: "secret",
+		PublicMethods: []string{"/customer.User/Login"},
+	})
+	cases := []struct {
+		name   string
+		method string
+		md     metadata.MD
+		allow  bool
+	}{
+		{"no metadata", "/customer.User/ListCustomer", nil, false},
+		{"empty authorization", "/customer.User/ListCustomer", metadata.Pairs(MetadataTokenKey, ""), false},
+		{"public method", "/customer.User/Login", nil, true},
+		{"internal token", "/customer.User/ListCustomer", metadata.Pairs(MetadataInternalTokenKey, "secret"), true},
+		{"wrong internal token", "/customer.User/ListCustomer", metadata.Pairs(MetadataInternalTokenKey, "guess"), false},
+		{"empty internal token", "/customer.User/ListCustomer", metadata.Pairs(MetadataInternalTokenKey, ""), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			if c.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, c.md)
+			}
+			called := false
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: c.method}, func(ctx context.Context, req any) (any, error) {
+				called = true
+				if _, err := GetUserClaims(ctx); err == nil {
+					t.Error("tokenless call should not carry user claims")
+				}
+				return nil, nil
+			})
+			if called != c.allow {
+				t.Fatalf("handler called = %v, want %v", called, c.allow)
+			}
+			if !c.allow && !errors.Is(err, ErrNoAuthor) {
+				t.Fatalf("err = %v, want ErrNoAuthor", err)
+			}
+		})
+	}
+}
+
+func TestUnaryClaimsInterceptorInternalTokenUnset(t *testing.T) {
+	interceptor := UnaryClaimsInterceptor(nil, ClaimsInterceptorOptions{})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataInternalTokenKey, ""))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/customer.User/ListCustomer"}, func(ctx context.Context, req any) (any, error) {
+		t.Fatal("handler should not be called")
+		return nil, nil
+	})
+	if !errors.Is(err, ErrNoAuthor) {
+		t.Fatalf("err = %v, want ErrNoAuthor", err)
+	}
+}
+
+func TestUnaryInternalTokenInterceptor(t *testing.T) {
+	interceptor := UnaryInternalTokenInterceptor("secret")
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"adds token", context.Background(), "secret"},
+		{"keeps user token", metadata.AppendToOutgoingContext(context.Background(), MetadataTokenKey, "jwt"), ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := interceptor(c.ctx, "/customer.User/ListCustomer", nil, nil, nil,
+				func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+					md, _ := metadata.FromOutgoingContext(ctx)
+					if got := firstMetadata(md, MetadataInternalTokenKey); got != c.want {
+						t.Errorf("internal token = %q, want %q", got, c.want)
+					}
+					return nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
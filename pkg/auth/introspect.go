@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"gz-dango/pkg/errors"
+)
+
+// 令牌无效的原因，用于向其他服务返回令牌检查结果
+const (
+	InactiveRevoked = "revoked" // 令牌已被撤销
+	InactiveExpired = "expired" // 令牌已过期
+	InactiveInvalid = "invalid" // 令牌格式或签名无效
+)
+
+// InactiveReason 返回 Authentication 失败的原因，非令牌本身导致的错误（如黑名单查询失败）返回false
+func InactiveReason(err *errors.Error) (string, bool) {
+	switch {
+	case err == nil:
+		return "", false
+	case err.Is(ErrTokenRevoked):
+		return InactiveRevoked, true
+	case err.Is(ErrTokenExpired):
+		return InactiveExpired, true
+	case err.Is(ErrInvalidToken):
+		return InactiveInvalid, true
+	default:
+		return "", false
+	}
+}
+
+// InactiveError 返回令牌无效的原因对应的认证错误
+func InactiveError(reason string) *errors.Error {
+	switch reason {
+	case InactiveRevoked:
+		return ErrTokenRevoked
+	case InactiveExpired:
+		return ErrTokenExpired
+	default:
+		return ErrInvalidToken
+	}
+}
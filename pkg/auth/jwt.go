@@ -1,15 +1,40 @@
 package auth
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
 type UserClaims struct {
 	jwt.RegisteredClaims
-	IsStaff bool   `json:"isf"`  // 是否是工作人员
-	UserId  uint32 `json:"uid"`  // 用户ID
-	Role    string `json:"role"` // 角色
+	IsStaff  bool     `json:"isf"`   // 是否是工作人员
+	UserId   uint32   `json:"uid"`   // 用户ID
+	TenantId uint32   `json:"tid"`   // 租户ID，0表示平台
+	DeptId   uint32   `json:"did"`   // 部门ID，0表示未分配部门
+	Roles    []string `json:"roles"` // 角色列表
+}
+
+// IsPlatformStaff 判断是否为平台工作人员，平台工作人员可以跨租户操作
+func (c *UserClaims) IsPlatformStaff() bool {
+	return c.IsStaff && c.TenantId == PlatformTenantId
+}
+
+// UserSubject 返回用户在casbin中的主体标识
+// 用户通过 g 规则继承其拥有的所有角色，任一角色允许即可访问
+func UserSubject(userId uint32) string {
+	return fmt.Sprintf("user_%d", userId)
+}
+
+// TenantDomain 返回租户在casbin中的域标识
+// 平台级数据使用通配域，其策略在所有租户域中生效
+func TenantDomain(tenantId uint32) string {
+	if tenantId == PlatformTenantId {
+		return PlatformDomain
+	}
+	return strconv.FormatUint(uint64(tenantId), 10)
 }
 
 func NewJWT(secretKey []byte, u UserClaims) (string, error) {
@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/casbin/casbin/v2"
+)
+
+// 权限URL的匹配方式，对应 p 规则的 mt 字段
+const (
+	MatchExact     = "exact"     // 完全相等
+	MatchKeyMatch2 = "keyMatch2" // RESTful路径，参数形如 /user/:id，支持 /*
+	MatchKeyMatch5 = "keyMatch5" // RESTful路径，参数形如 /user/{id}，支持 /*，忽略查询字符串
+	MatchRegex     = "regex"     // 正则表达式，匹配完整路径
+)
+
+// MethodAny 匹配所有请求方法
+const MethodAny = "*"
+
+// 策略的效果，对应 p 规则的 eft 字段
+const (
+	EffectAllow = "allow" // 允许访问
+	EffectDeny  = "deny"  // 拒绝访问，优先于允许
+)
+
+// IsValidEffect 判断是否为支持的策略效果，空字符串视为允许
+func IsValidEffect(effect string) bool {
+	switch effect {
+	case "", EffectAllow, EffectDeny:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	keyMatch2Param = regexp.MustCompile(`:[^/]+`)
+	keyMatch5Param = regexp.MustCompile(`\{[^/]+?\}`)
+
+	// patterns 编译后的正则表达式缓存，键为匹配方式和原始表达式
+	patterns sync.Map
+)
+
+// IsValidMatcher 判断是否为支持的URL匹配方式，空字符串视为完全相等
+func IsValidMatcher(matcher string) bool {
+	switch matcher {
+	case "", MatchExact, MatchKeyMatch2, MatchKeyMatch5, MatchRegex:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidatePattern 校验权限的URL和请求方法是否符合匹配方式的要求
+func ValidatePattern(matcher, url, method string) error {
+	if !IsValidMatcher(matcher) {
+		return fmt.Errorf("unsupported matcher: %s", matcher)
+	}
+	if url == "" {
+		return fmt.Errorf("url is required")
+	}
+	if matcher != MatchRegex && !strings.HasPrefix(url, "/") {
+		return fmt.Errorf("url must start with /: %s", url)
+	}
+	if _, err := compilePattern(matcher, url); err != nil {
+		return fmt.Errorf("invalid url pattern %q: %w", url, err)
+	}
+	if method == "" {
+		return fmt.Errorf("method is required")
+	}
+	if method == MethodAny {
+		return nil
+	}
+	if _, err := compilePattern(MatchRegex, method); err != nil {
+		return fmt.Errorf("invalid method pattern %q: %w", method, err)
+	}
+	return nil
+}
+
+// compilePattern 将URL模式转换为完整匹配的正则表达式，结果会被缓存
+func compilePattern(matcher, pattern string) (*regexp.Regexp, error) {
+	key := matcher + "\x00" + pattern
+	if re, ok := patterns.Load(key); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	expr := pattern
+	switch matcher {
+	case MatchKeyMatch2:
+		expr = keyMatchExpr(pattern, keyMatch2Param)
+	case MatchKeyMatch5:
+		expr = keyMatchExpr(pattern, keyMatch5Param)
+	case MatchRegex:
+	default:
+		expr = regexp.QuoteMeta(expr)
+	}
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(key, re)
+	return re, nil
+}
+
+// keyMatchExpr 将RESTful路径模式转换为正则表达式，路径参数匹配一个路径段，/* 匹配任意后缀，
+// 其余部分按字面量转义，避免 . 等字符被当作正则元字符
+func keyMatchExpr(pattern string, param *regexp.Regexp) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range param.FindAllStringIndex(pattern, -1) {
+		b.WriteString(quoteKeyLiteral(pattern[last:loc[0]]))
+		b.WriteString("[^/]+")
+		last = loc[1]
+	}
+	b.WriteString(quoteKeyLiteral(pattern[last:]))
+	return b.String()
+}
+
+// quoteKeyLiteral 转义路径模式中的字面量，仅保留 /* 通配
+func quoteKeyLiteral(s string) string {
+	parts := strings.Split(s, "/*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return strings.Join(parts, "/.*")
+}
+
+// PathMatch 判断请求路径是否匹配权限的URL模式
+func PathMatch(path, pattern, matcher string) bool {
+	switch matcher {
+	case "", MatchExact:
+		return path == pattern
+	case MatchKeyMatch5:
+		if i := strings.Index(path, "?"); i != -1 {
+			path = path[:i]
+		}
+	}
+	re, err := compilePattern(matcher, pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(path)
+}
+
+// MethodMatch 判断请求方法是否匹配权限的方法模式，模式为正则表达式，* 匹配所有方法
+func MethodMatch(method, pattern string) bool {
+	if pattern == MethodAny || pattern == method {
+		return true
+	}
+	re, err := compilePattern(MatchRegex, pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(method)
+}
+
+// addMatchFunctions 为enforcer注册模型中使用的自定义匹配函数
+func addMatchFunctions(e *casbin.SyncedEnforcer) {
+	e.AddFunction("pathMatch", func(args ...any) (any, error) {
+		if len(args) != 3 {
+			return false, fmt.Errorf("pathMatch: expected 3 arguments, got %d", len(args))
+		}
+		path, _ := args[0].(string)
+		pattern, _ := args[1].(string)
+		matcher, _ := args[2].(string)
+		return PathMatch(path, pattern, matcher), nil
+	})
+	e.AddFunction("methodMatch", func(args ...any) (any, error) {
+		if len(args) != 2 {
+			return false, fmt.Errorf("methodMatch: expected 2 arguments, got %d", len(args))
+		}
+		method, _ := args[0].(string)
+		pattern, _ := args[1].(string)
+		return MethodMatch(method, pattern), nil
+	})
+	e.AddFunction("condMatch", func(args ...any) (any, error) {
+		if len(args) != 2 {
+			return false, fmt.Errorf("condMatch: expected 2 arguments, got %d", len(args))
+		}
+		env, _ := args[0].(*RequestEnv)
+		cond, _ := args[1].(string)
+		return CondMatch(env, cond), nil
+	})
+}
@@ -0,0 +1,90 @@
+package auth
+
+import "testing"
+
+func TestPathMatch(t *testing.T) {
+	cases := []struct {
+		matcher string
+		pattern string
+		path    string
+		want    bool
+	}{
+		{MatchExact, "/api/v1/user", "/api/v1/user", true},
+		{"", "/api/v1/user", "/api/v1/user/1", false},
+		{MatchExact, "/api/v1/a.b", "/api/v1/aXb", false},
+
+		{MatchKeyMatch2, "/api/v1/user/:id", "/api/v1/user/1", true},
+		{MatchKeyMatch2, "/api/v1/user/:id", "/api/v1/user/1/roles", false},
+		{MatchKeyMatch2, "/api/v1/user/:id", "/api/v1/user/", false},
+		{MatchKeyMatch2, "/api/v1/user/:id/roles", "/api/v1/user/7/roles", true},
+		{MatchKeyMatch2, "/api/v1/user/*", "/api/v1/user/1/roles", true},
+		{MatchKeyMatch2, "/api/v1/user/*", "/api/v1/dept/1", false},
+		{MatchKeyMatch2, "/api/v1/a.b/:id", "/api/v1/a.b/1", true},
+		{MatchKeyMatch2, "/api/v1/a.b/:id", "/api/v1/aXb/1", false},
+		{MatchKeyMatch2, "/api/v1/(user)+", "/api/v1/useruser", false},
+		{MatchKeyMatch2, "/api/v1/(user)+", "/api/v1/(user)+", true},
+
+		{MatchKeyMatch5, "/api/v1/user/{id}", "/api/v1/user/1", true},
+		{MatchKeyMatch5, "/api/v1/user/{id}", "/api/v1/user/1?page=2", true},
+		{MatchKeyMatch5, "/api/v1/user/{id}", "/api/v1/user/1/roles", false},
+		{MatchKeyMatch5, "/api/v1/file.{ext}", "/api/v1/file.json", true},
+		{MatchKeyMatch5, "/api/v1/file.{ext}", "/api/v1/fileXjson", false},
+		{MatchKeyMatch5, "/api/v1/user/*", "/api/v1/user/1/roles?x=1", true},
+
+		{MatchRegex, "/api/v1/(user|dept)/[0-9]+", "/api/v1/dept/12", true},
+		{MatchRegex, "/api/v1/(user|dept)/[0-9]+", "/api/v1/dept/12/x", false},
+		{MatchRegex, "/api/v1/[", "/api/v1/[", false},
+	}
+	for _, c := range cases {
+		if got := PathMatch(c.path, c.pattern, c.matcher); got != c.want {
+			t.Errorf("PathMatch(%q, %q, %q) = %v, want %v", c.path, c.pattern, c.matcher, got, c.want)
+		}
+	}
+}
+
+func TestMethodMatch(t *testing.T) {
+	cases := []struct {
+		method  string
+		pattern string
+		want    bool
+	}{
+		{"GET", MethodAny, true},
+		{"GET", "GET", true},
+		{"POST", "GET", false},
+		{"PUT", "(PUT|PATCH)", true},
+		{"DELETE", "(PUT|PATCH)", false},
+		{"GETX", "GET", false},
+		{"GET", "[", false},
+	}
+	for _, c := range cases {
+		if got := MethodMatch(c.method, c.pattern); got != c.want {
+			t.Errorf("MethodMatch(%q, %q) = %v, want %v", c.method, c.pattern, got, c.want)
+		}
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	cases := []struct {
+		matcher string
+		url     string
+		method  string
+		ok      bool
+	}{
+		{MatchExact, "/api/v1/user", "GET", true},
+		{MatchKeyMatch2, "/api/v1/user/:id", MethodAny, true},
+		{MatchKeyMatch5, "/api/v1/user/{id}", "(GET|POST)", true},
+		{MatchRegex, "^/api/v1/.*", "GET", true},
+		{"glob", "/api/v1/user", "GET", false},
+		{MatchExact, "", "GET", false},
+		{MatchKeyMatch2, "api/v1/user", "GET", false},
+		{MatchRegex, "/api/v1/[", "GET", false},
+		{MatchExact, "/api/v1/user", "", false},
+		{MatchExact, "/api/v1/user", "(GET", false},
+	}
+	for _, c := range cases {
+		err := ValidatePattern(c.matcher, c.url, c.method)
+		if (err == nil) != c.ok {
+			t.Errorf("ValidatePattern(%q, %q, %q) = %v, want ok=%v", c.matcher, c.url, c.method, err, c.ok)
+		}
+	}
+}
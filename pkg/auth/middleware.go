@@ -1,14 +1,17 @@
 package auth
 
 import (
+	"net"
 	"net/http"
+	"net/netip"
 	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
-// extractToken 从不同位置提取 token
-func extractToken(r *http.Request) string {
+// ExtractToken 从不同位置提取 token
+func ExtractToken(r *http.Request) string {
 	// 检查是否为 WebSocket 升级请求
 	if r.Header.Get("Connection") == "upgrade" ||
 		r.Header.Get("Upgrade") == "websocket" {
@@ -32,6 +35,32 @@ func extractToken(r *http.Request) string {
 	return r.URL.Query().Get("Authorization")
 }
 
+// ClientIP 获取客户端IP，优先使用网关设置的 X-Forwarded-For 中的第一个地址
+// 服务需部署在会覆盖该请求头的网关之后，否则客户端可以伪造IP绕过网段条件
+func ClientIP(r *http.Request) netip.Addr {
+	addr := strings.TrimSpace(strings.Split(httpx.GetRemoteAddr(r), ",")[0])
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return ip
+}
+
+// NewRequestEnv 根据HTTP请求和用户信息构建鉴权所需的请求上下文属性
+func NewRequestEnv(r *http.Request, info *UserClaims) *RequestEnv {
+	env := &RequestEnv{
+		IP:   ClientIP(r),
+		Time: time.Now(),
+	}
+	if info != nil {
+		env.IsStaff = info.IsStaff
+	}
+	return env
+}
+
 func AuthMiddleware(enforcer *AuthEnforcer) func(http.HandlerFunc) http.HandlerFunc {
 	return func(next http.HandlerFunc) http.HandlerFunc {
 		return func(w http.ResponseWriter, r *http.Request) {
@@ -46,7 +75,7 @@ func AuthMiddleware(enforcer *AuthEnforcer) func(http.HandlerFunc) http.HandlerF
 				return
 			}
 			// 从请求头获取token
-			token := extractToken(r)
+			token := ExtractToken(r)
 			if token == "" {
 				httpx.WriteJson(w, ErrNoAuthor.Code, ErrNoAuthor.Reply())
 				return
@@ -59,7 +88,13 @@ func AuthMiddleware(enforcer *AuthEnforcer) func(http.HandlerFunc) http.HandlerF
 				return
 			}
 			// 访问鉴权
-			hasPerm, err := enforcer.Authorization(info.Role, r.URL.Path, r.Method)
+			hasPerm, err := enforcer.Authorization(
+				UserSubject(info.UserId),
+				TenantDomain(info.TenantId),
+				r.URL.Path,
+				r.Method,
+				NewRequestEnv(r, info),
+			)
 			if err != nil {
 				httpx.WriteJson(w, err.Code, err.Reply())
 				return
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	_ "embed"
+
+	"github.com/casbin/casbin/v2/model"
+)
+
+// defaultModel 内置的带域RBAC模型，拒绝优先：任一匹配的拒绝规则都会覆盖允许规则
+// 请求为 [主体, 域, URL, 请求方法, 请求上下文属性]
+// p 规则为 [主体, 域, URL模式, 方法模式, 匹配方式, 效果, 附加条件]，g 规则为 [主体, 继承的主体, 域]
+//
+//go:embed model.conf
+var defaultModel string
+
+// NewModel 加载casbin模型，path为空时使用内置模型
+// 自定义模型需保留内置模型的请求和策略定义，以及 pathMatch、methodMatch、condMatch 等匹配函数的用法
+func NewModel(path string) (model.Model, error) {
+	if path == "" {
+		return model.NewModelFromString(defaultModel)
+	}
+	return model.NewModelFromFile(path)
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// 策略变更消息的传输方式
+const (
+	WatcherRedis  = "redis"  // Redis Pub/Sub
+	WatcherEtcd   = "etcd"   // etcd watch
+	WatcherMemory = "memory" // 进程内，仅用于单实例或测试
+)
+
+const (
+	watcherMinBackoff = time.Second
+	watcherMaxBackoff = 30 * time.Second
+)
+
+// PolicyWatcher 在实例之间传递策略变更消息，并维护全局递增的策略版本号
+type PolicyWatcher interface {
+	// Start 在后台开始监听策略变更消息，连接断开后按退避间隔自动重连
+	// 监听持续到ctx取消或调用Stop
+	Start(ctx context.Context, handler PolicyWatcherHandler) error
+
+	// Stop 停止监听并等待后台任务退出
+	Stop()
+
+	// Publish 为变更分配下一个版本号并发布给所有实例（包括本实例），返回分配的版本号
+	Publish(ctx context.Context, change PolicyChange) (int64, error)
+
+	// Version 返回当前的全局策略版本号，尚未发生过变更时为0
+	Version(ctx context.Context) (int64, error)
+
+	// Health 返回监听连接的健康状态
+	Health() PolicyWatcherHealth
+}
+
+// PolicyWatcherHandler 处理监听到的策略变更
+type PolicyWatcherHandler interface {
+	// OnChange 收到一条策略变更消息
+	OnChange(change PolicyChange)
+
+	// OnResync 可能遗漏了变更消息（重连、消息无法解析等），需要全量同步策略
+	OnResync(reason string)
+}
+
+// PolicyWatcherHealth 监听连接的健康状态
+type PolicyWatcherHealth struct {
+	Connected     bool      // 当前是否处于监听状态
+	Reconnects    int       // 断开后重连的次数
+	LastError     string    // 最近一次连接错误
+	LastErrorAt   time.Time // 最近一次连接错误的时间
+	LastMessageAt time.Time // 最近一次收到消息的时间
+}
+
+// watcherLoop 封装各实现共用的启停、重连退避和健康状态
+type watcherLoop struct {
+	mutex  sync.RWMutex
+	health PolicyWatcherHealth
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// start 在后台反复执行listen，listen在连接成功后应调用connected并阻塞到连接断开
+// 除首次连接外，每次重连成功后都会通知handler全量同步
+func (l *watcherLoop) start(
+	ctx context.Context,
+	handler PolicyWatcherHandler,
+	listen func(ctx context.Context, connected func()) error,
+) {
+	ctx, cancel := context.WithCancel(ctx)
+	l.mutex.Lock()
+	l.cancel = cancel
+	l.done = make(chan struct{})
+	l.mutex.Unlock()
+
+	go func() {
+		defer close(l.done)
+		backoff := watcherMinBackoff
+		for attempt := 0; ; attempt++ {
+			reconnect := attempt > 0
+			err := listen(ctx, func() {
+				l.mutex.Lock()
+				l.health.Connected = true
+				if reconnect {
+					l.health.Reconnects++
+				}
+				l.mutex.Unlock()
+				backoff = watcherMinBackoff
+				if reconnect {
+					handler.OnResync("reconnected")
+				}
+			})
+			l.mutex.Lock()
+			l.health.Connected = false
+			if err != nil && ctx.Err() == nil {
+				l.health.LastError = err.Error()
+				l.health.LastErrorAt = time.Now()
+			}
+			l.mutex.Unlock()
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, watcherMaxBackoff)
+		}
+	}()
+}
+
+// stop 取消后台监听并等待其退出
+func (l *watcherLoop) stop() {
+	l.mutex.RLock()
+	cancel, done := l.cancel, l.done
+	l.mutex.RUnlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// dispatch 解析消息并交给handler，无法解析的消息会触发全量同步
+func (l *watcherLoop) dispatch(handler PolicyWatcherHandler, payload []byte) {
+	l.mutex.Lock()
+	l.health.LastMessageAt = time.Now()
+	l.mutex.Unlock()
+	var change PolicyChange
+	if err := json.Unmarshal(payload, &change); err != nil || change.Version <= 0 {
+		handler.OnResync("invalid_message")
+		return
+	}
+	handler.OnChange(change)
+}
+
+func (l *watcherLoop) snapshot() PolicyWatcherHealth {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.health
+}
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// EtcdPolicyWatcher 使用etcd watch传递策略变更消息
+// 每条消息写入key，版本号保存在 key + "/version" 中，两者在同一事务中更新
+// 断线重连后从上次处理的revision继续监听，revision被压缩时触发全量同步
+type EtcdPolicyWatcher struct {
+	client  *clientv3.Client
+	key     string
+	loop    watcherLoop
+	lastRev int64
+}
+
+func NewEtcdPolicyWatcher(client *clientv3.Client, key string) *EtcdPolicyWatcher {
+	return &EtcdPolicyWatcher{
+		client: client,
+		key:    key,
+	}
+}
+
+func (w *EtcdPolicyWatcher) versionKey() string {
+	return w.key + "/version"
+}
+
+func (w *EtcdPolicyWatcher) Start(ctx context.Context, handler PolicyWatcherHandler) error {
+	w.loop.start(ctx, handler, func(ctx context.Context, connected func()) error {
+		if w.lastRev == 0 {
+			resp, err := w.client.Get(ctx, w.key)
+			if err != nil {
+				return err
+			}
+			w.lastRev = resp.Header.Revision
+		}
+		wch := w.client.Watch(
+			clientv3.WithRequireLeader(ctx),
+			w.key,
+			clientv3.WithRev(w.lastRev+1),
+			clientv3.WithCreatedNotify(),
+		)
+		for resp := range wch {
+			if resp.CompactRevision > 0 {
+				// 期间的消息已被压缩，从当前revision重新开始
+				w.lastRev = resp.CompactRevision - 1
+				handler.OnResync("compacted")
+			}
+			if err := resp.Err(); err != nil {
+				return err
+			}
+			if resp.Created {
+				connected()
+				continue
+			}
+			for _, ev := range resp.Events {
+				w.lastRev = ev.Kv.ModRevision
+				if ev.Type == clientv3.EventTypePut {
+					w.loop.dispatch(handler, ev.Kv.Value)
+				}
+			}
+		}
+		return ctx.Err()
+	})
+	return nil
+}
+
+func (w *EtcdPolicyWatcher) Stop() {
+	w.loop.stop()
+}
+
+func (w *EtcdPolicyWatcher) Publish(ctx context.Context, change PolicyChange) (int64, error) {
+	for {
+		resp, err := w.client.Get(ctx, w.versionKey())
+		if err != nil {
+			return 0, err
+		}
+		var current, modRev int64
+		if len(resp.Kvs) > 0 {
+			if current, err = strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64); err != nil {
+				return 0, err
+			}
+			modRev = resp.Kvs[0].ModRevision
+		}
+		change.Version = current + 1
+		payload, err := json.Marshal(change)
+		if err != nil {
+			return 0, err
+		}
+		txn, err := w.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(w.versionKey()), "=", modRev)).
+			Then(
+				clientv3.OpPut(w.versionKey(), strconv.FormatInt(change.Version, 10)),
+				clientv3.OpPut(w.key, string(payload)),
+			).
+			Commit()
+		if err != nil {
+			return 0, err
+		}
+		if txn.Succeeded {
+			return change.Version, nil
+		}
+		// 版本号已被其他实例更新，重试
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (w *EtcdPolicyWatcher) Version(ctx context.Context) (int64, error) {
+	resp, err := w.client.Get(ctx, w.versionKey())
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64)
+}
+
+func (w *EtcdPolicyWatcher) Health() PolicyWatcherHealth {
+	return w.loop.snapshot()
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"sync"
+)
+
+var errMemoryBusDisconnected = goerrors.New("memory policy bus disconnected")
+
+// MemoryPolicyBus 进程内的策略变更总线，连接在同一总线上的watcher相互可见
+type MemoryPolicyBus struct {
+	mutex       sync.Mutex
+	version     int64
+	subscribers map[*MemoryPolicyWatcher]chan []byte
+}
+
+func NewMemoryPolicyBus() *MemoryPolicyBus {
+	return &MemoryPolicyBus{
+		subscribers: make(map[*MemoryPolicyWatcher]chan []byte),
+	}
+}
+
+// Disconnect 断开总线上所有watcher的连接，watcher将按退避间隔重连，用于模拟连接中断
+func (b *MemoryPolicyBus) Disconnect() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for w, ch := range b.subscribers {
+		close(ch)
+		delete(b.subscribers, w)
+	}
+}
+
+// MemoryPolicyWatcher 基于MemoryPolicyBus的watcher，适用于单实例应用或测试环境
+type MemoryPolicyWatcher struct {
+	bus  *MemoryPolicyBus
+	loop watcherLoop
+}
+
+func NewMemoryPolicyWatcher(bus *MemoryPolicyBus) *MemoryPolicyWatcher {
+	return &MemoryPolicyWatcher{
+		bus: bus,
+	}
+}
+
+func (w *MemoryPolicyWatcher) Start(ctx context.Context, handler PolicyWatcherHandler) error {
+	w.loop.start(ctx, handler, func(ctx context.Context, connected func()) error {
+		ch := make(chan []byte, 64)
+		w.bus.mutex.Lock()
+		w.bus.subscribers[w] = ch
+		w.bus.mutex.Unlock()
+		defer func() {
+			w.bus.mutex.Lock()
+			delete(w.bus.subscribers, w)
+			w.bus.mutex.Unlock()
+		}()
+		connected()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case payload, ok := <-ch:
+				if !ok {
+					return errMemoryBusDisconnected
+				}
+				w.loop.dispatch(handler, payload)
+			}
+		}
+	})
+	return nil
+}
+
+func (w *MemoryPolicyWatcher) Stop() {
+	w.loop.stop()
+}
+
+// Publish 分配版本号并投递给总线上的所有watcher，订阅者处理不及时的消息会被丢弃，
+// 接收方将通过版本号缺口发现并全量同步
+func (w *MemoryPolicyWatcher) Publish(ctx context.Context, change PolicyChange) (int64, error) {
+	w.bus.mutex.Lock()
+	defer w.bus.mutex.Unlock()
+	w.bus.version++
+	change.Version = w.bus.version
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return 0, err
+	}
+	for _, ch := range w.bus.subscribers {
+		select {
+		case ch <- payload:
+		default:
+		}
+	}
+	return change.Version, nil
+}
+
+func (w *MemoryPolicyWatcher) Version(ctx context.Context) (int64, error) {
+	w.bus.mutex.Lock()
+	defer w.bus.mutex.Unlock()
+	return w.bus.version, nil
+}
+
+func (w *MemoryPolicyWatcher) Health() PolicyWatcherHealth {
+	return w.loop.snapshot()
+}
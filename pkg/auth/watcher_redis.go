@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisPolicyWatcher 使用Redis Pub/Sub传递策略变更消息，版本号保存在 channel + ":version" 键中
+type RedisPolicyWatcher struct {
+	client  *redis.Client
+	channel string
+	loop    watcherLoop
+}
+
+func NewRedisPolicyWatcher(client *redis.Client, channel string) *RedisPolicyWatcher {
+	return &RedisPolicyWatcher{
+		client:  client,
+		channel: channel,
+	}
+}
+
+func (w *RedisPolicyWatcher) versionKey() string {
+	return w.channel + ":version"
+}
+
+func (w *RedisPolicyWatcher) Start(ctx context.Context, handler PolicyWatcherHandler) error {
+	w.loop.start(ctx, handler, func(ctx context.Context, connected func()) error {
+		pubsub := w.client.Subscribe(ctx, w.channel)
+		defer pubsub.Close()
+		// 等待订阅确认后才视为连接成功
+		if _, err := pubsub.Receive(ctx); err != nil {
+			return err
+		}
+		connected()
+		for {
+			msg, err := pubsub.ReceiveMessage(ctx)
+			if err != nil {
+				return err
+			}
+			w.loop.dispatch(handler, []byte(msg.Payload))
+		}
+	})
+	return nil
+}
+
+func (w *RedisPolicyWatcher) Stop() {
+	w.loop.stop()
+}
+
+func (w *RedisPolicyWatcher) Publish(ctx context.Context, change PolicyChange) (int64, error) {
+	version, err := w.client.Incr(ctx, w.versionKey()).Result()
+	if err != nil {
+		return 0, err
+	}
+	change.Version = version
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return 0, err
+	}
+	if err := w.client.Publish(ctx, w.channel, payload).Err(); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (w *RedisPolicyWatcher) Version(ctx context.Context) (int64, error) {
+	version, err := w.client.Get(ctx, w.versionKey()).Int64()
+	if goerrors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return version, err
+}
+
+func (w *RedisPolicyWatcher) Health() PolicyWatcherHealth {
+	return w.loop.snapshot()
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// recordingHandler 将收到的变更和全量同步原因转发到通道
+type recordingHandler struct {
+	changes chan PolicyChange
+	resyncs chan string
+}
+
+func newRecordingHandler() *recordingHandler {
+	return &recordingHandler{
+		changes: make(chan PolicyChange, 16),
+		resyncs: make(chan string, 16),
+	}
+}
+
+func (h *recordingHandler) OnChange(change PolicyChange) { h.changes <- change }
+
+func (h *recordingHandler) OnResync(reason string) { h.resyncs <- reason }
+
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watcher callback")
+	}
+	var zero T
+	return zero
+}
+
+// waitConnected 等待watcher完成订阅，避免发布的消息早于订阅
+func waitConnected(t *testing.T, w PolicyWatcher) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !w.Health().Connected {
+		if time.Now().After(deadline) {
+			t.Fatal("watcher did not connect")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMemoryPolicyWatcherDelivers(t *testing.T) {
+	bus := NewMemoryPolicyBus()
+	sub, pub := NewMemoryPolicyWatcher(bus), NewMemoryPolicyWatcher(bus)
+	h := newRecordingHandler()
+	if err := sub.Start(context.Background(), h); err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Stop()
+	waitConnected(t, sub)
+
+	for want := int64(1); want <= 2; want++ {
+		version, err := pub.Publish(context.Background(), PolicyChange{Instance: "other"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != want {
+			t.Fatalf("published version = %d, want %d", version, want)
+		}
+		if got := waitFor(t, h.changes); got.Version != want || got.Instance != "other" {
+			t.Fatalf("received %+v, want version %d", got, want)
+		}
+	}
+	if v, _ := sub.Version(context.Background()); v != 2 {
+		t.Fatalf("Version = %d, want 2", v)
+	}
+}
+
+func TestMemoryPolicyWatcherReconnect(t *testing.T) {
+	bus := NewMemoryPolicyBus()
+	w := NewMemoryPolicyWatcher(bus)
+	h := newRecordingHandler()
+	if err := w.Start(context.Background(), h); err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	waitConnected(t, w)
+
+	bus.Disconnect()
+	if reason := waitFor(t, h.resyncs); reason != "reconnected" {
+		t.Fatalf("resync reason = %q, want reconnected", reason)
+	}
+	health := w.Health()
+	if !health.Connected || health.Reconnects != 1 {
+		t.Fatalf("health = %+v, want connected after 1 reconnect", health)
+	}
+	if health.LastError != errMemoryBusDisconnected.Error() {
+		t.Fatalf("last error = %q", health.LastError)
+	}
+
+	// 重连后继续接收消息
+	if _, err := w.Publish(context.Background(), PolicyChange{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := waitFor(t, h.changes); got.Version != 1 {
+		t.Fatalf("received version %d, want 1", got.Version)
+	}
+}
+
+func TestWatcherLoopDispatchInvalid(t *testing.T) {
+	var l watcherLoop
+	h := newRecordingHandler()
+	for _, payload := range []string{"not json", `{"version":0}`, `{"version":-1}`} {
+		l.dispatch(h, []byte(payload))
+		if reason := waitFor(t, h.resyncs); reason != "invalid_message" {
+			t.Fatalf("%s: resync reason = %q, want invalid_message", payload, reason)
+		}
+	}
+	l.dispatch(h, []byte(`{"version":3,"instance":"a"}`))
+	if got := waitFor(t, h.changes); got.Version != 3 {
+		t.Fatalf("received version %d, want 3", got.Version)
+	}
+	if l.snapshot().LastMessageAt.IsZero() {
+		t.Fatal("LastMessageAt not recorded")
+	}
+}
+
+func TestWatcherLoopStopWithoutStart(t *testing.T) {
+	var l watcherLoop
+	l.stop()
+}
@@ -0,0 +1,165 @@
+// Package captcha 提供图片验证码和算术验证码的生成与校验功能
+// 验证码答案保存在Store中，并且只能被校验一次
+package captcha
+
+import (
+	"context"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// TypeDigit 数字验证码，图片中显示随机数字
+	TypeDigit = "digit"
+	// TypeMath 算术验证码，图片中显示算式，答案为计算结果
+	TypeMath = "math"
+)
+
+const (
+	// ModeOff 登录时不校验验证码
+	ModeOff = "off"
+	// ModeAlways 登录时总是校验验证码
+	ModeAlways = "always"
+	// ModeOnFailure 登录失败次数达到阈值后才校验验证码
+	ModeOnFailure = "on_failure"
+)
+
+const (
+	DefaultWidth  = 120
+	DefaultHeight = 40
+	DefaultLength = 4
+	DefaultExpire = 2 * time.Minute
+
+	// MinWidth 和 MinHeight 图片的最小尺寸，过小的画布无法绘制字符和干扰线
+	MinWidth  = 20
+	MinHeight = 10
+)
+
+// Item 生成的验证码
+type Item struct {
+	Id     string // 验证码ID
+	Type   string // 验证码类型
+	Image  string // base64编码的PNG图片(data URI)
+	Expire int    // 过期时间(秒)
+}
+
+// Captcha 验证码生成与校验器
+type Captcha struct {
+	store  Store
+	width  int
+	height int
+	length int
+	expire time.Duration
+}
+
+// NewCaptcha 创建一个新的验证码生成器
+// store: 验证码答案存储
+// width/height: 图片尺寸（如果<=0则使用默认值，小于最小尺寸时使用最小尺寸）
+// length: 数字验证码的长度（如果<=0则使用默认值）
+// expire: 验证码有效期（如果<=0则使用默认值）
+func NewCaptcha(store Store, width, height, length int, expire time.Duration) *Captcha {
+	if width <= 0 {
+		width = DefaultWidth
+	}
+	width = max(width, MinWidth)
+	if height <= 0 {
+		height = DefaultHeight
+	}
+	height = max(height, MinHeight)
+	if length <= 0 {
+		length = DefaultLength
+	}
+	if expire <= 0 {
+		expire = DefaultExpire
+	}
+	return &Captcha{
+		store:  store,
+		width:  width,
+		height: height,
+		length: length,
+		expire: expire,
+	}
+}
+
+// Generate 生成指定类型的验证码并保存答案
+// typ为空时默认生成数字验证码
+func (c *Captcha) Generate(ctx context.Context, typ string) (*Item, error) {
+	var text, answer string
+	switch typ {
+	case "", TypeDigit:
+		typ = TypeDigit
+		text = randomDigits(c.length)
+		answer = text
+	case TypeMath:
+		text, answer = randomExpr()
+	default:
+		return nil, ErrUnsupportedType.WithData(map[string]any{"type": typ})
+	}
+
+	image, err := renderPNG(text, c.width, c.height)
+	if err != nil {
+		return nil, ErrGenerateFailed.WithCause(err)
+	}
+	id := uuid.New().String()
+	seconds := int(c.expire / time.Second)
+	if err := c.store.Set(ctx, id, answer, seconds); err != nil {
+		return nil, err
+	}
+	return &Item{
+		Id:     id,
+		Type:   typ,
+		Image:  image,
+		Expire: seconds,
+	}, nil
+}
+
+// Verify 校验验证码答案
+// 无论校验是否通过，验证码都会被立即作废
+func (c *Captcha) Verify(ctx context.Context, id, answer string) (bool, error) {
+	if id == "" || answer == "" {
+		return false, nil
+	}
+	expected, err := c.store.Take(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if expected == "" {
+		return false, nil
+	}
+	return strings.TrimSpace(answer) == expected, nil
+}
+
+// randomDigits 生成指定长度的随机数字串
+func randomDigits(length int) string {
+	var b strings.Builder
+	for i := 0; i < length; i++ {
+		b.WriteByte(byte('0' + rand.IntN(10)))
+	}
+	return b.String()
+}
+
+// randomExpr 生成一个简单的算术表达式及其答案
+// 减法保证结果非负，乘法限制在个位数以内
+func randomExpr() (string, string) {
+	a, b := rand.IntN(20)+1, rand.IntN(20)+1
+	var op byte
+	var result int
+	switch rand.IntN(3) {
+	case 0:
+		op, result = '+', a+b
+	case 1:
+		if a < b {
+			a, b = b, a
+		}
+		op, result = '-', a-b
+	default:
+		a, b = a%9+1, b%9+1
+		op, result = '*', a*b
+	}
+	expr := strconv.Itoa(a) + string(op) + strconv.Itoa(b) + "=?"
+	return expr, strconv.Itoa(result)
+}
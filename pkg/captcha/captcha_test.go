@@ -0,0 +1,163 @@
+package captcha
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis/redistest"
+)
+
+func TestNewCaptchaClampsSize(t *testing.T) {
+	cases := []struct {
+		width, height         int
+		wantWidth, wantHeight int
+	}{
+		{0, 0, DefaultWidth, DefaultHeight},
+		{-1, -1, DefaultWidth, DefaultHeight},
+		{1, 1, MinWidth, MinHeight},
+		{3, 5, MinWidth, MinHeight},
+		{200, 60, 200, 60},
+	}
+	for _, c := range cases {
+		cp := NewCaptcha(NewMemoryStore(), c.width, c.height, 0, 0)
+		if cp.width != c.wantWidth || cp.height != c.wantHeight {
+			t.Errorf("NewCaptcha(%d, %d) size = %dx%d, want %dx%d",
+				c.width, c.height, cp.width, cp.height, c.wantWidth, c.wantHeight)
+		}
+		// 最小尺寸下也能绘制图片
+		for _, typ := range []string{TypeDigit, TypeMath} {
+			item, err := cp.Generate(context.Background(), typ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(item.Image, "data:image/png;base64,") {
+				t.Errorf("unexpected image %q", item.Image[:min(len(item.Image), 32)])
+			}
+		}
+	}
+}
+
+func TestGenerateUnsupportedType(t *testing.T) {
+	cp := NewCaptcha(NewMemoryStore(), 0, 0, 0, 0)
+	if _, err := cp.Generate(context.Background(), "audio"); err == nil {
+		t.Fatal("expected error for unsupported type")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name   string
+		stored string
+		id     string
+		answer string
+		want   bool
+	}{
+		{"correct", "1234", "id", "1234", true},
+		{"surrounding spaces", "1234", "id", " 1234\n", true},
+		{"wrong answer", "1234", "id", "1235", false},
+		{"empty answer", "1234", "id", "", false},
+		{"empty id", "1234", "", "1234", false},
+		{"unknown id", "1234", "other", "1234", false},
+		{"math answer", "12", "id", "12", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			if err := store.Set(ctx, "id", c.stored, 60); err != nil {
+				t.Fatal(err)
+			}
+			cp := NewCaptcha(store, 0, 0, 0, 0)
+			got, err := cp.Verify(ctx, c.id, c.answer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("Verify(%q, %q) = %v, want %v", c.id, c.answer, got, c.want)
+			}
+		})
+	}
+}
+
+func TestVerifyIsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.Set(ctx, "id", "1234", 60); err != nil {
+		t.Fatal(err)
+	}
+	cp := NewCaptcha(store, 0, 0, 0, 0)
+	// 校验失败同样作废验证码
+	if ok, _ := cp.Verify(ctx, "id", "0000"); ok {
+		t.Fatal("wrong answer verified")
+	}
+	if ok, _ := cp.Verify(ctx, "id", "1234"); ok {
+		t.Error("captcha verified after it was consumed")
+	}
+}
+
+func TestStores(t *testing.T) {
+	stores := map[string]Store{
+		"memory": NewMemoryStore(),
+		"redis":  NewRedisStore(redistest.CreateRedis(t), "", 0),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if err := store.Set(ctx, "single", "1234", 60); err != nil {
+				t.Fatal(err)
+			}
+			got, err := store.Take(ctx, "single")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != "1234" {
+				t.Errorf("first Take = %q, want %q", got, "1234")
+			}
+			if got, err = store.Take(ctx, "single"); err != nil || got != "" {
+				t.Errorf("second Take = %q, %v, want empty", got, err)
+			}
+			if got, err = store.Take(ctx, "missing"); err != nil || got != "" {
+				t.Errorf("Take missing = %q, %v, want empty", got, err)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.Set(ctx, "expired", "1234", -1); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := store.Take(ctx, "expired"); got != "" {
+		t.Errorf("Take expired = %q, want empty", got)
+	}
+	// 保存新条目时清理已过期的条目
+	if err := store.Set(ctx, "old", "1", -1); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Set(ctx, "new", "2", 60); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.items["old"]; ok {
+		t.Error("expired item was not cleaned up")
+	}
+}
+
+func TestRedisStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	client := redistest.CreateRedis(t)
+	store := NewRedisStore(client, "test:", time.Second)
+	if err := store.Set(ctx, "id", "1234", 60); err != nil {
+		t.Fatal(err)
+	}
+	ttl, err := client.TtlCtx(ctx, "test:id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl <= 0 || ttl > 60 {
+		t.Errorf("ttl = %d, want (0, 60]", ttl)
+	}
+}
@@ -0,0 +1,22 @@
+package captcha
+
+import (
+	"net/http"
+
+	"gz-dango/pkg/errors"
+)
+
+var (
+	ErrUnsupportedType = errors.New(
+		http.StatusBadRequest,
+		"unsupported_captcha_type",
+		"不支持的验证码类型",
+		nil,
+	)
+	ErrGenerateFailed = errors.New(
+		http.StatusInternalServerError,
+		"generate_captcha_failed",
+		"生成验证码失败",
+		nil,
+	)
+)
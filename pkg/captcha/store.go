@@ -0,0 +1,142 @@
+package captcha
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// Store 定义验证码答案的存储接口
+// 答案只能被取出一次，取出后立即失效
+type Store interface {
+	// Set 保存验证码答案，seconds为过期时间
+	// 如果操作失败则返回错误
+	Set(ctx context.Context, id, answer string, seconds int) error
+
+	// Take 取出并删除验证码答案
+	// 验证码不存在或已过期时返回空字符串
+	// 如果操作失败则返回错误
+	Take(ctx context.Context, id string) (string, error)
+}
+
+const (
+	// DefaultPrefix 是Redis验证码条目使用的默认键前缀
+	DefaultPrefix = "auth:captcha:"
+)
+
+type memoryItem struct {
+	answer   string
+	expireAt time.Time
+}
+
+// MemoryStore 使用内存存储实现Store接口
+// 适用于单实例应用或测试环境
+// 通过互斥锁保护实现线程安全
+type MemoryStore struct {
+	items map[string]memoryItem // 验证码ID到答案的映射
+	mutex sync.Mutex            // 用于并发访问的互斥锁
+}
+
+// NewMemoryStore 创建一个新的MemoryStore实例
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		items: make(map[string]memoryItem),
+	}
+}
+
+// Set 保存验证码答案，seconds为过期时间
+// 同时清理已过期的条目，避免内存无限增长
+func (m *MemoryStore) Set(ctx context.Context, id, answer string, seconds int) error {
+	// 检查上下文是否已被取消
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := time.Now()
+	for k, v := range m.items {
+		if now.After(v.expireAt) {
+			delete(m.items, k)
+		}
+	}
+	m.items[id] = memoryItem{
+		answer:   answer,
+		expireAt: now.Add(time.Duration(seconds) * time.Second),
+	}
+	return nil
+}
+
+// Take 取出并删除验证码答案
+// 已过期的条目视为不存在
+func (m *MemoryStore) Take(ctx context.Context, id string) (string, error) {
+	// 检查上下文是否已被取消
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	default:
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	item, exists := m.items[id]
+	if !exists {
+		return "", nil
+	}
+	delete(m.items, id)
+	if time.Now().After(item.expireAt) {
+		return "", nil
+	}
+	return item.answer, nil
+}
+
+// RedisStore 使用Redis作为后端存储实现Store接口
+// 适用于需要共享验证码状态的多实例应用
+type RedisStore struct {
+	client  *redis.Redis  // Redis客户端实例
+	prefix  string        // 验证码条目的键前缀
+	timeout time.Duration // 操作超时时间
+}
+
+// NewRedisStore 创建一个新的RedisStore实例
+// client: Redis客户端实例
+// prefix: 验证码的可选键前缀（如果为空则默认使用DefaultPrefix）
+// timeout: 操作超时时间（如果<=0则默认使用5秒）
+func NewRedisStore(client *redis.Redis, prefix string, timeout time.Duration) *RedisStore {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	if timeout <= 0 {
+		timeout = time.Duration(5) * time.Second
+	}
+	return &RedisStore{
+		client:  client,
+		prefix:  prefix,
+		timeout: timeout,
+	}
+}
+
+// Set 使用Redis SETEX命令保存验证码答案
+func (r *RedisStore) Set(ctx context.Context, id, answer string, seconds int) error {
+	// 为操作应用超时
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	return r.client.SetexCtx(ctx, r.prefix+id, answer, seconds)
+}
+
+// Take 使用Redis GETDEL命令原子地取出并删除验证码答案
+// 保证同一个验证码在多实例下也只能被校验一次
+func (r *RedisStore) Take(ctx context.Context, id string) (string, error) {
+	// 为操作应用超时
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	return r.client.GetDelCtx(ctx, r.prefix+id)
+}
@@ -5,6 +5,7 @@ package database
 import (
 	"context"
 	"errors"
+	"reflect"
 	"runtime"
 	"strings"
 
@@ -119,7 +120,7 @@ func DBCreate(ctx context.Context, db *gorm.DB, model, value any) error {
 	default:
 	}
 	// 使用GORM的Create方法创建记录
-	return db.Model(model).Create(value).Error
+	return db.WithContext(ctx).Model(model).Create(value).Error
 }
 
 // DBUpdate 更新数据库记录，支持关联关系更新
@@ -140,6 +141,7 @@ func DBUpdate(ctx context.Context, db *gorm.DB, m any, data map[string]any, upma
 	if len(conds) == 0 {
 		return gorm.ErrMissingWhereClause
 	}
+	db = db.WithContext(ctx)
 
 	// 如果没有关联关系更新，直接执行更新操作
 	if len(upmap) == 0 {
@@ -163,12 +165,21 @@ func DBUpdate(ctx context.Context, db *gorm.DB, m any, data map[string]any, upma
 		return err
 	}
 
-	// 更新关联关系
-	if err := DBAssociate(ctx, tx, m, upmap); err != nil {
+	// 查询匹配条件的记录，关联关系需要基于带主键的模型更新
+	rows := reflect.New(reflect.SliceOf(reflect.TypeOf(m).Elem()))
+	if err := tx.Model(m).Where(conds[0], conds[1:]...).Find(rows.Interface()).Error; err != nil {
 		DBRollback(ctx, tx)
 		return err
 	}
 
+	// 更新关联关系
+	for i := 0; i < rows.Elem().Len(); i++ {
+		if err := DBAssociate(ctx, tx, rows.Elem().Index(i).Addr().Interface(), upmap); err != nil {
+			DBRollback(ctx, tx)
+			return err
+		}
+	}
+
 	// 提交事务
 	return DBCommit(ctx, tx)
 }
@@ -191,7 +202,7 @@ func DBDelete(ctx context.Context, db *gorm.DB, model any, conds ...any) error {
 	}
 
 	// 执行删除操作
-	return db.Delete(model, conds...).Error
+	return db.WithContext(ctx).Delete(model, conds...).Error
 }
 
 // DBFind 查询单条数据库记录，支持预加载关联关系
@@ -207,6 +218,8 @@ func DBFind(ctx context.Context, db *gorm.DB, preloads []string, m any, conds ..
 		return ctx.Err() // 返回取消原因
 	default:
 	}
+	db = db.WithContext(ctx)
+
 	// 预加载关联关系
 	for _, preload := range preloads {
 		db = db.Preload(preload)
@@ -224,6 +237,8 @@ type QueryParams struct {
 	Limit    int            // 限制返回记录数
 	Offset   int            // 偏移量
 	IsCount  bool           // 是否只查询总数
+
+	Scopes []func(*gorm.DB) *gorm.DB // 额外的查询作用域，如数据范围过滤
 }
 
 func NewPksQueryParams(pks []uint32) QueryParams {
@@ -251,7 +266,7 @@ func DBList(ctx context.Context, db *gorm.DB, model, value any, query QueryParam
 	default:
 	}
 	// 初始化查询构建器
-	mdb := db.Model(model)
+	mdb := db.WithContext(ctx).Model(model)
 
 	// 预加载关联关系
 	for _, preload := range query.Preloads {
@@ -263,6 +278,11 @@ func DBList(ctx context.Context, db *gorm.DB, model, value any, query QueryParam
 		mdb = mdb.Where(k, v)
 	}
 
+	// 应用查询作用域
+	if len(query.Scopes) > 0 {
+		mdb = mdb.Scopes(query.Scopes...)
+	}
+
 	// 查询总数
 	var count int64 = 0
 	if query.IsCount {
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 角色的数据范围
+const (
+	DataScopeAll             = "all"               // 全部数据
+	DataScopeDeptAndChildren = "dept_and_children" // 本部门及下级部门数据
+	DataScopeDept            = "dept"              // 本部门数据
+	DataScopeCustom          = "custom"            // 自定义部门数据
+	DataScopeSelf            = "self"              // 仅本人数据
+)
+
+// IsValidDataScope 判断是否为支持的数据范围
+func IsValidDataScope(scope string) bool {
+	switch scope {
+	case DataScopeAll, DataScopeDeptAndChildren, DataScopeDept, DataScopeCustom, DataScopeSelf:
+		return true
+	default:
+		return false
+	}
+}
+
+// DataScope 调用方可以访问的数据行范围，由其所有角色的数据范围合并而来
+type DataScope struct {
+	All     bool     // 可以访问全部数据
+	UserId  uint32   // 调用方用户ID
+	Self    bool     // 可以访问本人的数据
+	DeptIds []uint32 // 可以访问的部门
+}
+
+// DataScopeColumns 模型中用于数据范围过滤的列，列名为空表示模型不支持该维度
+type DataScopeColumns struct {
+	Dept string // 数据所属部门的列
+	User string // 数据所属用户的列
+}
+
+// Scope 返回按数据范围过滤数据行的GORM作用域
+// 部门条件与本人条件之间为或的关系，没有任何可访问范围时不返回数据
+func (s DataScope) Scope(cols DataScopeColumns) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if s.All {
+			return db
+		}
+		exprs := make([]clause.Expression, 0, 2)
+		if cols.Dept != "" && len(s.DeptIds) > 0 {
+			values := make([]any, 0, len(s.DeptIds))
+			for _, id := range s.DeptIds {
+				values = append(values, id)
+			}
+			exprs = append(exprs, clause.IN{
+				Column: clause.Column{Table: clause.CurrentTable, Name: cols.Dept},
+				Values: values,
+			})
+		}
+		if cols.User != "" && s.Self && s.UserId > 0 {
+			exprs = append(exprs, clause.Eq{
+				Column: clause.Column{Table: clause.CurrentTable, Name: cols.User},
+				Value:  s.UserId,
+			})
+		}
+		if len(exprs) == 0 {
+			return db.Where("1 = 0")
+		}
+		return db.Where(clause.Or(exprs...))
+	}
+}
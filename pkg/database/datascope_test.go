@@ -0,0 +1,78 @@
+package database
+
+import (
+	"slices"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type dataScopeTestModel struct {
+	Id     uint32 `gorm:"primaryKey"`
+	DeptId uint32
+	UserId uint32
+}
+
+func newDataScopeTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&dataScopeTestModel{}); err != nil {
+		t.Fatal(err)
+	}
+	rows := []dataScopeTestModel{
+		{Id: 1, DeptId: 10, UserId: 100},
+		{Id: 2, DeptId: 10, UserId: 101},
+		{Id: 3, DeptId: 20, UserId: 102},
+		{Id: 4, DeptId: 30, UserId: 103},
+	}
+	if err := db.Create(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestDataScopeScope(t *testing.T) {
+	db := newDataScopeTestDB(t)
+	both := DataScopeColumns{Dept: "dept_id", User: "user_id"}
+	cases := []struct {
+		name  string
+		scope DataScope
+		cols  DataScopeColumns
+		want  []uint32
+	}{
+		{"all", DataScope{All: true}, both, []uint32{1, 2, 3, 4}},
+		{"depts", DataScope{DeptIds: []uint32{10, 30}}, both, []uint32{1, 2, 4}},
+		{"self", DataScope{UserId: 102, Self: true}, both, []uint32{3}},
+		{"depts or self", DataScope{UserId: 103, Self: true, DeptIds: []uint32{10}}, both, []uint32{1, 2, 4}},
+		{"self without user column", DataScope{UserId: 102, Self: true}, DataScopeColumns{Dept: "dept_id"}, nil},
+		{"nothing accessible", DataScope{UserId: 100}, both, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got []uint32
+			err := db.Model(&dataScopeTestModel{}).Scopes(c.scope.Scope(c.cols)).Order("id").Pluck("id", &got).Error
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, c.want) {
+				t.Fatalf("ids = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDataScopeSingleRecord(t *testing.T) {
+	db := newDataScopeTestDB(t)
+	scope := DataScope{DeptIds: []uint32{10}}.Scope(DataScopeColumns{Dept: "dept_id"})
+	var m dataScopeTestModel
+	if err := db.Scopes(scope).First(&m, 1).Error; err != nil {
+		t.Fatalf("in scope: %v", err)
+	}
+	if err := db.Scopes(scope).First(&m, 3).Error; err != gorm.ErrRecordNotFound {
+		t.Fatalf("out of scope err = %v, want ErrRecordNotFound", err)
+	}
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	TenantKey       = "tenant_id"
+	TenantFieldName = "TenantId"
+
+	// PlatformTenantId 平台级数据的租户ID，不属于任何租户
+	PlatformTenantId uint32 = 0
+
+	tenantScopedKey = "tenant:scoped"
+)
+
+// TenantResolver 从上下文中解析调用方所属的租户
+// 返回false表示不限定租户（如平台工作人员或内部调用），此时不追加租户条件
+type TenantResolver func(ctx context.Context) (uint32, bool)
+
+// SharedTenantModel 平台级数据对所有租户只读可见的模型
+// 租户调用方可以查询到租户ID为 PlatformTenantId 的记录，但不能修改或删除
+type SharedTenantModel interface {
+	SharedTenant() bool
+}
+
+// RegisterTenantScope 注册租户隔离回调
+// 对包含 TenantId 字段的模型，查询、更新和删除时自动追加调用方租户条件，新增时自动写入调用方租户
+// db: GORM数据库实例
+// resolver: 租户解析函数
+// 返回注册回调可能产生的错误
+func RegisterTenantScope(db *gorm.DB, resolver TenantResolver) error {
+	cb := db.Callback()
+	if err := cb.Create().Before("gorm:create").
+		Register("tenant:create", tenantAssign(resolver)); err != nil {
+		return err
+	}
+	if err := cb.Query().Before("gorm:query").
+		Register("tenant:query", tenantWhere(resolver, true)); err != nil {
+		return err
+	}
+	if err := cb.Row().Before("gorm:row").
+		Register("tenant:row", tenantWhere(resolver, true)); err != nil {
+		return err
+	}
+	if err := cb.Update().Before("gorm:update").
+		Register("tenant:update", tenantWhere(resolver, false)); err != nil {
+		return err
+	}
+	return cb.Delete().Before("gorm:delete").
+		Register("tenant:delete", tenantWhere(resolver, false))
+}
+
+// tenantField 返回当前语句模型的租户字段，模型不支持租户时返回nil
+func tenantField(db *gorm.DB) *tenantFieldInfo {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return nil
+	}
+	field := db.Statement.Schema.LookUpField(TenantFieldName)
+	if field == nil {
+		return nil
+	}
+	_, shared := reflect.New(db.Statement.Schema.ModelType).Interface().(SharedTenantModel)
+	return &tenantFieldInfo{
+		dbName: field.DBName,
+		shared: shared,
+		set:    field.Set,
+	}
+}
+
+type tenantFieldInfo struct {
+	dbName string
+	shared bool
+	set    func(context.Context, reflect.Value, any) error
+}
+
+// tenantWhere 追加租户条件
+// readable为true时，共享模型额外允许读取平台级数据
+func tenantWhere(resolver TenantResolver, readable bool) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		field := tenantField(db)
+		if field == nil {
+			return
+		}
+		tenantId, ok := resolver(db.Statement.Context)
+		if !ok {
+			return
+		}
+		// 同一语句链上的多次执行（如先Count再Find）只追加一次
+		if _, scoped := db.Statement.Settings.LoadOrStore(tenantScopedKey, true); scoped {
+			return
+		}
+		column := clause.Column{Table: clause.CurrentTable, Name: field.dbName}
+		var expr clause.Expression = clause.Eq{Column: column, Value: tenantId}
+		if readable && field.shared {
+			expr = clause.IN{Column: column, Values: []any{PlatformTenantId, tenantId}}
+		}
+		db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{expr}})
+	}
+}
+
+// tenantAssign 新增记录时写入调用方租户，覆盖请求中指定的租户
+func tenantAssign(resolver TenantResolver) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		field := tenantField(db)
+		if field == nil {
+			return
+		}
+		// 关联记录以 ON CONFLICT DO NOTHING 方式保存，它们已存在且不应改写租户
+		if c, ok := db.Statement.Clauses["ON CONFLICT"]; ok {
+			if oc, ok := c.Expression.(clause.OnConflict); ok && oc.DoNothing {
+				return
+			}
+		}
+		tenantId, ok := resolver(db.Statement.Context)
+		if !ok {
+			return
+		}
+		ctx := db.Statement.Context
+		rv := db.Statement.ReflectValue
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < rv.Len(); i++ {
+				if err := field.set(ctx, reflect.Indirect(rv.Index(i)), tenantId); err != nil {
+					db.AddError(err)
+					return
+				}
+			}
+		case reflect.Struct:
+			if err := field.set(ctx, rv, tenantId); err != nil {
+				db.AddError(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type tenantTestModel struct {
+	Id       uint32 `gorm:"primaryKey"`
+	TenantId uint32
+	Name     string
+}
+
+type sharedTenantTestModel struct {
+	Id       uint32 `gorm:"primaryKey"`
+	TenantId uint32
+	Name     string
+}
+
+func (sharedTenantTestModel) SharedTenant() bool { return true }
+
+type tenantCtxKey struct{}
+
+func withTenant(tenantId uint32) context.Context {
+	return context.WithValue(context.Background(), tenantCtxKey{}, tenantId)
+}
+
+func newTenantTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = RegisterTenantScope(db, func(ctx context.Context) (uint32, bool) {
+		tid, ok := ctx.Value(tenantCtxKey{}).(uint32)
+		return tid, ok
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&tenantTestModel{}, &sharedTenantTestModel{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tid := range []uint32{PlatformTenantId, 1, 2} {
+		db.Create(&tenantTestModel{TenantId: tid, Name: "own"})
+		db.Create(&sharedTenantTestModel{TenantId: tid, Name: "shared"})
+	}
+	return db
+}
+
+func TestTenantScopeQuery(t *testing.T) {
+	db := newTenantTestDB(t)
+	cases := []struct {
+		name   string
+		ctx    context.Context
+		model  any
+		tenant []uint32
+	}{
+		{"unscoped caller sees all", context.Background(), &[]tenantTestModel{}, []uint32{0, 1, 2}},
+		{"tenant sees own rows", withTenant(1), &[]tenantTestModel{}, []uint32{1}},
+		{"tenant reads shared platform rows", withTenant(1), &[]sharedTenantTestModel{}, []uint32{0, 1}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got []uint32
+			if err := db.WithContext(c.ctx).Model(c.model).Order("tenant_id").Pluck("tenant_id", &got).Error; err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(c.tenant) {
+				t.Fatalf("tenants = %v, want %v", got, c.tenant)
+			}
+			for i := range got {
+				if got[i] != c.tenant[i] {
+					t.Fatalf("tenants = %v, want %v", got, c.tenant)
+				}
+			}
+		})
+	}
+}
+
+func TestTenantScopeFindByPk(t *testing.T) {
+	db := newTenantTestDB(t)
+	var m tenantTestModel
+	// 主键为3的记录属于租户2
+	if err := db.WithContext(withTenant(1)).First(&m, 3).Error; err != gorm.ErrRecordNotFound {
+		t.Fatalf("err = %v, want ErrRecordNotFound", err)
+	}
+	if err := db.WithContext(withTenant(2)).First(&m, 3).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTenantScopeWrite(t *testing.T) {
+	db := newTenantTestDB(t)
+	ctx := withTenant(1)
+	// 租户不能修改或删除共享的平台级数据
+	res := db.WithContext(ctx).Model(&sharedTenantTestModel{}).Where("id = ?", 1).Update("name", "changed")
+	if res.Error != nil || res.RowsAffected != 0 {
+		t.Fatalf("update platform row: rows = %d, err = %v", res.RowsAffected, res.Error)
+	}
+	res = db.WithContext(ctx).Delete(&tenantTestModel{}, 3)
+	if res.Error != nil || res.RowsAffected != 0 {
+		t.Fatalf("delete other tenant row: rows = %d, err = %v", res.RowsAffected, res.Error)
+	}
+	res = db.WithContext(ctx).Delete(&tenantTestModel{}, 2)
+	if res.Error != nil || res.RowsAffected != 1 {
+		t.Fatalf("delete own row: rows = %d, err = %v", res.RowsAffected, res.Error)
+	}
+}
+
+func TestTenantScopeCreate(t *testing.T) {
+	db := newTenantTestDB(t)
+	m := tenantTestModel{TenantId: 2, Name: "new"}
+	if err := db.WithContext(withTenant(1)).Create(&m).Error; err != nil {
+		t.Fatal(err)
+	}
+	if m.TenantId != 1 {
+		t.Fatalf("tenant = %d, want caller tenant 1", m.TenantId)
+	}
+	ms := []tenantTestModel{{TenantId: 0}, {TenantId: 2}}
+	if err := db.WithContext(withTenant(1)).Create(&ms).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range ms {
+		if m.TenantId != 1 {
+			t.Fatalf("tenant = %d, want caller tenant 1", m.TenantId)
+		}
+	}
+	platform := tenantTestModel{TenantId: 2}
+	if err := db.Create(&platform).Error; err != nil {
+		t.Fatal(err)
+	}
+	if platform.TenantId != 2 {
+		t.Fatalf("unscoped caller tenant = %d, want 2", platform.TenantId)
+	}
+}